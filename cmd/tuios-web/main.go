@@ -273,9 +273,11 @@ func createTUIOSHandler(sess sip.Session) (tea.Model, []tea.ProgramOption) {
 
 	// Close the daemon client when the web session ends, otherwise the client
 	// read loop, its socket, and the daemon-side connState leak per connection.
+	// startup.shutdown_command runs first, as it does when a local client exits.
 	if o, ok := model.(*app.OS); ok {
 		go func() {
 			<-sess.Context().Done()
+			o.RunShutdownCommand()
 			o.Cleanup()
 		}()
 	}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/session"
+)
+
+// TestMostRecentSession pins which session --attach-or-new picks: the one with
+// the latest activity, not the newest or the first listed, and none at all
+// when the daemon has no sessions so the launch falls back to a fresh start.
+func TestMostRecentSession(t *testing.T) {
+	if name, ok := mostRecentSession(nil); ok {
+		t.Fatalf("picked %q from an empty daemon", name)
+	}
+
+	sessions := []session.SessionInfo{
+		{Name: "old", Created: 100, LastActive: 200},
+		{Name: "busy", Created: 50, LastActive: 900},
+		{Name: "new", Created: 800, LastActive: 800},
+	}
+	if name, ok := mostRecentSession(sessions); !ok || name != "busy" {
+		t.Errorf("mostRecentSession = %q, %v; want busy", name, ok)
+	}
+}
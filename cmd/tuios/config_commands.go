@@ -61,27 +61,66 @@ func editConfigFile() error {
 	return nil
 }
 
-func resetConfigToDefaults() error {
+func resetConfigToDefaults(assumeYes bool) error {
 	configPath, err := config.GetConfigPath()
 	if err != nil {
 		return fmt.Errorf("could not determine config path: %w", err)
 	}
 
-	if _, err := os.Stat(configPath); err == nil {
-		fmt.Printf("Warning: This will overwrite your existing configuration at:\n")
-		fmt.Printf("  %s\n\n", configPath)
-		fmt.Printf("Are you sure you want to reset to defaults? (yes/no): ")
-
-		var response string
-		_, _ = fmt.Scanln(&response)
-		response = strings.ToLower(strings.TrimSpace(response))
+	defaults, err := defaultConfigFile(configPath)
+	if err != nil {
+		return err
+	}
 
-		if response != "yes" && response != "y" {
-			fmt.Println("Reset cancelled.")
+	existing, err := os.ReadFile(configPath)
+	switch {
+	case err == nil:
+		if string(existing) == defaults {
+			fmt.Println("Configuration already matches the defaults, nothing to reset.")
 			return nil
 		}
+
+		fmt.Printf("Resetting %s will change:\n\n", configPath)
+		for _, line := range configDiff(string(existing), defaults) {
+			fmt.Println(line)
+		}
+		fmt.Println()
+
+		if !assumeYes {
+			fmt.Printf("Are you sure you want to reset to defaults? (yes/no): ")
+
+			var response string
+			_, _ = fmt.Scanln(&response)
+			response = strings.ToLower(strings.TrimSpace(response))
+
+			if response != "yes" && response != "y" {
+				fmt.Println("Reset cancelled.")
+				return nil
+			}
+		}
+
+		backupPath := configPath + ".bak"
+		if err := os.WriteFile(backupPath, existing, 0o600); err != nil {
+			return fmt.Errorf("failed to back up config file: %w", err)
+		}
+		fmt.Printf("Previous configuration saved to %s\n", backupPath)
+	case !os.IsNotExist(err):
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	if err := os.WriteFile(configPath, []byte(defaults), 0o600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
 	}
 
+	fmt.Printf("Configuration reset to defaults\n")
+	fmt.Printf("  Location: %s\n", configPath)
+	fmt.Println("\nYou can customize it with: tuios config edit")
+	return nil
+}
+
+// defaultConfigFile renders the default configuration as written by
+// config reset, header comment included.
+func defaultConfigFile(configPath string) (string, error) {
 	defaultCfg := config.DefaultConfig()
 
 	var sb strings.Builder
@@ -95,21 +134,60 @@ func resetConfigToDefaults() error {
 
 	data, err := toml.Marshal(defaultCfg)
 	if err != nil {
-		return fmt.Errorf("failed to marshal config: %w", err)
+		return "", fmt.Errorf("failed to marshal config: %w", err)
 	}
 
 	if _, err := sb.Write(data); err != nil {
-		return fmt.Errorf("failed to write config data: %w", err)
+		return "", fmt.Errorf("failed to write config data: %w", err)
 	}
+	return sb.String(), nil
+}
 
-	if err := os.WriteFile(configPath, []byte(sb.String()), 0o600); err != nil {
-		return fmt.Errorf("failed to write config file: %w", err)
+// configDiff returns the lines that differ between two config files as
+// "-" (removed) and "+" (added) lines, each run of changes headed by the
+// line number it starts at in the old file. Unchanged lines are left out.
+func configDiff(oldText, newText string) []string {
+	a := strings.Split(strings.TrimSuffix(oldText, "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(newText, "\n"), "\n")
+
+	// lcs[i][j] is the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
 	}
 
-	fmt.Printf("Configuration reset to defaults\n")
-	fmt.Printf("  Location: %s\n", configPath)
-	fmt.Println("\nYou can customize it with: tuios config edit")
-	return nil
+	var out []string
+	inHunk := false
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		if i < len(a) && j < len(b) && a[i] == b[j] {
+			inHunk = false
+			i++
+			j++
+			continue
+		}
+		if !inHunk {
+			out = append(out, fmt.Sprintf("@@ line %d", i+1))
+			inHunk = true
+		}
+		if i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]) {
+			out = append(out, "- "+a[i])
+			i++
+		} else {
+			out = append(out, "+ "+b[j])
+			j++
+		}
+	}
+	return out
 }
 
 func previewThemeColors(themeName string) error {
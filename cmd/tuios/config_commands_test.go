@@ -0,0 +1,29 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestConfigDiff checks the reset preview lists only the changed lines,
+// removals before additions, under the old file's line number.
+func TestConfigDiff(t *testing.T) {
+	oldText := "[appearance]\nborder_style = \"double\"\ndock_position = \"bottom\"\nleader = \"ctrl+a\"\n"
+	newText := "[appearance]\nborder_style = \"rounded\"\ndock_position = \"bottom\"\n"
+
+	got := configDiff(oldText, newText)
+	want := []string{
+		"@@ line 2",
+		"- border_style = \"double\"",
+		"+ border_style = \"rounded\"",
+		"@@ line 4",
+		"- leader = \"ctrl+a\"",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("configDiff =\n%q\nwant\n%q", got, want)
+	}
+
+	if got := configDiff(newText, newText); len(got) != 0 {
+		t.Errorf("identical files should have no diff, got %q", got)
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/session"
+)
+
+// TestKillServerSummary checks the confirmation lists the totals and each
+// session with its windows and clients.
+func TestKillServerSummary(t *testing.T) {
+	got := killServerSummary([]session.SessionInfo{
+		{Name: "work", WindowCount: 3, Clients: 1},
+		{Name: "scratch", WindowCount: 1},
+	})
+	for _, want := range []string{
+		"This stops 2 sessions (4 windows) and disconnects 1 attached client:",
+		"  work: 3 windows, 1 client\n",
+		"  scratch: 1 window, 0 clients\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("summary is missing %q:\n%s", want, got)
+		}
+	}
+}
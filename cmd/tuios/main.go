@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/Gaurav-Gosain/tuios/internal/app"
 	"github.com/Gaurav-Gosain/tuios/internal/session"
@@ -48,7 +49,9 @@ var (
 	showCPU             bool
 	showRAM             bool
 	sharedBorders       bool
+	tilingGap           int
 	zoomMaxWidth        int
+	attachOrNew         bool
 )
 
 func main() {
@@ -59,10 +62,20 @@ func main() {
 
 A terminal-based window manager that provides a modern interface for managing
 multiple terminal sessions with workspace support, tiling modes, and
-comprehensive keyboard/mouse interactions.`,
+comprehensive keyboard/mouse interactions.
+
+Appearance settings are resolved in this order, first match wins:
+  1. command-line flags (--theme, --border-style, ...)
+  2. environment variables: TUIOS_THEME, TUIOS_BORDER_STYLE,
+     TUIOS_SCROLLBACK_LINES, TUIOS_DOCKBAR_POSITION
+  3. the config file (see 'tuios config path')
+  4. built-in defaults`,
 		Example: `  # Run TUIOS
   tuios
 
+  # Attach to the most recent session, or start fresh if there is none
+  tuios --attach-or-new
+
   # Run with debug logging
   tuios --debug
 
@@ -93,7 +106,7 @@ comprehensive keyboard/mouse interactions.`,
   # List all keybindings
   tuios keybinds list`,
 		Version: version,
-		RunE: func(_ *cobra.Command, _ []string) error {
+		RunE: func(cmd *cobra.Command, _ []string) error {
 			if previewTheme != "" {
 				return previewThemeColors(previewTheme)
 			}
@@ -108,11 +121,15 @@ comprehensive keyboard/mouse interactions.`,
 				}
 				return nil
 			}
+			if wantAttachOrNew(cmd) {
+				return runAttachOrNew()
+			}
 			return runLocal()
 		},
 		SilenceUsage: true,
 	}
 
+	rootCmd.Flags().BoolVar(&attachOrNew, "attach-or-new", false, "Attach to the most recent daemon session if one exists, otherwise start fresh (default: from config startup.auto_attach)")
 	rootCmd.PersistentFlags().BoolVar(&debugMode, "debug", false, "Enable debug logging")
 	rootCmd.PersistentFlags().StringVar(&cpuProfile, "cpuprofile", "", "Write CPU profile to file")
 	rootCmd.PersistentFlags().StringVar(&pprofAddr, "pprof", "", "Serve net/http/pprof on this address for live profiling (e.g. localhost:6060)")
@@ -134,6 +151,7 @@ comprehensive keyboard/mouse interactions.`,
 	rootCmd.PersistentFlags().BoolVar(&showCPU, "show-cpu", false, "Show CPU graph in the dock")
 	rootCmd.PersistentFlags().BoolVar(&showRAM, "show-ram", false, "Show RAM usage in the dock")
 	rootCmd.PersistentFlags().BoolVar(&sharedBorders, "shared-borders", false, "Share borders between adjacent tiled windows")
+	rootCmd.PersistentFlags().IntVar(&tilingGap, "tiling-gap", 0, "Blank cells between tiled windows and around them (default: from config or 0, max: 8)")
 
 	rootCmd.PersistentFlags().IntVar(&zoomMaxWidth, "zoom-max-width", 0, "Max width in cells for zoom mode (0 = fullscreen, e.g. 120)")
 
@@ -208,16 +226,20 @@ like vim, vi, nano, and emacs in that order.`,
 		},
 	}
 
+	var configResetYes bool
 	configResetCmd := &cobra.Command{
 		Use:   "reset",
 		Short: "Reset configuration to defaults",
 		Long: `Reset the TUIOS configuration file to default settings
 
-This will overwrite your existing configuration after confirmation.`,
+Before overwriting an existing configuration this shows the lines that
+will change and asks for confirmation. The old file is kept next to the
+new one as config.toml.bak, so a reset can be undone by copying it back.`,
 		RunE: func(_ *cobra.Command, _ []string) error {
-			return resetConfigToDefaults()
+			return resetConfigToDefaults(configResetYes)
 		},
 	}
+	configResetCmd.Flags().BoolVarP(&configResetYes, "yes", "y", false, "Reset without asking for confirmation (the backup is still written)")
 
 	configCmd.AddCommand(configPathCmd, configEditCmd, configResetCmd)
 
@@ -261,22 +283,39 @@ interactive mode (visible TUI) to watch automation happen in real-time.`,
 		Example: `  # Run tape with visible TUI (watch it happen)
   tuios tape play demo.tape
 
+  # Play it three times at double speed
+  tuios tape play demo.tape --loop 3 --speed 2
+
   # Validate tape file syntax
   tuios tape validate demo.tape`,
 	}
 
+	var tapePlayLoop int
+	var tapePlaySpeed float64
 	tapePlayCmd := &cobra.Command{
 		Use:   "play <file.tape>",
 		Short: "Run a tape file in interactive mode",
 		Long: `Execute a tape script while displaying the TUIOS TUI
 
 In interactive mode, you can see the automation happening in real-time
-in the terminal UI. Press Ctrl+P to pause/resume playback.`,
+in the terminal UI. Press Ctrl+P to pause/resume playback.
+
+--speed scales every Sleep in the script: 2 plays twice as fast, 0.5 at
+half speed. --loop plays the script that many times back to back, or
+forever with --loop 0.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(_ *cobra.Command, args []string) error {
-			return runTapeInteractive(args[0])
+			if tapePlayLoop < 0 {
+				return fmt.Errorf("--loop must be 0 (forever) or more, got %d", tapePlayLoop)
+			}
+			if tapePlaySpeed <= 0 {
+				return fmt.Errorf("--speed must be greater than 0, got %g", tapePlaySpeed)
+			}
+			return runTapeInteractive(args[0], tapePlayLoop, tapePlaySpeed)
 		},
 	}
+	tapePlayCmd.Flags().IntVar(&tapePlayLoop, "loop", 1, "Number of times to play the tape, 0 to loop forever")
+	tapePlayCmd.Flags().Float64Var(&tapePlaySpeed, "speed", 1, "Playback speed multiplier applied to Sleep delays")
 
 	tapeValidateCmd := &cobra.Command{
 		Use:   "validate <file.tape>",
@@ -425,6 +464,35 @@ This will close all windows in the session and disconnect any attached clients.`
 		},
 	}
 
+	renameSessionCmd := &cobra.Command{
+		Use:   "rename-session <old-name> <new-name>",
+		Short: "Rename a TUIOS session",
+		Long: `Rename a TUIOS session without recreating it.
+
+Windows, running programs and attached clients are kept. The new name must not
+already be in use.`,
+		Example: `  tuios rename-session session-0 work`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return runRenameSession(args[0], args[1])
+		},
+	}
+
+	saveSessionCmd := &cobra.Command{
+		Use:   "save-session <session-name>",
+		Short: "Save a session's layout to disk now",
+		Long: `Write a session's resurrection state to disk immediately.
+
+Sessions are already saved every 30 seconds and when the daemon stops; this
+command takes a snapshot on demand, for example right before a reboot or after
+arranging a layout you want to keep. Restore it with 'tuios restore-session'.`,
+		Example: `  tuios save-session work`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return runSaveSession(args[0])
+		},
+	}
+
 	resurrectCmd := &cobra.Command{
 		Use:   "resurrect [session-name]",
 		Short: "Restore a previously saved session",
@@ -443,7 +511,7 @@ specific session on demand.`,
 
   # Restore and attach to a saved session
   tuios resurrect mysession`,
-		Aliases: []string{"restore"},
+		Aliases: []string{"restore", "restore-session"},
 		Args:    cobra.MaximumNArgs(1),
 		RunE: func(_ *cobra.Command, args []string) error {
 			name := ""
@@ -498,21 +566,27 @@ Debug log levels:
 	daemonCmd.Flags().StringVar(&daemonLogLevel, "log-level", "", "Debug log level: off, errors, basic, messages, verbose, trace")
 	daemonCmd.Flags().BoolVar(&daemonNoRestore, "no-restore", false, "Do not auto-restore saved sessions on start (use 'tuios resurrect' to restore on demand)")
 
+	var killServerForce bool
 	killDaemonCmd := &cobra.Command{
 		Use:   "kill-server",
 		Short: "Stop the TUIOS daemon",
 		Long: `Stop the TUIOS daemon.
 
-This will stop all sessions and disconnect all clients.
+This will stop all sessions and disconnect all clients. Run from a terminal
+with sessions open, it first lists them with their windows and attached
+clients and asks for confirmation; --force skips the question. Scripts, with
+stdin not a terminal, are never asked.
 
 The command is synchronous: it returns only once the daemon has saved every
 session's state and removed its socket, so a new daemon can be started as soon
 as it returns. It fails if the daemon has not finished within 10 seconds.`,
-		Example: `  tuios kill-server`,
+		Example: `  tuios kill-server
+  tuios kill-server --force`,
 		RunE: func(_ *cobra.Command, _ []string) error {
-			return runKillDaemon()
+			return runKillDaemon(killServerForce)
 		},
 	}
+	killDaemonCmd.Flags().BoolVarP(&killServerForce, "force", "f", false, "Stop the daemon without listing its sessions and asking first")
 
 	// Remote control commands
 	var sendKeysSession string
@@ -629,6 +703,72 @@ Use --ansi to preserve ANSI escape codes (colors, styles).`,
 	capturePaneCmd.Flags().BoolVar(&capturePaneANSI, "ansi", false, "Preserve ANSI escape codes")
 	_ = capturePaneCmd.RegisterFlagCompletionFunc("session", completeSessionNames)
 
+	var signalSession, signalWindow string
+	signalCmd := &cobra.Command{
+		Use:   "signal <signal>",
+		Short: "Send a signal to the process in a pane",
+		Long: `Send a signal to the foreground job of a window in a running TUIOS session.
+
+The signal goes to the foreground process group of the window's terminal, the
+same job a Ctrl+C typed into the window would reach, so it works on a pane that
+does not have focus or whose program is ignoring its keys.
+
+Signals can be named with or without the SIG prefix, in any case, or given by
+number. Accepted: ` + strings.Join(session.SignalNames(), ", ") + `.`,
+		Example: `  # Interrupt the focused window's running command
+  tuios signal INT
+
+  # Kill a stuck process in a background pane
+  tuios signal -w build SIGKILL
+
+  # Suspend and later resume a job
+  tuios signal -w logs TSTP
+  tuios signal -w logs CONT`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return runSignal(signalSession, signalWindow, args[0])
+		},
+	}
+	signalCmd.Flags().StringVarP(&signalSession, "session", "s", "", "Target session")
+	signalCmd.Flags().StringVarP(&signalWindow, "window", "w", "", "Target window by name or ID (default: focused window)")
+	_ = signalCmd.RegisterFlagCompletionFunc("session", completeSessionNames)
+
+	var newWindowSession, newWindowName, newWindowCwd string
+	var newWindowWorkspace int
+	var newWindowDetached bool
+	newWindowCmd := &cobra.Command{
+		Use:   "new-window [flags] [-- command...]",
+		Short: "Create a window in a running session",
+		Long: `Create a window in a running TUIOS session, attached or not.
+
+The window can go on any workspace and start in any directory, and a command
+given after -- is typed into its shell once it starts. With --detached the
+focus stays where it is, so a script can build up a layout in the background
+without pulling the user away from what they are doing.
+
+The new window's ID is printed on stdout for use with other commands.`,
+		Example: `  # Open a window on the current workspace
+  tuios new-window
+
+  # Start a watcher on workspace 3 without switching to it
+  tuios new-window --workspace 3 --cwd ~/src/app --detached -- make watch
+
+  # Capture the ID for later commands
+  id=$(tuios new-window -n logs -d -- tail -f /var/log/syslog)
+  tuios capture-pane -w "$id"`,
+		Args: cobra.ArbitraryArgs,
+		RunE: func(_ *cobra.Command, args []string) error {
+			return runNewWindow(newWindowSession, newWindowName, newWindowWorkspace, newWindowCwd, newWindowDetached, args)
+		},
+	}
+	newWindowCmd.Flags().StringVarP(&newWindowSession, "session", "s", "", "Target session")
+	newWindowCmd.Flags().StringVarP(&newWindowName, "name", "n", "", "Name for the new window")
+	newWindowCmd.Flags().IntVar(&newWindowWorkspace, "workspace", 0, "Workspace to create the window on (default: current)")
+	newWindowCmd.Flags().StringVar(&newWindowCwd, "cwd", "", "Directory to start the shell in")
+	newWindowCmd.Flags().BoolVarP(&newWindowDetached, "detached", "d", false, "Keep focus where it is")
+	_ = newWindowCmd.RegisterFlagCompletionFunc("session", completeSessionNames)
+	_ = newWindowCmd.MarkFlagDirname("cwd")
+
 	var runCommandSession string
 	var runCommandList bool
 	var runCommandJSON bool
@@ -995,9 +1135,9 @@ Name a verb to describe only that verb.`,
 	layoutCmd.AddCommand(layoutListCmd, layoutDeleteCmd, layoutDirCmd, layoutExportCmd)
 
 	rootCmd.AddCommand(sshCmd, configCmd, keybindsCmd, tapeCmd, layoutCmd)
-	rootCmd.AddCommand(attachCmd, newCmd, lsCmd, killSessionCmd, resurrectCmd)
+	rootCmd.AddCommand(attachCmd, newCmd, lsCmd, killSessionCmd, renameSessionCmd, saveSessionCmd, resurrectCmd)
 	rootCmd.AddCommand(startDaemonCmd, daemonCmd, killDaemonCmd)
-	rootCmd.AddCommand(sendKeysCmd, runCommandCmd, setConfigCmd, getConfigCmd, logsCmd, capturePaneCmd)
+	rootCmd.AddCommand(sendKeysCmd, runCommandCmd, setConfigCmd, getConfigCmd, logsCmd, capturePaneCmd, signalCmd, newWindowCmd)
 	rootCmd.AddCommand(listWindowsCmd, getWindowCmd, sessionInfoCmd, listVerbsCmd)
 
 	// Command failures are printed here rather than by fang, which would query
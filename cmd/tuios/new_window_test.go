@@ -0,0 +1,22 @@
+package main
+
+import "testing"
+
+func TestShellJoin(t *testing.T) {
+	tests := []struct {
+		words []string
+		want  string
+	}{
+		{nil, ""},
+		{[]string{"make watch && notify-send done"}, "make watch && notify-send done"},
+		{[]string{"make", "watch"}, "make watch"},
+		{[]string{"grep", "two words", "file"}, "grep 'two words' file"},
+		{[]string{"echo", "it's", ""}, `echo 'it'\''s' ''`},
+		{[]string{"echo", "$HOME"}, "echo '$HOME'"},
+	}
+	for _, tt := range tests {
+		if got := shellJoin(tt.words); got != tt.want {
+			t.Errorf("shellJoin(%q) = %q, want %q", tt.words, got, tt.want)
+		}
+	}
+}
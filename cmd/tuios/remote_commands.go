@@ -6,6 +6,7 @@ import (
 	"maps"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -184,6 +185,82 @@ func runCapturePane(sessionName, windowTarget string, scrollback, ansi bool) err
 	return nil
 }
 
+// runSignal sends a signal to a window's foreground job over the verb protocol.
+func runSignal(sessionName, windowTarget, signal string) error {
+	client, err := dialVerb()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	if _, err := client.Call("signal-window", map[string]any{
+		"session": sessionName,
+		"window":  windowTarget,
+		"signal":  signal,
+	}); err != nil {
+		return explainVerbError("signal-window", err)
+	}
+	return nil
+}
+
+// runNewWindow creates a window over the verb protocol and prints its ID. A
+// relative --cwd is resolved here, since the daemon's working directory is not
+// the caller's.
+func runNewWindow(sessionName, name string, workspace int, cwd string, detached bool, command []string) error {
+	if cwd != "" {
+		abs, err := filepath.Abs(cwd)
+		if err != nil {
+			return fmt.Errorf("invalid --cwd: %w", err)
+		}
+		cwd = abs
+	}
+
+	client, err := dialVerb()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	raw, err := client.Call("new-window", map[string]any{
+		"session":   sessionName,
+		"name":      name,
+		"workspace": workspace,
+		"cwd":       cwd,
+		"command":   shellJoin(command),
+		"detached":  detached,
+	})
+	if err != nil {
+		return explainVerbError("new-window", err)
+	}
+
+	var res struct {
+		WindowID string `json:"window_id"`
+	}
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	fmt.Println(res.WindowID)
+	return nil
+}
+
+// shellJoin turns the words after -- back into one command line for the new
+// window's shell. A single word is passed through untouched, so a quoted
+// pipeline keeps its meaning; otherwise words the shell would split or expand
+// are single-quoted.
+func shellJoin(words []string) string {
+	if len(words) == 1 {
+		return words[0]
+	}
+	quoted := make([]string, len(words))
+	for i, w := range words {
+		if w == "" || strings.ContainsAny(w, " \t\n'\"\\$`|&;<>()*?[]{}~#!") {
+			w = "'" + strings.ReplaceAll(w, "'", `'\''`) + "'"
+		}
+		quoted[i] = w
+	}
+	return strings.Join(quoted, " ")
+}
+
 // runCommand executes a tape command in a running TUIOS session.
 func runCommand(sessionName, command string, args []string, jsonOutput bool) error {
 	if err := requireDaemon(); err != nil {
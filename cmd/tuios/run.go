@@ -172,6 +172,7 @@ func runLocal() error {
 		ShowCPU:             showCPU,
 		ShowRAM:             showRAM,
 		SharedBorders:       sharedBorders,
+		TilingGap:           tilingGap,
 		ZoomMaxWidth:        zoomMaxWidth,
 		ScrollbackLines:     scrollbackLines,
 		NoAnimations:        noAnimations,
@@ -254,6 +255,7 @@ func runLocal() error {
 	finalModel, err := p.Run()
 
 	if finalOS, ok := finalModel.(*app.OS); ok {
+		finalOS.RunShutdownCommand()
 		finalOS.Cleanup()
 	}
 
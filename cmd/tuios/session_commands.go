@@ -19,6 +19,8 @@ import (
 	"github.com/Gaurav-Gosain/tuios/internal/input"
 	"github.com/Gaurav-Gosain/tuios/internal/session"
 	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 func runAttach(sessionName string, createIfMissing bool) error {
@@ -129,6 +131,60 @@ func listSessionInfos(client *session.VerbClient) ([]session.SessionInfo, error)
 	return listed.Sessions, nil
 }
 
+// wantAttachOrNew reports whether a bare 'tuios' should try attaching first.
+// An explicit --attach-or-new (including --attach-or-new=false) wins over the
+// startup.auto_attach config key.
+func wantAttachOrNew(cmd *cobra.Command) bool {
+	if cmd.Flags().Changed("attach-or-new") {
+		return attachOrNew
+	}
+	userConfig, err := config.LoadUserConfig()
+	if err != nil {
+		return false
+	}
+	return userConfig.Startup.AutoAttach
+}
+
+// runAttachOrNew is 'tmux attach || tmux' in one step: attach to the most
+// recently active daemon session if there is one, otherwise start fresh
+// locally. It never starts the daemon just to find it empty.
+func runAttachOrNew() error {
+	// Attaching from inside a session would nest that session in itself.
+	if os.Getenv("TUIOS_SESSION") != "" || !session.IsDaemonRunning() {
+		return runLocal()
+	}
+
+	client, err := dialVerb()
+	if err != nil {
+		return runLocal()
+	}
+	sessions, err := listSessionInfos(client)
+	_ = client.Close()
+	if err != nil {
+		return runLocal()
+	}
+
+	name, ok := mostRecentSession(sessions)
+	if !ok {
+		return runLocal()
+	}
+	return runAttach(name, false)
+}
+
+// mostRecentSession returns the name of the session with the latest activity.
+func mostRecentSession(sessions []session.SessionInfo) (string, bool) {
+	var latest *session.SessionInfo
+	for i := range sessions {
+		if latest == nil || sessions[i].LastActive > latest.LastActive {
+			latest = &sessions[i]
+		}
+	}
+	if latest == nil {
+		return "", false
+	}
+	return latest.Name, true
+}
+
 func runNewSession(sessionName string) error {
 	if !session.IsDaemonRunning() {
 		fmt.Println("Starting TUIOS daemon...")
@@ -202,18 +258,11 @@ func runNewSessionDetached(sessionName string) error {
 	return nil
 }
 
+// generateUniqueSessionName names a session created without one, following
+// startup.session_name_format (session-N by default) and skipping every name
+// already in use.
 func generateUniqueSessionName(existingNames []string) string {
-	existing := make(map[string]bool)
-	for _, name := range existingNames {
-		existing[name] = true
-	}
-
-	for i := 0; ; i++ {
-		name := fmt.Sprintf("session-%d", i)
-		if !existing[name] {
-			return name
-		}
-	}
+	return config.NewSessionName(existingNames)
 }
 
 func runDaemonSession(sessionName string, createNew bool) error {
@@ -252,6 +301,7 @@ func runDaemonSession(sessionName string, createNew bool) error {
 		ShowCPU:             showCPU,
 		ShowRAM:             showRAM,
 		SharedBorders:       sharedBorders,
+		TilingGap:           tilingGap,
 		ZoomMaxWidth:        zoomMaxWidth,
 		ScrollbackLines:     scrollbackLines,
 		NoAnimations:        noAnimations,
@@ -304,6 +354,22 @@ func runDaemonSession(sessionName string, createNew bool) error {
 	}
 	log.Printf("[CLIENT] Attached to session, got state")
 
+	// An existing session whose windows all exited while it was detached has
+	// nothing to show. With on_empty_session_attach = "kill-session" it is reaped
+	// here, before the UI takes the screen; "spawn-shell" is handled by the
+	// startup preferences once the real terminal size is known.
+	attachedEmpty := client.AttachedExisting() && (state == nil || len(state.Windows) == 0)
+	if attachedEmpty && userConfig.Startup.OnEmptySessionAttach == config.EmptyAttachKillSession {
+		name := client.SessionName()
+		killErr := client.KillSession()
+		_ = client.Close()
+		if killErr != nil {
+			return fmt.Errorf("session %q has no windows, and killing it failed: %w", name, killErr)
+		}
+		fmt.Printf("Session %q had no windows left and was killed (startup.on_empty_session_attach).\n", name)
+		return nil
+	}
+
 	log.Printf("[CLIENT] Starting read loop")
 	client.StartReadLoop()
 
@@ -319,6 +385,7 @@ func runDaemonSession(sessionName string, createNew bool) error {
 		EnableGraphicsPassthrough: true,
 	})
 	initialOS.PostRenderWriter = prw
+	initialOS.AttachedToEmpty = attachedEmpty
 
 	windowCount := 0
 	if state != nil {
@@ -474,10 +541,13 @@ func runDaemonSession(sessionName string, createNew bool) error {
 		// Syncing state back is meaningful only for a detach, while the session
 		// still exists. A quit already killed it, a kill from elsewhere left no
 		// session to receive the state, and a lost connection cannot carry the
-		// write, so skip it rather than block on a dead socket on the way out.
+		// write, so skip it rather than block on a dead socket on the way out. A
+		// detach through leader d already synced before telling the daemon, so
+		// this only does anything for a client stopped by a signal.
 		if reason == app.ExitNormal && !killed {
 			finalOS.SyncStateToDaemon()
 		}
+		finalOS.RunShutdownCommand()
 		finalOS.Cleanup()
 	}
 
@@ -537,23 +607,10 @@ func runListSessions(jsonOutput bool) error {
 		return nil
 	}
 
-	client, err := dialVerb()
+	sessions, err := fetchSessions()
 	if err != nil {
 		return err
 	}
-	defer func() { _ = client.Close() }()
-
-	raw, err := client.Call("list-sessions", nil)
-	if err != nil {
-		return explainVerbError("list-sessions", err)
-	}
-	var listed struct {
-		Sessions []session.SessionInfo `json:"sessions"`
-	}
-	if err := json.Unmarshal(raw, &listed); err != nil {
-		return fmt.Errorf("failed to parse sessions: %w", err)
-	}
-	sessions := listed.Sessions
 
 	if jsonOutput {
 		data, err := json.MarshalIndent(sessions, "", "  ")
@@ -617,6 +674,27 @@ func runListSessions(jsonOutput bool) error {
 	return nil
 }
 
+// fetchSessions asks the running daemon for its live sessions.
+func fetchSessions() ([]session.SessionInfo, error) {
+	client, err := dialVerb()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = client.Close() }()
+
+	raw, err := client.Call("list-sessions", nil)
+	if err != nil {
+		return nil, explainVerbError("list-sessions", err)
+	}
+	var listed struct {
+		Sessions []session.SessionInfo `json:"sessions"`
+	}
+	if err := json.Unmarshal(raw, &listed); err != nil {
+		return nil, fmt.Errorf("failed to parse sessions: %w", err)
+	}
+	return listed.Sessions, nil
+}
+
 func formatTimeAgo(unixTime int64) string {
 	if unixTime == 0 {
 		return "-"
@@ -666,6 +744,43 @@ func runKillSession(sessionName string) error {
 	return nil
 }
 
+func runRenameSession(oldName, newName string) error {
+	client, err := dialVerb()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	if _, err := client.Call("rename-session", map[string]any{"session": oldName, "name": newName}); err != nil {
+		return explainVerbError("rename-session", err)
+	}
+
+	fmt.Printf("Renamed session '%s' to '%s'.\n", oldName, newName)
+	return nil
+}
+
+func runSaveSession(sessionName string) error {
+	client, err := dialVerb()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	raw, err := client.Call("save-session", map[string]any{"session": sessionName})
+	if err != nil {
+		return explainVerbError("save-session", err)
+	}
+	var res struct {
+		Windows int `json:"windows"`
+	}
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	fmt.Printf("Saved session '%s' (%d windows).\n", sessionName, res.Windows)
+	return nil
+}
+
 // runResurrect lists resurrectable sessions (no name) or restores one on demand
 // and attaches to it (name given).
 func runResurrect(sessionName string) error {
@@ -864,11 +979,15 @@ func runDaemon(foreground, disableAutoRestore bool) error {
 	return daemon.Run()
 }
 
-func runKillDaemon() error {
+func runKillDaemon(force bool) error {
 	diag := session.DiagnoseDaemon()
 
 	switch diag.State {
 	case session.DaemonRunning:
+		if !force && !confirmKillServer() {
+			fmt.Println("kill-server cancelled. Nothing was stopped.")
+			return nil
+		}
 		pid := diag.PID
 		if pid == 0 {
 			pid = session.GetDaemonPID()
@@ -906,6 +1025,58 @@ func runKillDaemon() error {
 	}
 }
 
+// confirmKillServer lists what stopping the daemon would end and asks before
+// going ahead. It only asks when stdin is a terminal and there is a session to
+// lose, so scripts and an idle daemon are stopped without a prompt.
+func confirmKillServer() bool {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return true
+	}
+	sessions, err := fetchSessions()
+	if err != nil {
+		// The daemon answers to a signal even when it cannot be queried, so
+		// ask without the summary rather than refusing to stop it.
+		fmt.Printf("Could not list the daemon's sessions: %v\n", err)
+	} else if len(sessions) == 0 {
+		return true
+	} else {
+		fmt.Print(killServerSummary(sessions))
+	}
+
+	fmt.Printf("Stop the TUIOS daemon? (yes/no): ")
+	var response string
+	_, _ = fmt.Scanln(&response)
+	response = strings.ToLower(strings.TrimSpace(response))
+	return response == "yes" || response == "y"
+}
+
+// killServerSummary describes the sessions and attached clients kill-server
+// would end, one line per session under a total.
+func killServerSummary(sessions []session.SessionInfo) string {
+	var windows, clients int
+	for _, s := range sessions {
+		windows += s.WindowCount
+		clients += s.Clients
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "This stops %s (%s) and disconnects %s:\n",
+		plural(len(sessions), "session"), plural(windows, "window"), plural(clients, "attached client"))
+	for _, s := range sessions {
+		fmt.Fprintf(&b, "  %s: %s, %s\n", s.Name, plural(s.WindowCount, "window"), plural(s.Clients, "client"))
+	}
+	b.WriteString("Programs running in those windows are ended. The layout is saved and restored when the daemon next starts.\n")
+	return b.String()
+}
+
+// plural formats n with noun, adding an s unless n is 1.
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
 // killServerTimeout bounds how long kill-server waits for the daemon to finish
 // persisting and exit. A shutdown that is going to succeed takes milliseconds;
 // the slow case is Daemon.shutdown's own 5s cap on draining goroutines, so this
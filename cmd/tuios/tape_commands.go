@@ -18,7 +18,7 @@ import (
 	"github.com/Gaurav-Gosain/tuios/internal/theme"
 )
 
-func runTapeInteractive(tapeFile string) error {
+func runTapeInteractive(tapeFile string, loop int, speed float64) error {
 	content, err := os.ReadFile(tapeFile)
 	if err != nil {
 		return fmt.Errorf("failed to read tape file: %w", err)
@@ -63,6 +63,8 @@ func runTapeInteractive(tapeFile string) error {
 	config.AnimationsEnabled = false
 
 	player := tape.NewPlayer(commands)
+	player.SetRuns(loop)
+	player.SetSpeed(speed)
 
 	initialOS := &app.OS{
 		FocusedWindow:        -1,
@@ -107,6 +109,7 @@ func runTapeInteractive(tapeFile string) error {
 	finalModel, err := p.Run()
 
 	if finalOS, ok := finalModel.(*app.OS); ok {
+		finalOS.RunShutdownCommand()
 		finalOS.Cleanup()
 	}
 
@@ -56,6 +56,89 @@ func (m *OS) CreateSnapAnimation(i int, quarter SnapQuarter) *ui.Animation {
 	return ui.NewSnapAnimation(window, targetX, targetY, targetWidth, targetHeight, config.GetAnimationDuration())
 }
 
+// startOpenAnimation grows a newly created window into place according to
+// config.WindowOpenAnimation. In tiling mode the window already has a snap
+// animation toward its tile, so that animation is retargeted to start from the
+// origin box instead of adding a second one that would fight over the geometry.
+func (m *OS) startOpenAnimation(window *terminal.Window) {
+	if config.WindowOpenAnimation == config.OpenAnimationNone {
+		return
+	}
+	duration := config.GetAnimationDuration()
+	if duration == 0 {
+		return
+	}
+
+	for _, anim := range m.Animations {
+		if anim.Window != window || anim.Type != ui.AnimationSnap {
+			continue
+		}
+		ox, oy := m.openAnimationOrigin(anim.EndX, anim.EndY, anim.EndWidth, anim.EndHeight)
+		anim.StartX, anim.StartY = max(ox-2, 0), max(oy-1, 0)
+		anim.StartWidth, anim.StartHeight = 5, 3
+		window.X, window.Y = anim.StartX, anim.StartY
+		window.Width, window.Height = anim.StartWidth, anim.StartHeight
+		window.MarkPositionDirty()
+		return
+	}
+
+	ox, oy := m.openAnimationOrigin(window.X, window.Y, window.Width, window.Height)
+	if anim := ui.NewOpenAnimation(window, ox, oy, duration); anim != nil {
+		m.Animations = append(m.Animations, anim)
+	}
+}
+
+// openAnimationOrigin returns the point a window with the given final bounds
+// grows out of: the last mouse position for "cursor" when one is known, and
+// the middle of the final bounds otherwise.
+func (m *OS) openAnimationOrigin(x, y, width, height int) (int, int) {
+	if config.WindowOpenAnimation == config.OpenAnimationCursor && m.LastMouseX > 0 && m.LastMouseY > 0 {
+		return m.LastMouseX, m.LastMouseY
+	}
+	return x + width/2, y + height/2
+}
+
+// startCloseAnimation shrinks the window at index i toward the dock according
+// to config.WindowCloseAnimation. It reports false when no animation runs, in
+// which case the caller deletes the window straight away; otherwise the window
+// is marked Closing and UpdateAnimations deletes it once the animation ends.
+func (m *OS) startCloseAnimation(i int) bool {
+	if config.WindowCloseAnimation == config.CloseAnimationNone {
+		return false
+	}
+	window := m.Windows[i]
+	if window.Minimized || window.Workspace != m.CurrentWorkspace {
+		return false
+	}
+
+	dockX, dockY := m.calculateDockPosition(i)
+	anim := ui.NewCloseAnimation(window, dockX, dockY, config.GetAnimationDuration())
+	if anim == nil {
+		return false
+	}
+
+	// The close animation owns the geometry from here on.
+	m.CancelAnimationsForWindow(window)
+	window.Closing = true
+	m.Animations = append(m.Animations, anim)
+
+	if i == m.FocusedWindow {
+		m.FocusNextVisibleWindow()
+	}
+	return true
+}
+
+// finishCloseAnimations deletes every window that is still playing its close
+// animation, for callers that are about to drop or fast-forward animations and
+// would otherwise leave a closing window on screen for good.
+func (m *OS) finishCloseAnimations() {
+	for i := len(m.Windows) - 1; i >= 0; i-- {
+		if m.Windows[i].Closing {
+			m.deleteWindowNow(i)
+		}
+	}
+}
+
 // HasActiveAnimations returns true if there are any active animations
 func (m *OS) HasActiveAnimations() bool {
 	return len(m.Animations) > 0
@@ -70,10 +153,11 @@ func (m *OS) CompleteWindowAnimations(windowIndex int) {
 
 	window := m.Windows[windowIndex]
 
-	// Find and complete all animations for this window
+	// Find and complete all animations for this window. A close animation is
+	// left alone: completing it early would leave the window parked at the dock.
 	for i := len(m.Animations) - 1; i >= 0; i-- {
 		anim := m.Animations[i]
-		if anim.Window == window {
+		if anim.Window == window && anim.Type != ui.AnimationClose {
 			// Snap window to final position immediately
 			anim.Window.X = anim.EndX
 			anim.Window.Y = anim.EndY
@@ -92,10 +176,11 @@ func (m *OS) CompleteWindowAnimations(windowIndex int) {
 }
 
 // CancelAnimationsForWindow removes all pending animations for a window
-// without completing them (the caller will set the new position).
+// without completing them (the caller will set the new position). A close
+// animation is kept, since the window is deleted when it ends.
 func (m *OS) CancelAnimationsForWindow(w *terminal.Window) {
 	for i := len(m.Animations) - 1; i >= 0; i-- {
-		if m.Animations[i].Window == w {
+		if m.Animations[i].Window == w && m.Animations[i].Type != ui.AnimationClose {
 			m.Animations = slices.Delete(m.Animations, i, i+1)
 		}
 	}
@@ -104,6 +189,8 @@ func (m *OS) CancelAnimationsForWindow(w *terminal.Window) {
 // CompleteAllAnimations immediately completes all active animations
 // This is used in tiling mode to prevent state conflicts when starting a new drag
 func (m *OS) CompleteAllAnimations() {
+	m.finishCloseAnimations()
+
 	// Complete all animations by snapping windows to their final positions
 	for i := len(m.Animations) - 1; i >= 0; i-- {
 		anim := m.Animations[i]
@@ -128,6 +215,10 @@ func (m *OS) CompleteAllAnimations() {
 
 // UpdateAnimations updates all active animations and applies their effects.
 func (m *OS) UpdateAnimations() {
+	// Windows whose close animation finished are deleted after the loop, since
+	// deleting one removes its animations and would shift the indices below.
+	var closed []*terminal.Window
+
 	// Update animations in reverse order so we can safely remove completed ones
 	for i := len(m.Animations) - 1; i >= 0; i-- {
 		anim := m.Animations[i]
@@ -169,10 +260,20 @@ func (m *OS) UpdateAnimations() {
 				)
 			}
 
+			if anim.Type == ui.AnimationClose {
+				closed = append(closed, anim.Window)
+			}
+
 			// Remove completed animation
 			m.Animations = append(m.Animations[:i], m.Animations[i+1:]...)
 		}
 	}
+
+	for _, window := range closed {
+		if idx := slices.Index(m.Windows, window); idx >= 0 {
+			m.deleteWindowNow(idx)
+		}
+	}
 }
 
 // calculateDockPosition calculates the position in the dock for a minimized window
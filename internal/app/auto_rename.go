@@ -0,0 +1,58 @@
+package app
+
+import (
+	"time"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// updateAutoNames names windows after their foreground command when
+// config.AutoRenameFromCommand is on, and drops the names it gave once it is
+// turned off. Called on the maintenance tick; the PTYs are only asked every
+// config.AutoRenameInterval.
+func (m *OS) updateAutoNames() {
+	if !config.AutoRenameFromCommand {
+		changed := false
+		for _, w := range m.Windows {
+			changed = autoRenameWindow(w, "") || changed
+		}
+		if changed {
+			m.MarkAllDirty()
+		}
+		return
+	}
+	if time.Since(m.autoRenameChecked) < config.AutoRenameInterval {
+		return
+	}
+	m.autoRenameChecked = time.Now()
+
+	changed := false
+	for _, w := range m.Windows {
+		if w.CustomName != "" && !w.AutoNamed {
+			continue
+		}
+		if cmd := w.ForegroundCommand(); cmd != "" {
+			changed = autoRenameWindow(w, cmd) || changed
+		}
+	}
+	if changed {
+		m.MarkAllDirty()
+	}
+}
+
+// autoRenameWindow gives w the name cmd, or clears its automatic name when cmd
+// is empty. A name the user set is left alone. It reports whether the name
+// changed.
+func autoRenameWindow(w *terminal.Window, cmd string) bool {
+	if w.CustomName != "" && !w.AutoNamed {
+		return false
+	}
+	if w.CustomName == cmd {
+		return false
+	}
+	w.CustomName = cmd
+	w.AutoNamed = cmd != ""
+	w.InvalidateCache()
+	return true
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// TestAutoRenameFollowsCommandUntilRenamed checks a window without a name takes
+// its foreground command's, follows it as the command changes, keeps a name the
+// user gives it, loses the automatic one when the option is turned off, and
+// saves it with the session.
+func TestAutoRenameFollowsCommandUntilRenamed(t *testing.T) {
+	win := newTestWindow(t, "autoname-0001", 40, 10)
+	m := newTestOS(win)
+
+	if !autoRenameWindow(win, "bash") || win.CustomName != "bash" || !win.AutoNamed {
+		t.Fatalf("unnamed window not named after its command: name %q, auto %v", win.CustomName, win.AutoNamed)
+	}
+	autoRenameWindow(win, "vim")
+	if win.CustomName != "vim" {
+		t.Errorf("name did not follow the command to vim, got %q", win.CustomName)
+	}
+	if autoRenameWindow(win, "vim") {
+		t.Error("an unchanged command reported a rename")
+	}
+
+	prev := config.AutoRenameFromCommand
+	t.Cleanup(func() { config.AutoRenameFromCommand = prev })
+	config.AutoRenameFromCommand = false
+	m.updateAutoNames()
+	if win.CustomName != "" || win.AutoNamed {
+		t.Errorf("turning auto-rename off left name %q, auto %v", win.CustomName, win.AutoNamed)
+	}
+
+	autoRenameWindow(win, "bash")
+	if err := m.RenameWindowByID(win.ID, "logs"); err != nil {
+		t.Fatal(err)
+	}
+	if win.AutoNamed {
+		t.Error("a manual rename is still marked automatic")
+	}
+	if autoRenameWindow(win, "vim") || win.CustomName != "logs" {
+		t.Errorf("auto-rename replaced the manual name with %q", win.CustomName)
+	}
+	m.updateAutoNames()
+	if win.CustomName != "logs" {
+		t.Errorf("turning auto-rename off cleared the manual name, got %q", win.CustomName)
+	}
+
+	// Clearing the name by hand hands the window back to auto-rename.
+	if err := m.RenameWindowByID(win.ID, ""); err != nil {
+		t.Fatal(err)
+	}
+	autoRenameWindow(win, "htop")
+	if ws := m.BuildSessionState().Windows[0]; ws.CustomName != "htop" || !ws.AutoNamed {
+		t.Errorf("session state lost the automatic name: %q, auto %v", ws.CustomName, ws.AutoNamed)
+	}
+}
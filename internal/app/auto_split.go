@@ -0,0 +1,35 @@
+package app
+
+import (
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// splitSecondWindow applies config.AutoTileFromSecondWindow to w, a window
+// that has just opened: when it is the second window on its floating
+// workspace, the first is snapped to the left half and w to the right. Tiling
+// mode is left off.
+func (m *OS) splitSecondWindow(w *terminal.Window) {
+	if !config.AutoTileFromSecondWindow || m.AutoTiling {
+		return
+	}
+	first, newIdx := -1, -1
+	for i, other := range m.Windows {
+		if other.Workspace != w.Workspace || other.Minimized || other.Minimizing || other.Closing {
+			continue
+		}
+		if other == w {
+			newIdx = i
+			continue
+		}
+		if first >= 0 {
+			return // a third window: leave the floating layout alone
+		}
+		first = i
+	}
+	if first < 0 || newIdx < 0 {
+		return
+	}
+	m.Snap(first, SnapLeft)
+	m.Snap(newIdx, SnapRight)
+}
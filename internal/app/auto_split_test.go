@@ -0,0 +1,49 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// TestSecondWindowSplitsTheScreen opens windows with
+// auto_tile_from_second_window on: the second one puts the two side by side
+// without turning tiling on, and a third floats as usual.
+func TestSecondWindowSplitsTheScreen(t *testing.T) {
+	prevSplit, prevAnim := config.AutoTileFromSecondWindow, config.AnimationsEnabled
+	config.AutoTileFromSecondWindow, config.AnimationsEnabled = true, false
+	t.Cleanup(func() { config.AutoTileFromSecondWindow, config.AnimationsEnabled = prevSplit, prevAnim })
+
+	m := newStartupOS(t, false, false)
+	defer closeWindows(m)
+
+	m.AddWindow("first")
+	first := m.Windows[0]
+	x, y, w, h := first.X, first.Y, first.Width, first.Height
+
+	m.AddWindow("second")
+	second := m.Windows[1]
+	if m.AutoTiling {
+		t.Error("the split turned tiling mode on")
+	}
+	lx, ly, lw, lh := m.calculateSnapBounds(SnapLeft)
+	if first.X != lx || first.Y != ly || first.Width != lw || first.Height != lh {
+		t.Errorf("first window at %d,%d %dx%d, want the left half %d,%d %dx%d",
+			first.X, first.Y, first.Width, first.Height, lx, ly, lw, lh)
+	}
+	rx, _, _, _ := m.calculateSnapBounds(SnapRight)
+	if second.X != rx || second.Width != m.GetRenderWidth()-rx {
+		t.Errorf("second window at x %d width %d, want the right half from %d", second.X, second.Width, rx)
+	}
+	if x == first.X && y == first.Y && w == first.Width && h == first.Height {
+		t.Error("first window did not move")
+	}
+
+	m.AddWindow("third")
+	if third := m.Windows[2]; third.X == rx && third.Width == second.Width {
+		t.Error("a third window should float, not be snapped")
+	}
+	if first.X != lx || second.X != rx {
+		t.Error("the third window moved the split pair")
+	}
+}
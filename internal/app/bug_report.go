@@ -0,0 +1,136 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// WriteBugReport writes what a maintainer needs to act on a bug report to a
+// single timestamped file next to the crash logs and returns its path: the
+// build and platform, the screen and every window's geometry, the config in
+// effect and the messages held by the log viewer.
+func (m *OS) WriteBugReport() (string, error) {
+	dir := CrashLogDir()
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return "", fmt.Errorf("create report directory: %w", err)
+	}
+
+	now := time.Now()
+	path := filepath.Join(dir, fmt.Sprintf("report-%s.txt", now.Format("2006-01-02_15-04-05")))
+
+	var b strings.Builder
+	b.WriteString("tuios bug report\n")
+	b.WriteString("================\n\n")
+	fmt.Fprintf(&b, "Time:    %s\n", now.Format(time.RFC3339))
+	writeBuildInfo(&b)
+	fmt.Fprintf(&b, "OS/Arch: %s/%s\n", runtime.GOOS, runtime.GOARCH)
+	fmt.Fprintf(&b, "TERM:    %s\n", os.Getenv("TERM"))
+
+	b.WriteString("\nSession\n-------\n")
+	if m.IsDaemonSession {
+		fmt.Fprintf(&b, "Daemon session: %s\n", m.SessionName)
+	} else {
+		b.WriteString("Local session\n")
+	}
+	fmt.Fprintf(&b, "Mode:      %s\n", m.modeName())
+	fmt.Fprintf(&b, "Layout:    %s\n", m.LayoutName())
+	fmt.Fprintf(&b, "Workspace: %d of %d\n", m.CurrentWorkspace, m.NumWorkspaces)
+
+	b.WriteString("\nScreen\n------\n")
+	fmt.Fprintf(&b, "Terminal: %dx%d\n", m.Width, m.Height)
+	fmt.Fprintf(&b, "Render:   %dx%d (usable height %d)\n", m.GetRenderWidth(), m.GetRenderHeight(), m.GetUsableHeight())
+	if m.EffectiveWidth > 0 || m.EffectiveHeight > 0 {
+		fmt.Fprintf(&b, "Effective (all clients): %dx%d\n", m.EffectiveWidth, m.EffectiveHeight)
+	}
+
+	fmt.Fprintf(&b, "\nWindows (%d)\n-----------\n", len(m.Windows))
+	for i, w := range m.Windows {
+		var flags []string
+		if i == m.FocusedWindow {
+			flags = append(flags, "focused")
+		}
+		if w.Tiled {
+			flags = append(flags, "tiled")
+		}
+		if w.IsFloating {
+			flags = append(flags, "floating")
+		}
+		if w.Minimized {
+			flags = append(flags, "minimized")
+		}
+		if w.IsAltScreen() {
+			flags = append(flags, "alt-screen")
+		}
+		fmt.Fprintf(&b, "%d. %q ws=%d at %d,%d size %dx%d content %dx%d %s\n",
+			i+1, w.Title(), w.Workspace, w.X, w.Y, w.Width, w.Height,
+			w.ContentWidth(), w.ContentHeight(), strings.Join(flags, ","))
+	}
+
+	b.WriteString("\nConfig\n------\n")
+	if m.UserConfig == nil {
+		b.WriteString("(defaults, no config loaded)\n")
+	} else if data, err := toml.Marshal(m.UserConfig); err != nil {
+		fmt.Fprintf(&b, "(could not encode: %v)\n", err)
+	} else {
+		b.Write(data)
+	}
+
+	fmt.Fprintf(&b, "\nLogs (%d)\n--------\n", len(m.LogMessages))
+	for _, msg := range m.LogMessages {
+		b.WriteString(msg.String())
+		b.WriteByte('\n')
+	}
+
+	if err := os.WriteFile(path, []byte(b.String()), 0600); err != nil {
+		return "", fmt.Errorf("write report: %w", err)
+	}
+	return path, nil
+}
+
+// writeBuildInfo adds the version, commit and Go release the binary was built
+// with, as far as the build recorded them.
+func writeBuildInfo(b *strings.Builder) {
+	fmt.Fprintf(b, "Go:      %s\n", runtime.Version())
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return
+	}
+	fmt.Fprintf(b, "Version: %s\n", info.Main.Version)
+	for _, s := range info.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			fmt.Fprintf(b, "Commit:  %s\n", s.Value)
+		case "vcs.modified":
+			if s.Value == "true" {
+				b.WriteString("Modified: yes\n")
+			}
+		}
+	}
+}
+
+// modeName names the input mode for the report.
+func (m *OS) modeName() string {
+	if m.Mode == TerminalMode {
+		return "terminal"
+	}
+	return "window management"
+}
+
+// SaveBugReport writes a bug report and says where it went, or why it could
+// not be written. Shared by the debug prefix and the command palette.
+func (m *OS) SaveBugReport() {
+	path, err := m.WriteBugReport()
+	if err != nil {
+		m.ShowNotification("Bug report: "+err.Error(), "error", config.NotificationDuration)
+		return
+	}
+	m.ShowNotification("Bug report saved to "+path, "info", config.NotificationDuration)
+}
@@ -0,0 +1,50 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/adrg/xdg"
+)
+
+// TestWriteBugReportBundlesState writes a report into a temp state dir and
+// checks each part a maintainer relies on made it into the one file.
+func TestWriteBugReportBundlesState(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	xdg.Reload()
+	t.Cleanup(xdg.Reload)
+
+	w := newTestWindow(t, "window-a", 40, 12)
+	w.X, w.Y = 3, 2
+	m := newTestOS(w)
+	m.Width, m.Height = 120, 40
+	m.UserConfig = config.DefaultConfig()
+	m.Log("ERROR", "pty closed")
+
+	path, err := m.WriteBugReport()
+	if err != nil {
+		t.Fatalf("WriteBugReport: %v", err)
+	}
+	if filepath.Dir(path) != CrashLogDir() {
+		t.Errorf("report written to %s, want a file in %s", path, CrashLogDir())
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read report: %v", err)
+	}
+	for _, want := range []string{
+		"OS/Arch:",
+		"Terminal: 120x40",
+		"Windows (1)",
+		"at 3,2 size 40x12 content 38x10 focused",
+		"[appearance]",
+		"[ERROR] pty closed",
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("report is missing %q:\n%s", want, data)
+		}
+	}
+}
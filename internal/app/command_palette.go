@@ -2,20 +2,33 @@ package app
 
 import (
 	"strings"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/layout"
 )
 
 // ConfigReloadedMsg carries a config parsed by the file watcher goroutine so it
 // can be applied on the Bubble Tea goroutine. The watcher must not touch the
 // appearance globals directly (the render loop reads them concurrently); it
 // delivers this message via the program's Send instead, and Update applies it
-// with config.ApplyAppearanceConfig.
+// with applyReloadedConfig.
 type ConfigReloadedMsg struct {
 	Config *config.UserConfig
 }
 
+// applyReloadedConfig applies a reloaded config's appearance globals and
+// brings live windows in line with the settings that size them.
+func (m *OS) applyReloadedConfig(cfg *config.UserConfig) {
+	prevPadding := config.WindowContentPadding
+	config.ApplyAppearanceConfig(cfg)
+	if config.WindowContentPadding != prevPadding {
+		m.applyContentPadding()
+	}
+	m.MarkAllDirty()
+}
+
 // CommandPaletteItem represents a single command in the command palette.
 type CommandPaletteItem struct {
 	Name     string // Display name: "Split Horizontal"
@@ -53,13 +66,17 @@ func GetCommandPaletteItems() []CommandPaletteItem {
 			Shortcut: "prefix+r",
 			Category: "Window",
 			Action: func(m *OS) (*OS, tea.Cmd) {
-				if config.WindowTitlePosition != "hidden" && len(m.Windows) > 0 && m.FocusedWindow >= 0 {
-					focusedWindow := m.GetFocusedWindow()
-					if focusedWindow != nil {
-						m.Mode = WindowManagementMode
-						m.RenamingWindow = true
-						m.RenameBuffer = focusedWindow.CustomName
-					}
+				m.StartRename()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Reload Window",
+			Shortcut: "prefix+t R",
+			Category: "Window",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				if err := m.ReloadFocusedWindow(); err != nil {
+					m.ShowNotification("Reload failed: "+err.Error(), "warning", config.NotificationDuration)
 				}
 				return m, nil
 			},
@@ -197,6 +214,30 @@ func GetCommandPaletteItems() []CommandPaletteItem {
 				return m, nil
 			},
 		},
+		{
+			Name:     "Equalize Focused Split",
+			Shortcut: "prefix+_",
+			Category: "Layout",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				if m.EqualizeFocusedSiblings() {
+					m.ShowNotification("Split Equalized", "info", config.NotificationDuration)
+				}
+				return m, nil
+			},
+		},
+		{
+			Name:     "Retile Workspace",
+			Shortcut: "prefix+E",
+			Category: "Layout",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				if m.RetileNow() {
+					m.ShowNotification("Layout Retiled", "success", config.NotificationDuration)
+				} else {
+					m.ShowNotification("Tiling is off", "warning", config.NotificationDuration)
+				}
+				return m, nil
+			},
+		},
 		{
 			Name:     "Snap Fullscreen",
 			Shortcut: "prefix+z",
@@ -236,6 +277,84 @@ func GetCommandPaletteItems() []CommandPaletteItem {
 				return m, nil
 			},
 		},
+		{
+			Name:     "Arrange in Columns",
+			Shortcut: "prefix+L c",
+			Category: "Layout",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.ApplyLayoutPreset(layout.PresetColumns)
+				return m, nil
+			},
+		},
+		{
+			Name:     "Arrange in Rows",
+			Shortcut: "prefix+L r",
+			Category: "Layout",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.ApplyLayoutPreset(layout.PresetRows)
+				return m, nil
+			},
+		},
+		{
+			Name:     "Arrange in Grid",
+			Shortcut: "prefix+L g",
+			Category: "Layout",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.ResetToGrid()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Toggle Monocle",
+			Shortcut: "prefix+L m",
+			Category: "Layout",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				if !m.ToggleMonocle() {
+					m.ShowNotification("Monocle needs BSP or master-stack tiling", "info", config.NotificationDuration)
+				}
+				return m, nil
+			},
+		},
+		{
+			Name:     "Flip Layout Horizontally",
+			Shortcut: "prefix+L h",
+			Category: "Layout",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				if !m.FlipLayoutHorizontal() {
+					m.ShowNotification("Flipping needs BSP tiling with two or more windows", "info", config.NotificationDuration)
+				}
+				return m, nil
+			},
+		},
+		{
+			Name:     "Flip Layout Vertically",
+			Shortcut: "prefix+L v",
+			Category: "Layout",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				if !m.FlipLayoutVertical() {
+					m.ShowNotification("Flipping needs BSP tiling with two or more windows", "info", config.NotificationDuration)
+				}
+				return m, nil
+			},
+		},
+		{
+			Name:     "Increase Tiling Gap",
+			Shortcut: "prefix+L +",
+			Category: "Layout",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.AdjustTilingGap(1)
+				return m, nil
+			},
+		},
+		{
+			Name:     "Decrease Tiling Gap",
+			Shortcut: "prefix+L -",
+			Category: "Layout",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.AdjustTilingGap(-1)
+				return m, nil
+			},
+		},
 
 		// Navigation
 		{
@@ -256,6 +375,140 @@ func GetCommandPaletteItems() []CommandPaletteItem {
 				return m, nil
 			},
 		},
+		{
+			Name:     "Next Floating Window",
+			Shortcut: "prefix+t f",
+			Category: "Navigation",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.CycleToNextFloatingWindow()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Next Tiled Window",
+			Shortcut: "prefix+t T",
+			Category: "Navigation",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.CycleToNextTiledWindow()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Promote to Master",
+			Shortcut: "prefix+t enter",
+			Category: "Layout",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.PromoteToMaster()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Cycle Master Ratio",
+			Shortcut: "prefix+t m",
+			Category: "Layout",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.CycleMasterRatio()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Compare Windows",
+			Shortcut: "prefix+t d",
+			Category: "Window",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.StartCompare()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Peek at Workspace",
+			Shortcut: "prefix+w p",
+			Category: "Navigation",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				if m.PeekedWorkspace != 0 {
+					m.ClosePeek()
+					return m, nil
+				}
+				m.PeekPrefixActive = true
+				m.PrefixActive = true
+				m.LastPrefixTime = time.Now()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Scrollback in Pager",
+			Shortcut: "prefix+t p",
+			Category: "Window",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				if err := m.OpenScrollbackInPager(false); err != nil {
+					m.ShowNotification("Scrollback: "+err.Error(), "error", config.NotificationDuration)
+				}
+				return m, nil
+			},
+		},
+		{
+			Name:     "Scrollback in Editor",
+			Shortcut: "prefix+t e",
+			Category: "Window",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				if err := m.OpenScrollbackInPager(true); err != nil {
+					m.ShowNotification("Scrollback: "+err.Error(), "error", config.NotificationDuration)
+				}
+				return m, nil
+			},
+		},
+		{
+			Name:     "Toggle Frozen Header",
+			Shortcut: "prefix+t h",
+			Category: "Window",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				fw := m.GetFocusedWindow()
+				switch {
+				case fw != nil && fw.FrozenHeaderLines > 0:
+					m.FreezeHeader(0)
+					m.ShowNotification("Header unfrozen", "info", config.NotificationDuration)
+				case m.FreezeHeader(1):
+					m.ShowNotification("Froze the top row", "info", config.NotificationDuration)
+				}
+				return m, nil
+			},
+		},
+		{
+			Name:     "Invert Window Colors",
+			Shortcut: "prefix+t i",
+			Category: "Window",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.ToggleInvert()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Last Window",
+			Shortcut: "prefix+;",
+			Category: "Navigation",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.FocusLastWindow()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Jump to Window by Name",
+			Shortcut: "prefix+f",
+			Category: "Navigation",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.StartWindowJump()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Show Pane Numbers",
+			Shortcut: "prefix+#",
+			Category: "Navigation",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.ShowPaneNumbers()
+				return m, nil
+			},
+		},
 		{
 			Name:     "Workspace 1",
 			Shortcut: "prefix+w 1",
@@ -357,12 +610,58 @@ func GetCommandPaletteItems() []CommandPaletteItem {
 		},
 		{
 			Name:     "Toggle Multifocus",
+			Shortcut: "prefix+b",
 			Category: "Window",
 			Action: func(m *OS) (*OS, tea.Cmd) {
 				m.ToggleMultifocus(m.FocusedWindow)
 				return m, nil
 			},
 		},
+		{
+			Name:     "Multifocus Workspace",
+			Shortcut: "prefix+B",
+			Category: "Window",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.MultifocusWorkspace()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Toggle Window Numbers in Titles",
+			Shortcut: "prefix+I",
+			Category: "Window",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.ToggleWindowIndices()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Toggle Synchronized Scroll",
+			Shortcut: "prefix+V",
+			Category: "Window",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.ToggleSyncScroll()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Link Scroll With Last Window",
+			Shortcut: "prefix+l",
+			Category: "Window",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.LinkScrollWithLastWindow()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Unlink Scroll",
+			Shortcut: "prefix+U",
+			Category: "Window",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.UnlinkFocusedScroll()
+				return m, nil
+			},
+		},
 		{
 			Name:     "Clear Multifocus",
 			Category: "Window",
@@ -484,6 +783,15 @@ func GetCommandPaletteItems() []CommandPaletteItem {
 				return m, nil
 			},
 		},
+		{
+			Name:     "Cycle Themes",
+			Shortcut: "prefix+>",
+			Category: "Session",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.CycleTheme(1)
+				return m, nil
+			},
+		},
 		{
 			Name:     "Reload Config",
 			Category: "Session",
@@ -500,7 +808,7 @@ func GetCommandPaletteItems() []CommandPaletteItem {
 				}
 				// Runs on the Bubble Tea goroutine, so applying the appearance
 				// globals here is single-threaded and takes effect immediately.
-				config.ApplyAppearanceConfig(newCfg)
+				m.applyReloadedConfig(newCfg)
 				m.ShowNotification("Config reloaded", "success", 0)
 				return m, nil
 			},
@@ -540,6 +848,24 @@ func GetCommandPaletteItems() []CommandPaletteItem {
 				return m, nil
 			},
 		},
+		{
+			Name:     "Export Logs",
+			Shortcut: "",
+			Category: "Session",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.SaveLogs()
+				return m, nil
+			},
+		},
+		{
+			Name:     "Generate Bug Report",
+			Shortcut: "prefix+D r",
+			Category: "Session",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				m.SaveBugReport()
+				return m, nil
+			},
+		},
 		{
 			Name:     "Toggle Scrollback Browser",
 			Shortcut: "prefix+s",
@@ -620,6 +946,25 @@ func GetCommandPaletteItems() []CommandPaletteItem {
 				return m, nil
 			},
 		},
+		{
+			Name:     "Copy Working Directory",
+			Shortcut: "prefix+C",
+			Category: "Session",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				return m, m.CopyFocusedCwd()
+			},
+		},
+		{
+			Name:     "Find in Window",
+			Shortcut: "prefix+/",
+			Category: "Session",
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				if focusedWindow := m.GetFocusedWindow(); focusedWindow != nil {
+					focusedWindow.EnterQuickFind()
+				}
+				return m, nil
+			},
+		},
 	}
 }
 
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"strings"
+
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// withContentPadding surrounds w's rendered terminal with its content padding:
+// blank rows above and below and blank columns either side, so the output
+// does not butt against the border.
+func withContentPadding(w *terminal.Window, content string) string {
+	pad := w.ContentPadding()
+	if pad == 0 {
+		return content
+	}
+	width := w.ContentWidth()
+	side := strings.Repeat(" ", pad)
+	blank := strings.Repeat(" ", width+2*pad)
+
+	lines := strings.Split(content, "\n")
+	padded := make([]string, 0, len(lines)+2*pad)
+	for range pad {
+		padded = append(padded, blank)
+	}
+	for _, line := range lines {
+		fill := max(width-ansi.StringWidth(line), 0)
+		padded = append(padded, side+line+strings.Repeat(" ", fill)+side)
+	}
+	for range pad {
+		padded = append(padded, blank)
+	}
+	return strings.Join(padded, "\n")
+}
+
+// applyContentPadding resizes every window's terminal to fit a changed
+// content padding, keeping the windows' outer size.
+func (m *OS) applyContentPadding() {
+	for _, w := range m.Windows {
+		w.Resize(w.Width, w.Height)
+	}
+	m.MarkAllDirty()
+}
@@ -0,0 +1,79 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// TestContentPadding checks padding shrinks the terminal, shifts the content
+// origin used by mouse mapping, and renders as blank cells inside the border.
+func TestContentPadding(t *testing.T) {
+	original := config.WindowContentPadding
+	defer func() { config.WindowContentPadding = original }()
+
+	w := newTestWindow(t, "window-a", 40, 12)
+	m := newTestOS(w)
+	config.WindowContentPadding = 1
+	m.applyContentPadding()
+
+	if got, want := w.ContentWidth(), 36; got != want {
+		t.Errorf("content width %d, want %d", got, want)
+	}
+	if got, want := w.ContentHeight(), 8; got != want {
+		t.Errorf("content height %d, want %d", got, want)
+	}
+	if w.Terminal.Width() != 36 || w.Terminal.Height() != 8 {
+		t.Errorf("terminal is %dx%d, want 36x8", w.Terminal.Width(), w.Terminal.Height())
+	}
+	if _, _, ok := w.ScreenToTerminal(w.X+1, w.Y+1); ok {
+		t.Error("the padding cell inside the border maps into the terminal")
+	}
+	if x, y, ok := w.ScreenToTerminal(w.X+2, w.Y+2); !ok || x != 0 || y != 0 {
+		t.Errorf("first content cell maps to %d,%d (inside %v), want 0,0", x, y, ok)
+	}
+
+	w.WriteOutput([]byte("hello"))
+	lines := strings.Split(withContentPadding(w, m.renderTerminal(w, true, true)), "\n")
+	if len(lines) != 10 {
+		t.Fatalf("padded content has %d rows, want 10", len(lines))
+	}
+	if got := ansi.Strip(lines[0]); strings.TrimSpace(got) != "" || ansi.StringWidth(got) != 38 {
+		t.Errorf("top padding row %q, want 38 blanks", got)
+	}
+	if got := ansi.Strip(lines[1]); !strings.HasPrefix(got, " hello") || ansi.StringWidth(got) != 38 {
+		t.Errorf("first content row %q, want a blank then the output, 38 wide", got)
+	}
+
+	config.WindowContentPadding = 4
+	small := newTestWindow(t, "window-b", 6, 6)
+	if got := small.ContentPadding(); got != 1 {
+		t.Errorf("padding %d in a 4x4 interior, want it cut to 1", got)
+	}
+}
+
+// TestContentPaddingConfigReload checks a config reload that changes the
+// padding resizes the live windows' terminals, not just the new ones.
+func TestContentPaddingConfigReload(t *testing.T) {
+	original := config.WindowContentPadding
+	defer func() { config.WindowContentPadding = original }()
+	config.WindowContentPadding = 0
+
+	w := newTestWindow(t, "window-a", 40, 12)
+	m := newTestOS(w)
+
+	cfg := config.DefaultConfig()
+	cfg.Appearance.WindowContentPadding = 2
+	m.Update(ConfigReloadedMsg{Config: cfg})
+	if w.Terminal.Width() != 34 || w.Terminal.Height() != 6 {
+		t.Errorf("terminal is %dx%d after the reload, want 34x6", w.Terminal.Width(), w.Terminal.Height())
+	}
+
+	cfg.Appearance.WindowContentPadding = 0
+	m.Update(ConfigReloadedMsg{Config: cfg})
+	if w.Terminal.Width() != 38 || w.Terminal.Height() != 10 {
+		t.Errorf("terminal is %dx%d after removing the padding, want 38x10", w.Terminal.Width(), w.Terminal.Height())
+	}
+}
@@ -0,0 +1,234 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/theme"
+)
+
+// ContextMenuItem is one row of the right-click menu. An item with a Submenu
+// replaces the menu's rows with the ones it returns instead of acting.
+type ContextMenuItem struct {
+	Label   string
+	Submenu func(m *OS) []ContextMenuItem
+	Action  func(m *OS) (*OS, tea.Cmd)
+}
+
+// ContextMenu is the open right-click menu, anchored at the cell that was
+// clicked. Its window actions apply to the focused window, which right-click
+// on a title bar focuses first.
+type ContextMenu struct {
+	Title    string
+	Items    []ContextMenuItem
+	Selected int
+	X, Y     int
+}
+
+// OpenWindowContextMenu focuses the window at index and opens its menu at the
+// given cell.
+func (m *OS) OpenWindowContextMenu(index, x, y int) {
+	if index < 0 || index >= len(m.Windows) {
+		return
+	}
+	m.FocusWindow(index)
+	win := m.Windows[index]
+
+	items := []ContextMenuItem{
+		{Label: "New Window", Action: func(m *OS) (*OS, tea.Cmd) { return m.AddWindow(""), nil }},
+	}
+	if config.WindowTitlePosition != "hidden" {
+		items = append(items, ContextMenuItem{Label: "Rename", Action: func(m *OS) (*OS, tea.Cmd) {
+			m.StartRename()
+			return m, nil
+		}})
+	}
+	items = append(items, ContextMenuItem{Label: "Minimize", Action: func(m *OS) (*OS, tea.Cmd) {
+		if m.FocusedWindow >= 0 {
+			m.MinimizeWindow(m.FocusedWindow)
+		}
+		return m, nil
+	}})
+	if m.AutoTiling {
+		items = append(items,
+			ContextMenuItem{Label: "Split Horizontal", Action: func(m *OS) (*OS, tea.Cmd) {
+				m.SplitFocusedHorizontal()
+				return m, nil
+			}},
+			ContextMenuItem{Label: "Split Vertical", Action: func(m *OS) (*OS, tea.Cmd) {
+				m.SplitFocusedVertical()
+				return m, nil
+			}},
+		)
+	}
+	if m.workspaceLimit() > 1 {
+		items = append(items, ContextMenuItem{Label: "Move to Workspace ▸", Submenu: workspaceMenuItems})
+	}
+	items = append(items, ContextMenuItem{Label: "Close", Action: func(m *OS) (*OS, tea.Cmd) {
+		if m.FocusedWindow >= 0 {
+			m.DeleteWindow(m.FocusedWindow)
+		}
+		return m, nil
+	}})
+
+	m.ContextMenu = &ContextMenu{Title: tooltipWindowName(win), Items: items, X: x, Y: y}
+}
+
+// OpenDesktopContextMenu opens the menu for a click on empty space. A window
+// created from it is placed at the clicked cell, as an empty_click_action
+// spawn would be.
+func (m *OS) OpenDesktopContextMenu(x, y int) {
+	items := []ContextMenuItem{
+		{Label: "New Window", Action: func(m *OS) (*OS, tea.Cmd) {
+			m.LastMouseX, m.LastMouseY = x, y
+			return m.AddWindow(""), nil
+		}},
+		{Label: "Toggle Tiling", Action: func(m *OS) (*OS, tea.Cmd) {
+			m.ToggleAutoTiling()
+			return m, nil
+		}},
+		{Label: "Settings", Action: func(m *OS) (*OS, tea.Cmd) {
+			m.OpenSettings()
+			return m, nil
+		}},
+	}
+	m.ContextMenu = &ContextMenu{Title: "Desktop", Items: items, X: x, Y: y}
+}
+
+// workspaceMenuItems lists the workspaces the focused window can be moved to.
+func workspaceMenuItems(m *OS) []ContextMenuItem {
+	var items []ContextMenuItem
+	for ws := 1; ws <= m.workspaceLimit(); ws++ {
+		if ws == m.CurrentWorkspace {
+			continue
+		}
+		items = append(items, ContextMenuItem{
+			Label: fmt.Sprintf("Workspace %d", ws),
+			Action: func(m *OS) (*OS, tea.Cmd) {
+				if m.FocusedWindow >= 0 {
+					m.MoveWindowToWorkspace(m.FocusedWindow, ws)
+				}
+				return m, nil
+			},
+		})
+	}
+	return items
+}
+
+// CloseContextMenu dismisses the menu without acting.
+func (m *OS) CloseContextMenu() {
+	m.ContextMenu = nil
+}
+
+// ContextMenuMove moves the selection by delta rows, wrapping at either end.
+func (m *OS) ContextMenuMove(delta int) {
+	menu := m.ContextMenu
+	if menu == nil || len(menu.Items) == 0 {
+		return
+	}
+	n := len(menu.Items)
+	menu.Selected = ((menu.Selected+delta)%n + n) % n
+}
+
+// ActivateContextMenu runs the selected item. A submenu item swaps in its
+// rows and keeps the menu open; any other item closes the menu first so its
+// action sees the desktop as it will be drawn.
+func (m *OS) ActivateContextMenu() (*OS, tea.Cmd) {
+	menu := m.ContextMenu
+	if menu == nil || menu.Selected < 0 || menu.Selected >= len(menu.Items) {
+		return m, nil
+	}
+	item := menu.Items[menu.Selected]
+	if item.Submenu != nil {
+		menu.Title = strings.TrimSuffix(item.Label, " ▸")
+		menu.Items = item.Submenu(m)
+		menu.Selected = 0
+		if len(menu.Items) == 0 {
+			m.CloseContextMenu()
+		}
+		return m, nil
+	}
+	m.CloseContextMenu()
+	if item.Action == nil {
+		return m, nil
+	}
+	return item.Action(m)
+}
+
+// contextMenuGeometry returns where the menu is drawn and its outer size:
+// a title row above the items, one cell of padding either side, kept on
+// screen so a click near the right or bottom edge opens it up and to the left.
+func (m *OS) contextMenuGeometry() (x, y, width, height int) {
+	menu := m.ContextMenu
+	width = lipgloss.Width(menu.Title)
+	for _, item := range menu.Items {
+		width = max(width, lipgloss.Width(item.Label))
+	}
+	width = min(width+2, max(m.GetRenderWidth(), 3))
+	height = len(menu.Items) + 1
+
+	x, y = menu.X, menu.Y
+	if x+width > m.GetRenderWidth() {
+		x = m.GetRenderWidth() - width
+	}
+	if y+height > m.GetRenderHeight() {
+		y = m.GetRenderHeight() - height
+	}
+	return max(x, 0), max(y, 0), width, height
+}
+
+// ContextMenuItemAt reports which item row is at the given cell, or -1, and
+// whether the cell is inside the menu at all.
+func (m *OS) ContextMenuItemAt(x, y int) (int, bool) {
+	if m.ContextMenu == nil {
+		return -1, false
+	}
+	mx, my, w, h := m.contextMenuGeometry()
+	if x < mx || x >= mx+w || y < my || y >= my+h {
+		return -1, false
+	}
+	return y - my - 1, true
+}
+
+// ContextMenuHover selects the item under the pointer, if any.
+func (m *OS) ContextMenuHover(x, y int) {
+	if idx, _ := m.ContextMenuItemAt(x, y); idx >= 0 {
+		m.ContextMenu.Selected = idx
+	}
+}
+
+// renderContextMenu draws the open menu at its anchor.
+func (m *OS) renderContextMenu() *lipgloss.Layer {
+	menu := m.ContextMenu
+	if menu == nil {
+		return nil
+	}
+	x, y, width, _ := m.contextMenuGeometry()
+	inner := width - 2
+
+	ui := theme.UI()
+	row := lipgloss.NewStyle().Foreground(ui.Fg).Background(ui.Card).Padding(0, 1)
+	pad := func(label string) string {
+		label = lipgloss.NewStyle().MaxWidth(inner).Render(label)
+		return label + strings.Repeat(" ", max(inner-lipgloss.Width(label), 0))
+	}
+	rows := []string{
+		row.Foreground(ui.FgDim).Render(pad(menu.Title)),
+	}
+	for i, item := range menu.Items {
+		style := row
+		if i == menu.Selected {
+			style = style.Background(ui.RowSel).Foreground(ui.AccentBright)
+		}
+		rows = append(rows, style.Render(pad(item.Label)))
+	}
+
+	return lipgloss.NewLayer(strings.Join(rows, "\n")).
+		X(x).
+		Y(y).
+		Z(config.ZIndexContextMenu).
+		ID("context-menu")
+}
@@ -0,0 +1,75 @@
+package app
+
+import "testing"
+
+// TestContextMenuMoveToWorkspace walks the window menu from the keyboard side:
+// the selection wraps, the workspace item opens a submenu without closing the
+// menu, and picking a workspace moves the window and closes it.
+func TestContextMenuMoveToWorkspace(t *testing.T) {
+	win := newTestWindow(t, "window-a", 40, 10)
+	win.Workspace = 1
+	m := newTestOS(win)
+	m.CurrentWorkspace = 1
+	m.Width, m.Height = 80, 24
+
+	m.OpenWindowContextMenu(0, 5, 1)
+	if m.ContextMenu == nil {
+		t.Fatal("menu not opened")
+	}
+	m.ContextMenuMove(-1)
+	if got := m.ContextMenu.Items[m.ContextMenu.Selected].Label; got != "Close" {
+		t.Fatalf("moving up from the top selected %q, want the last item", got)
+	}
+	m.ContextMenuMove(-1)
+	if got := m.ContextMenu.Items[m.ContextMenu.Selected].Label; got != "Move to Workspace ▸" {
+		t.Fatalf("selected %q", got)
+	}
+
+	m.ActivateContextMenu()
+	if m.ContextMenu == nil || m.ContextMenu.Title != "Move to Workspace" {
+		t.Fatal("submenu did not replace the items")
+	}
+	if got := m.ContextMenu.Items[0].Label; got != "Workspace 2" {
+		t.Fatalf("first workspace entry %q, want the current workspace skipped", got)
+	}
+	m.ActivateContextMenu()
+	if m.ContextMenu != nil {
+		t.Error("menu still open after an action")
+	}
+	if win.Workspace != 2 {
+		t.Errorf("window on workspace %d, want 2", win.Workspace)
+	}
+}
+
+// TestContextMenuHitTesting checks that rows are found under the pointer below
+// the title row, and that a menu opened at the screen corner is pulled back on
+// screen.
+func TestContextMenuHitTesting(t *testing.T) {
+	win := newTestWindow(t, "window-a", 40, 10)
+	m := newTestOS(win)
+	m.Width, m.Height = 80, 24
+
+	m.OpenDesktopContextMenu(10, 5)
+	if idx, inside := m.ContextMenuItemAt(10, 5); !inside || idx != -1 {
+		t.Errorf("title row = (%d, %v), want (-1, true)", idx, inside)
+	}
+	if idx, _ := m.ContextMenuItemAt(12, 7); idx != 1 {
+		t.Errorf("second row = %d, want 1", idx)
+	}
+	if _, inside := m.ContextMenuItemAt(9, 6); inside {
+		t.Error("cell left of the menu counted as inside")
+	}
+	m.ContextMenuHover(12, 8)
+	if m.ContextMenu.Selected != 2 {
+		t.Errorf("hover selected %d, want 2", m.ContextMenu.Selected)
+	}
+
+	m.OpenDesktopContextMenu(79, 23)
+	x, y, w, h := m.contextMenuGeometry()
+	if x+w > 80 || y+h > 24 {
+		t.Errorf("menu at (%d,%d) size %dx%d runs off an 80x24 screen", x, y, w, h)
+	}
+	if m.renderContextMenu() == nil {
+		t.Error("open menu not rendered")
+	}
+}
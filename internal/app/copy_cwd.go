@@ -0,0 +1,38 @@
+package app
+
+import (
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// focusedCwd returns the working directory of the window's shell. It is read
+// from /proc where that works (local windows on Linux); otherwise the last
+// OSC 7 report is used, which also covers daemon windows. Empty when neither
+// is available.
+func focusedCwd(w *terminal.Window) string {
+	if cwd := w.CWD(); cwd != "" {
+		return cwd
+	}
+	if dir, ok := localCwdPath(w.ReportedCWD()); ok {
+		return dir
+	}
+	return ""
+}
+
+// CopyFocusedCwd copies the focused window's working directory to the
+// clipboard, so another pane can cd to it. When the directory cannot be
+// determined it says so in a notification and copies nothing.
+func (m *OS) CopyFocusedCwd() tea.Cmd {
+	focused := m.GetFocusedWindow()
+	if focused == nil {
+		return nil
+	}
+	cwd := focusedCwd(focused)
+	if cwd == "" {
+		m.ShowNotification("Working directory unavailable for this window", "warning", config.NotificationDuration)
+		return nil
+	}
+	m.ShowNotification("Copied: "+cwd, "success", config.NotificationDuration)
+	return tea.SetClipboard(cwd)
+}
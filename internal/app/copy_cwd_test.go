@@ -0,0 +1,28 @@
+package app
+
+import "testing"
+
+// TestCopyFocusedCwdFallsBackToOSC7 checks that a window whose directory
+// cannot be read from /proc (here a daemon window) is resolved through the
+// shell's OSC 7 report, and that without one nothing is copied.
+func TestCopyFocusedCwdFallsBackToOSC7(t *testing.T) {
+	win := newTestWindow(t, "copy-cwd-0001", 40, 8)
+	m := newTestOS(win)
+	m.CurrentWorkspace = 1
+	win.Workspace = 1
+
+	if cmd := m.CopyFocusedCwd(); cmd != nil {
+		t.Fatal("copied a directory before the shell reported one")
+	}
+
+	win.LockIO()
+	_, _ = win.Terminal.Write([]byte("\x1b]7;file:///srv/project\x07"))
+	win.UnlockIO()
+
+	if got := focusedCwd(win); got != "/srv/project" {
+		t.Fatalf("focusedCwd = %q, want /srv/project", got)
+	}
+	if cmd := m.CopyFocusedCwd(); cmd == nil {
+		t.Error("reported directory was not copied")
+	}
+}
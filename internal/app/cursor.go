@@ -53,13 +53,9 @@ func (m *OS) getRealCursor() *tea.Cursor {
 		return nil
 	}
 
-	// Transform to screen coordinates (+1 for border, +0 for tiled)
-	borderOffset := 1
-	if window.Tiled {
-		borderOffset = 0
-	}
-	screenX := window.X + borderOffset + pos.X
-	screenY := window.Y + borderOffset + pos.Y
+	// Transform to screen coordinates past the left border, title bar and padding
+	screenX := window.X + window.ContentOffsetX() + pos.X
+	screenY := window.Y + window.ContentOffsetY() + pos.Y
 
 	cursor := tea.NewCursor(screenX, screenY)
 	cursor.Shape = mapCursorStyle(window.CursorStyle())
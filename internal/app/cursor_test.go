@@ -0,0 +1,36 @@
+package app
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// TestRealCursorFollowsProgramCursor checks the host cursor takes the shape
+// the focused program asked for with DECSCUSR.
+func TestRealCursorFollowsProgramCursor(t *testing.T) {
+	w := newTestWindow(t, "window-a", 40, 12)
+	m := newTestOS(w)
+	m.Mode = TerminalMode
+
+	if cur := m.getRealCursor(); cur == nil {
+		t.Fatal("no host cursor for the focused window")
+	}
+
+	tests := []struct {
+		seq   string
+		shape tea.CursorShape
+		blink bool
+	}{
+		{"\x1b[6 q", tea.CursorBar, false},
+		{"\x1b[3 q", tea.CursorUnderline, true},
+		{"\x1b[2 q", tea.CursorBlock, false},
+	}
+	for _, tt := range tests {
+		w.WriteOutput([]byte(tt.seq))
+		cur := m.getRealCursor()
+		if cur.Shape != tt.shape || cur.Blink != tt.blink {
+			t.Errorf("after %q: cursor shape %v blink %v, want %v blink %v", tt.seq, cur.Shape, cur.Blink, tt.shape, tt.blink)
+		}
+	}
+}
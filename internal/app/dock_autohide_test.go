@@ -0,0 +1,91 @@
+package app
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestDockAutoHide checks that an auto-hidden dock gives its rows to windows
+// while nothing is minimized, comes back for a minimized window or a mouse at
+// the dock edge, and tucks away again when the mouse leaves the dock rows.
+func TestDockAutoHide(t *testing.T) {
+	prevHide, prevPos := config.DockAutoHide, config.DockbarPosition
+	defer func() { config.DockAutoHide, config.DockbarPosition = prevHide, prevPos }()
+	config.DockAutoHide = true
+	config.DockbarPosition = "bottom"
+
+	m := &OS{Width: 100, Height: 30, CurrentWorkspace: 1}
+	full := m.GetRenderHeight()
+	reserved := full - config.DockHeight
+
+	m.syncDockAutoHide()
+	if got := m.GetUsableHeight(); got != full {
+		t.Fatalf("usable height with the dock tucked = %d, want %d", got, full)
+	}
+
+	m.Windows = []*terminal.Window{{ID: "window-a", Workspace: 1, Minimized: true}}
+	m.syncDockAutoHide()
+	if got := m.GetUsableHeight(); got != reserved {
+		t.Errorf("usable height with a minimized window = %d, want %d", got, reserved)
+	}
+
+	m.Windows[0].Minimized = false
+	m.syncDockAutoHide()
+	if !m.DockTucked {
+		t.Fatal("dock not tucked away once nothing is minimized")
+	}
+
+	m.UpdateDockReveal(full - 1)
+	if m.DockTucked {
+		t.Error("mouse at the bottom edge did not reveal the dock")
+	}
+	m.UpdateDockReveal(full - config.DockHeight)
+	if m.DockTucked {
+		t.Error("dock tucked away while the mouse is still over it")
+	}
+	m.UpdateDockReveal(10)
+	if !m.DockTucked {
+		t.Error("dock still shown after the mouse left it")
+	}
+
+	config.DockAutoHide = false
+	m.syncDockAutoHide()
+	if m.DockTucked || m.GetUsableHeight() != reserved {
+		t.Errorf("turning auto-hide off left tucked=%v usable=%d", m.DockTucked, m.GetUsableHeight())
+	}
+}
+
+// TestDockAutoHideMouseMode checks that terminal mode still asks for
+// any-motion reporting while the dock is auto-hidden, so the pointer reaching
+// the edge can reveal it, and drops back to cell motion when the dock is not
+// auto-hiding.
+func TestDockAutoHideMouseMode(t *testing.T) {
+	prevHide, prevPos, prevFocus := config.DockAutoHide, config.DockbarPosition, config.FocusMode
+	defer func() {
+		config.DockAutoHide, config.DockbarPosition, config.FocusMode = prevHide, prevPos, prevFocus
+	}()
+	config.DockAutoHide = true
+	config.DockbarPosition = "bottom"
+	config.FocusMode = config.FocusModeClick
+
+	m := &OS{Width: 100, Height: 30, CurrentWorkspace: 1, Mode: TerminalMode}
+	m.syncDockAutoHide()
+	if got := m.mouseMode(); got != tea.MouseModeAllMotion {
+		t.Errorf("mouse mode with the dock tucked = %v, want all motion", got)
+	}
+
+	m.UpdateDockReveal(m.GetRenderHeight() - 1)
+	if got := m.mouseMode(); got != tea.MouseModeAllMotion {
+		t.Errorf("mouse mode with the dock revealed = %v, want all motion", got)
+	}
+
+	config.DockAutoHide = false
+	m.DockRevealed = false
+	m.syncDockAutoHide()
+	if got := m.mouseMode(); got != tea.MouseModeCellMotion {
+		t.Errorf("mouse mode without auto-hide = %v, want cell motion", got)
+	}
+}
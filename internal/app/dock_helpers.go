@@ -3,6 +3,8 @@ package app
 import (
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 
 	"charm.land/lipgloss/v2"
 	"github.com/Gaurav-Gosain/tuios/internal/config"
@@ -81,7 +83,7 @@ func (m *OS) buildDockLeftText() (string, int, ModeInfo) {
 	if m.AutoTiling {
 		tree := m.WorkspaceTrees[m.CurrentWorkspace]
 		if tree != nil {
-			modeInfo.NextSplit = tree.GetNextSplitDirection()
+			modeInfo.NextSplit = tree.GetNextSplitDirection(m.GetBSPBounds())
 		} else {
 			modeInfo.NextSplit = "V" // Default to vertical
 		}
@@ -122,6 +124,16 @@ func (m *OS) buildDockLeftText() (string, int, ModeInfo) {
 		modeLabel += " Z"
 	}
 
+	// Add monocle indicator with the focused window's place in the stack
+	if label := m.monocleLabel(); label != "" {
+		modeLabel += " " + label
+	}
+
+	// Add prefix passthrough indicator: the leader goes to the pane
+	if m.PrefixPassthrough {
+		modeLabel += " P"
+	}
+
 	// Build pill-style mode indicator with configurable semicircles
 	// This will be styled in render.go with the mode color
 	modeText = config.GetDockPillLeftChar() + modeLabel + config.GetDockPillRightChar()
@@ -155,6 +167,10 @@ func (m *OS) buildDockLeftText() (string, int, ModeInfo) {
 		workspacesUsed,
 		config.GetDockIconWorkspaceCount())
 
+	if list := m.dockWorkspaceList(); list != "" {
+		workspaceText += list + " "
+	}
+
 	// Passive project-tape badge: when the focused window is inside a directory
 	// carrying a .tuios.tape, a small status marker rides in the dock. It is
 	// informational only; it opens no dialog and runs nothing.
@@ -173,6 +189,30 @@ func (m *OS) buildDockLeftText() (string, int, ModeInfo) {
 	return leftText, width, modeInfo
 }
 
+// dockWorkspaceList returns the workspace numbers config.DockWorkspaces asks
+// the dock to list, separated by spaces with the current one in brackets, as
+// in "1 [2] 4". With "occupied", workspaces without windows are left out
+// unless they are the current one. It is empty when the list is off.
+func (m *OS) dockWorkspaceList() string {
+	if config.DockWorkspaces != config.DockWorkspacesAll && config.DockWorkspaces != config.DockWorkspacesOccupied {
+		return ""
+	}
+	occupied := make(map[int]bool)
+	for _, w := range m.Windows {
+		occupied[w.Workspace] = true
+	}
+	var parts []string
+	for ws := 1; ws <= m.workspaceLimit(); ws++ {
+		switch {
+		case ws == m.CurrentWorkspace:
+			parts = append(parts, fmt.Sprintf("[%d]", ws))
+		case config.DockWorkspaces == config.DockWorkspacesAll || occupied[ws]:
+			parts = append(parts, strconv.Itoa(ws))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
 // calculateDockRightWidth calculates the width of the right side of the dock
 func (m *OS) calculateDockRightWidth() int {
 	focusedWindow := m.GetFocusedWindow()
@@ -195,7 +235,13 @@ func (m *OS) calculateDockRightWidth() int {
 		}
 	}
 
-	return 32 // CPU graph (~19 chars) + space + RAM (~11 chars) = ~31 chars
+	// CPU graph (~19 chars) + space + RAM (~11 chars) = ~31 chars, plus the
+	// status command's text when there is any.
+	width := 32
+	if m.StatusText != "" {
+		width += lipgloss.Width(m.StatusText) + 1
+	}
+	return width
 }
 
 // getDockItems returns all dock items (minimized windows in current workspace)
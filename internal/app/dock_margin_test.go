@@ -0,0 +1,46 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// TestDockMarginKeepsWindowsOffTheDock checks that config.DockMargin leaves
+// blank rows between the windows and the dock on whichever edge it sits, that
+// snapping and tiling both stop short of them, and that a hidden dock gives the
+// margin back along with the dock rows.
+func TestDockMarginKeepsWindowsOffTheDock(t *testing.T) {
+	prevMargin, prevPos := config.DockMargin, config.DockbarPosition
+	defer func() { config.DockMargin, config.DockbarPosition = prevMargin, prevPos }()
+	config.DockMargin = 2
+
+	m := &OS{Width: 100, Height: 30, CurrentWorkspace: 1}
+	full := m.GetRenderHeight()
+
+	config.DockbarPosition = "bottom"
+	if got, want := m.GetUsableHeight(), full-config.DockHeight-2; got != want {
+		t.Errorf("bottom dock: usable height = %d, want %d", got, want)
+	}
+	_, y, _, h := m.calculateSnapBounds(SnapFullScreen)
+	if bottom, dockTop := y+h, full-config.DockHeight; dockTop-bottom != 2 {
+		t.Errorf("bottom dock: snapped window ends at row %d, dock starts at %d; want 2 rows between", bottom, dockTop)
+	}
+	if b := m.GetBSPBounds(); b.Y+b.H != full-config.DockHeight-2 {
+		t.Errorf("bottom dock: tiling bounds end at row %d, want %d", b.Y+b.H, full-config.DockHeight-2)
+	}
+
+	config.DockbarPosition = "top"
+	if got, want := m.GetTopMargin(), config.DockHeight+2; got != want {
+		t.Errorf("top dock: top margin = %d, want %d", got, want)
+	}
+	_, y, _, h = m.calculateSnapBounds(SnapFullScreen)
+	if y != config.DockHeight+2 || y+h != full {
+		t.Errorf("top dock: snapped window spans rows %d-%d, want %d-%d", y, y+h, config.DockHeight+2, full)
+	}
+
+	config.DockbarPosition = "hidden"
+	if m.GetTopMargin() != 0 || m.GetUsableHeight() != full {
+		t.Errorf("hidden dock: top margin %d, usable %d; want 0 and %d", m.GetTopMargin(), m.GetUsableHeight(), full)
+	}
+}
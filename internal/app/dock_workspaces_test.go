@@ -0,0 +1,36 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestDockWorkspaceList checks each config.DockWorkspaces mode: off lists
+// nothing, all lists every workspace, and occupied keeps only workspaces with
+// windows plus the current one, which is bracketed in both lists.
+func TestDockWorkspaceList(t *testing.T) {
+	prev := config.DockWorkspaces
+	defer func() { config.DockWorkspaces = prev }()
+
+	m := &OS{
+		NumWorkspaces:    5,
+		CurrentWorkspace: 2,
+		Windows: []*terminal.Window{
+			{ID: "a", Workspace: 1},
+			{ID: "b", Workspace: 4},
+		},
+	}
+
+	for mode, want := range map[string]string{
+		config.DockWorkspacesOff:      "",
+		config.DockWorkspacesAll:      "1 [2] 3 4 5",
+		config.DockWorkspacesOccupied: "1 [2] 4",
+	} {
+		config.DockWorkspaces = mode
+		if got := m.dockWorkspaceList(); got != want {
+			t.Errorf("dock_workspaces = %q: list = %q, want %q", mode, got, want)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// TestFlipLayoutHorizontal checks flipping moves the first pane to the other
+// side with its width kept, leaves focus on the same window, and flips back.
+func TestFlipLayoutHorizontal(t *testing.T) {
+	prevAnim := config.AnimationsEnabled
+	config.AnimationsEnabled = false
+	defer func() { config.AnimationsEnabled = prevAnim }()
+
+	m := &OS{
+		CurrentWorkspace: 1,
+		WorkspaceFocus:   map[int]int{},
+		Width:            120,
+		Height:           40,
+		AutoTiling:       true,
+		UseBSPLayout:     true,
+		FocusedWindow:    1,
+	}
+	for _, id := range []string{"window-a", "window-b", "window-c"} {
+		w := newTestWindow(t, id, 20, 10)
+		w.Workspace = 1
+		m.Windows = append(m.Windows, w)
+	}
+	m.TileAllWindows()
+	first := m.Windows[0]
+	x, width := first.X, first.Width
+	bounds := m.GetBSPBounds()
+
+	if !m.FlipLayoutHorizontal() {
+		t.Fatal("FlipLayoutHorizontal reported nothing to flip")
+	}
+	if first.Width != width || first.X+first.Width != bounds.X+bounds.W {
+		t.Errorf("first pane at x=%d width %d, want width %d against the right edge", first.X, first.Width, width)
+	}
+	if got := m.GetFocusedWindow(); got == nil || got.ID != "window-b" {
+		t.Errorf("focus moved off window-b")
+	}
+
+	m.FlipLayoutHorizontal()
+	if first.X != x || first.Width != width {
+		t.Errorf("flipping twice left the first pane at x=%d width %d, want x=%d width %d", first.X, first.Width, x, width)
+	}
+
+	m.UseBSPLayout = false
+	if m.FlipLayoutVertical() {
+		t.Error("flip applied to the master-stack layout")
+	}
+}
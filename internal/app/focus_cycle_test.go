@@ -0,0 +1,95 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestCycleToNextFloatingWindow checks that the floating cycle skips tiled,
+// minimized and off-workspace windows, wraps around unless window_cycle_wrap
+// is off, and that the tiled cycle is its mirror image.
+func TestCycleToNextFloatingWindow(t *testing.T) {
+	m := &OS{CurrentWorkspace: 1, FocusedWindow: 0, WorkspaceFocus: map[int]int{}}
+	m.Windows = []*terminal.Window{
+		{ID: "tiled-a", Workspace: 1},
+		{ID: "float-a", Workspace: 1, IsFloating: true},
+		{ID: "tiled-b", Workspace: 1},
+		{ID: "float-min", Workspace: 1, IsFloating: true, Minimized: true},
+		{ID: "float-other", Workspace: 2, IsFloating: true},
+		{ID: "float-b", Workspace: 1, IsFloating: true},
+	}
+
+	for _, want := range []int{1, 5, 1} {
+		if !m.CycleToNextFloatingWindow() {
+			t.Fatal("CycleToNextFloatingWindow reported no floating window")
+		}
+		if m.FocusedWindow != want {
+			t.Fatalf("focused %d, want %d", m.FocusedWindow, want)
+		}
+	}
+
+	for _, want := range []int{2, 0, 2} {
+		if !m.CycleToNextTiledWindow() {
+			t.Fatal("CycleToNextTiledWindow reported no tiled window")
+		}
+		if m.FocusedWindow != want {
+			t.Fatalf("focused %d, want %d", m.FocusedWindow, want)
+		}
+	}
+
+	original := config.WindowCycleWrap
+	defer func() { config.WindowCycleWrap = original }()
+	config.WindowCycleWrap = false
+	m.FocusedWindow = 5
+	if !m.CycleToNextFloatingWindow() || m.FocusedWindow != 5 {
+		t.Errorf("next floating from the last one without wrap focused %d, want 5", m.FocusedWindow)
+	}
+	config.WindowCycleWrap = true
+
+	m.Windows = m.Windows[:2]
+	m.FocusedWindow = 1
+	if m.CycleToNextFloatingWindow() {
+		t.Error("cycling with the only floating window focused should report false")
+	}
+}
+
+// TestCycleVisibleWindowWrap checks next and previous cycling wrap at the ends
+// by default and stop there with window_cycle_wrap off.
+func TestCycleVisibleWindowWrap(t *testing.T) {
+	original := config.WindowCycleWrap
+	defer func() { config.WindowCycleWrap = original }()
+
+	m := &OS{CurrentWorkspace: 1, FocusedWindow: 2, WorkspaceFocus: map[int]int{}}
+	m.Windows = []*terminal.Window{
+		{ID: "a", Workspace: 1},
+		{ID: "b", Workspace: 1},
+		{ID: "c", Workspace: 1},
+	}
+
+	config.WindowCycleWrap = true
+	m.CycleToNextVisibleWindow()
+	if m.FocusedWindow != 0 {
+		t.Fatalf("next from the last window focused %d, want 0", m.FocusedWindow)
+	}
+	m.CycleToPreviousVisibleWindow()
+	if m.FocusedWindow != 2 {
+		t.Fatalf("previous from the first window focused %d, want 2", m.FocusedWindow)
+	}
+
+	config.WindowCycleWrap = false
+	m.CycleToNextVisibleWindow()
+	if m.FocusedWindow != 2 {
+		t.Errorf("next from the last window without wrap focused %d, want 2", m.FocusedWindow)
+	}
+	m.FocusedWindow = 0
+	m.CycleToPreviousVisibleWindow()
+	if m.FocusedWindow != 0 {
+		t.Errorf("previous from the first window without wrap focused %d, want 0", m.FocusedWindow)
+	}
+	m.CycleToNextVisibleWindow()
+	if m.FocusedWindow != 1 {
+		t.Errorf("next from the first window focused %d, want 1", m.FocusedWindow)
+	}
+}
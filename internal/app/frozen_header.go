@@ -0,0 +1,73 @@
+package app
+
+import (
+	"strings"
+
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+	uv "github.com/charmbracelet/ultraviolet"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// FreezeHeader pins the top n rows of the focused window as they are now, like
+// frozen spreadsheet rows: they stay drawn at the top while the output below
+// them scrolls, including when scrolled back through history. It reports false
+// when there is no focused window or n leaves no room for the rest of the
+// output. n of 0 unfreezes.
+func (m *OS) FreezeHeader(n int) bool {
+	w := m.GetFocusedWindow()
+	switch {
+	case w == nil:
+		return false
+	case n == 0:
+		w.FrozenHeaderLines, w.FrozenHeader = 0, nil
+	case w.Terminal == nil || n < 0 || n >= w.ContentHeight():
+		return false
+	default:
+		w.FrozenHeaderLines, w.FrozenHeader = n, visibleRows(w, n)
+	}
+	w.MarkContentDirty()
+	w.InvalidateCache()
+	return true
+}
+
+// visibleRows renders the top n rows of w as currently shown, reading
+// scrollback for the rows above the live screen when scrolled back.
+func visibleRows(w *terminal.Window, n int) []string {
+	w.RLockIO()
+	defer w.RUnlockIO()
+
+	screen := w.Terminal
+	scrollbackLen := w.ScrollbackLen()
+	rows := make([]string, 0, n)
+	for y := range n {
+		var line uv.Line
+		if y < w.ScrollbackOffset {
+			line = w.ScrollbackLine(scrollbackLen - w.ScrollbackOffset + y)
+		} else if sy := y - w.ScrollbackOffset; sy < screen.Height() {
+			line = make(uv.Line, screen.Width())
+			for x := range line {
+				if cell := screen.CellAt(x, sy); cell != nil {
+					line[x] = *cell
+				}
+			}
+		}
+		rows = append(rows, line.Render())
+	}
+	return rows
+}
+
+// withFrozenHeader draws w's frozen header over the top rows of its rendered
+// content, padded to the content width so nothing underneath shows through.
+// At least one row of live output stays visible if the window shrank.
+func withFrozenHeader(w *terminal.Window, content string) string {
+	if w.FrozenHeaderLines == 0 || len(w.FrozenHeader) == 0 {
+		return content
+	}
+	width := w.ContentWidth()
+	lines := strings.Split(content, "\n")
+	for i := 0; i < len(w.FrozenHeader) && i < len(lines)-1; i++ {
+		row := ansi.Truncate(w.FrozenHeader[i], width, "")
+		lines[i] = row + strings.Repeat(" ", max(width-ansi.StringWidth(row), 0))
+	}
+	return strings.Join(lines, "\n")
+}
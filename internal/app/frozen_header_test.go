@@ -0,0 +1,45 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// TestFrozenHeaderStaysWhileOutputScrolls freezes a two-row header, scrolls it
+// off the screen with more output, and checks the rendered window still starts
+// with it while the latest output shows beneath.
+func TestFrozenHeaderStaysWhileOutputScrolls(t *testing.T) {
+	win := newTestWindow(t, "frozen", 40, 10)
+	win.WriteOutput([]byte("PID   CPU\r\n----  ---\r\n"))
+	m := newTestOS(win)
+
+	if m.FreezeHeader(win.ContentHeight()) {
+		t.Error("freezing every row should be refused")
+	}
+	if !m.FreezeHeader(2) {
+		t.Fatal("FreezeHeader(2) refused")
+	}
+
+	for i := range 30 {
+		win.WriteOutput(fmt.Appendf(nil, "row %d\r\n", i))
+	}
+	win.MarkContentDirty()
+
+	lines := strings.Split(ansi.Strip(withFrozenHeader(win, m.renderTerminal(win, true, false))), "\n")
+	if !strings.HasPrefix(lines[0], "PID   CPU") || !strings.HasPrefix(lines[1], "----  ---") {
+		t.Errorf("header rows scrolled away:\n%s", strings.Join(lines, "\n"))
+	}
+	if w := ansi.StringWidth(lines[0]); w != win.ContentWidth() {
+		t.Errorf("header row is %d cells wide, want the content width %d", w, win.ContentWidth())
+	}
+	if !strings.Contains(strings.Join(lines[2:], "\n"), "row 29") {
+		t.Errorf("latest output missing beneath the header:\n%s", strings.Join(lines, "\n"))
+	}
+
+	if !m.FreezeHeader(0) || win.FrozenHeaderLines != 0 || win.FrozenHeader != nil {
+		t.Error("FreezeHeader(0) should unfreeze")
+	}
+}
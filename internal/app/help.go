@@ -32,7 +32,8 @@ func GetHelpCategories(registry *config.KeybindRegistry) []HelpCategory {
 			Bindings: generateCategoryBindings(registry, "Window Management", []string{
 				"new_window", "close_window", "rename_window",
 				"minimize_window", "restore_all",
-				"next_window", "prev_window",
+				"next_window", "prev_window", "last_window", "copy_selection",
+				"repeat_last_action",
 				"terminal_next_window", "terminal_prev_window",
 			}),
 		},
@@ -69,7 +70,7 @@ func GetHelpCategories(registry *config.KeybindRegistry) []HelpCategory {
 			Name: "Modes",
 			Bindings: generateCategoryBindings(registry, "Modes", []string{
 				"enter_terminal_mode", "enter_window_mode",
-				"terminal_exit_mode",
+				"terminal_exit_mode", "terminal_passthrough",
 				"toggle_help", "quit",
 			}),
 		},
@@ -0,0 +1,60 @@
+package app
+
+import (
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// HoverFocusMsg fires once the pointer has rested on a window for
+// config.HoverFocusDelay. Seq ties it to the hover that scheduled it.
+type HoverFocusMsg struct {
+	WindowID string
+	Seq      int
+}
+
+// HoverFocus is called with the window under the pointer (-1 for none) as it
+// moves. In hover focus mode it schedules focusing that window after
+// config.HoverFocusDelay; moving onto another window, or off every window,
+// before then replaces or cancels the pending focus, so passing over a pane
+// does not focus it. In click mode it does nothing.
+func (m *OS) HoverFocus(index int) tea.Cmd {
+	if config.FocusMode != config.FocusModeHover {
+		return nil
+	}
+	if index < 0 || index >= len(m.Windows) || index == m.FocusedWindow {
+		m.HoverFocusTarget = ""
+		return nil
+	}
+	id := m.Windows[index].ID
+	if id == m.HoverFocusTarget {
+		return nil // already waiting on this window
+	}
+	m.HoverFocusTarget = id
+	m.HoverFocusSeq++
+	msg := HoverFocusMsg{WindowID: id, Seq: m.HoverFocusSeq}
+	return tea.Tick(config.HoverFocusDelay, func(time.Time) tea.Msg { return msg })
+}
+
+// applyHoverFocus focuses the window a HoverFocusMsg names if the pointer is
+// still waiting on it, and reports whether focus moved.
+func (m *OS) applyHoverFocus(msg HoverFocusMsg) bool {
+	if msg.Seq != m.HoverFocusSeq || msg.WindowID != m.HoverFocusTarget {
+		return false
+	}
+	m.HoverFocusTarget = ""
+	if config.FocusMode != config.FocusModeHover {
+		return false
+	}
+	for i, w := range m.Windows {
+		if w.ID == msg.WindowID {
+			if w.Workspace != m.CurrentWorkspace || w.Minimized || i == m.FocusedWindow {
+				return false
+			}
+			m.FocusWindow(i)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestHoverFocus checks that hover mode focuses a window only once the
+// pointer has stayed on it: a hover that moved on before its delay ran out is
+// dropped, and click mode never schedules anything.
+func TestHoverFocus(t *testing.T) {
+	prev := config.FocusMode
+	defer func() { config.FocusMode = prev }()
+
+	m := &OS{CurrentWorkspace: 1, FocusedWindow: 0, WorkspaceFocus: map[int]int{}}
+	m.Windows = []*terminal.Window{
+		{ID: "window-a", Workspace: 1},
+		{ID: "window-b", Workspace: 1},
+		{ID: "window-c", Workspace: 1},
+	}
+
+	config.FocusMode = config.FocusModeClick
+	if cmd := m.HoverFocus(1); cmd != nil {
+		t.Fatal("click mode scheduled a hover focus")
+	}
+
+	config.FocusMode = config.FocusModeHover
+	if cmd := m.HoverFocus(1); cmd == nil {
+		t.Fatal("hover mode did not schedule focus for the window under the pointer")
+	}
+	passedOver := HoverFocusMsg{WindowID: "window-b", Seq: m.HoverFocusSeq}
+	if cmd := m.HoverFocus(1); cmd != nil {
+		t.Error("a second motion event over the same window restarted the delay")
+	}
+
+	m.HoverFocus(2)
+	if m.applyHoverFocus(passedOver) || m.FocusedWindow != 0 {
+		t.Fatalf("window passed over on the way was focused (focused %d)", m.FocusedWindow)
+	}
+
+	rested := HoverFocusMsg{WindowID: "window-c", Seq: m.HoverFocusSeq}
+	if !m.applyHoverFocus(rested) || m.FocusedWindow != 2 {
+		t.Errorf("window the pointer rested on not focused (focused %d)", m.FocusedWindow)
+	}
+
+	m.HoverFocus(0)
+	pending := HoverFocusMsg{WindowID: "window-a", Seq: m.HoverFocusSeq}
+	m.HoverFocus(-1)
+	if m.applyHoverFocus(pending) {
+		t.Error("focus moved after the pointer left every window")
+	}
+}
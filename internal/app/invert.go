@@ -0,0 +1,141 @@
+package app
+
+import (
+	"strings"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// ToggleInvert flips color inversion for the focused window, for a glaring pane
+// that is easier to read the other way round without changing the theme.
+func (m *OS) ToggleInvert() {
+	w := m.GetFocusedWindow()
+	if w == nil {
+		return
+	}
+	w.Inverted = !w.Inverted
+	w.MarkContentDirty()
+	w.InvalidateCache()
+	if w.Inverted {
+		m.ShowNotification("Window colors: inverted", "info", config.NotificationDuration)
+		return
+	}
+	m.ShowNotification("Window colors: normal", "info", config.NotificationDuration)
+}
+
+// withInversion draws w's rendered terminal in reverse video when w is
+// inverted. Every SGR sequence in content is rewritten so reverse video ends up
+// the opposite of what the program asked for, which keeps text the program
+// itself reversed (a selection, a status line) standing out. Lines are padded
+// to the content size so the inverted background fills the whole pane.
+func withInversion(w *terminal.Window, content string) string {
+	if !w.Inverted {
+		return content
+	}
+	width, height := w.ContentWidth(), w.ContentHeight()
+	lines := strings.Split(content, "\n")
+	for len(lines) < height {
+		lines = append(lines, "")
+	}
+
+	var b strings.Builder
+	b.Grow(len(content) + len(lines)*(width+8))
+	reversed := false
+	for i, line := range lines {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(reverseSGR(reversed))
+		reversed = invertLine(&b, line, reversed)
+		b.WriteString(strings.Repeat(" ", max(width-ansi.StringWidth(line), 0)))
+	}
+	b.WriteString("\x1b[0m")
+	return b.String()
+}
+
+// invertLine writes line to b with each SGR sequence rewritten for inversion,
+// and returns whether the program's own reverse video is on at the end of it.
+func invertLine(b *strings.Builder, line string, reversed bool) bool {
+	for {
+		start := strings.Index(line, "\x1b[")
+		if start < 0 {
+			b.WriteString(line)
+			return reversed
+		}
+		end := start + 2
+		for end < len(line) && (line[end] < 0x40 || line[end] > 0x7e) {
+			end++
+		}
+		if end == len(line) {
+			b.WriteString(line)
+			return reversed
+		}
+		b.WriteString(line[:start])
+		if line[end] != 'm' {
+			b.WriteString(line[start : end+1])
+		} else {
+			orig := line[start+2 : end]
+			params, rev := stripReverse(orig, reversed)
+			// A sequence that only set reverse video has nothing left to say,
+			// and an empty one would read as a reset.
+			if params != "" || orig == "" {
+				b.WriteString("\x1b[" + params + "m")
+			}
+			reversed = rev
+			b.WriteString(reverseSGR(reversed))
+		}
+		line = line[end+1:]
+	}
+}
+
+// stripReverse removes the reverse-video parameters from an SGR parameter
+// list, tracking what they and any reset did to the program's reverse state.
+// The operands of extended colors (38, 48 and 58) are skipped so a color index
+// of 7 or 27 is not mistaken for reverse video.
+func stripReverse(params string, reversed bool) (string, bool) {
+	if params == "" {
+		return "", false
+	}
+	fields := strings.Split(params, ";")
+	kept := fields[:0]
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+		switch f {
+		case "", "0":
+			reversed = false
+		case "7":
+			reversed = true
+			continue
+		case "27":
+			reversed = false
+			continue
+		case "38", "48", "58":
+			n := 0
+			if i+1 < len(fields) {
+				switch fields[i+1] {
+				case "5":
+					n = 2
+				case "2":
+					n = 4
+				}
+			}
+			n = min(n, len(fields)-1-i)
+			kept = append(kept, fields[i:i+1+n]...)
+			i += n
+			continue
+		}
+		kept = append(kept, f)
+	}
+	return strings.Join(kept, ";"), reversed
+}
+
+// reverseSGR returns the sequence that draws the opposite of the program's
+// reverse state.
+func reverseSGR(reversed bool) string {
+	if reversed {
+		return "\x1b[27m"
+	}
+	return "\x1b[7m"
+}
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// TestInvertWindowColors toggles inversion on the focused window and checks
+// the render pass reverses plain text, un-reverses text the program reversed,
+// leaves a color index of 7 alone, and fills the pane, while the emulator
+// itself is untouched.
+func TestInvertWindowColors(t *testing.T) {
+	win := newTestWindow(t, "invert", 40, 10)
+	win.WriteOutput([]byte("plain \x1b[7mreversed\x1b[27m \x1b[38;5;7mgrey\x1b[0m\r\n"))
+	m := newTestOS(win)
+
+	content := m.renderTerminal(win, true, false)
+	if got := withInversion(win, content); got != content {
+		t.Fatal("a window that is not inverted was changed")
+	}
+
+	m.ToggleInvert()
+	if !win.Inverted {
+		t.Fatal("ToggleInvert did not invert the focused window")
+	}
+	inverted := withInversion(win, content)
+	lines := strings.Split(inverted, "\n")
+	if len(lines) != win.ContentHeight() {
+		t.Errorf("inverted render has %d lines, want the content height %d", len(lines), win.ContentHeight())
+	}
+	for i, line := range lines {
+		if w := ansi.StringWidth(line); w != win.ContentWidth() {
+			t.Errorf("line %d is %d cells wide, want the content width %d", i, w, win.ContentWidth())
+		}
+	}
+	if !strings.HasPrefix(inverted, "\x1b[7m") {
+		t.Errorf("inverted render does not start in reverse video: %q", lines[0])
+	}
+	if i := strings.Index(lines[0], "reversed"); i < 0 || !strings.HasSuffix(lines[0][:i], "\x1b[27m") {
+		t.Errorf("text the program reversed is not drawn the other way round: %q", lines[0])
+	}
+	if ansi.Strip(lines[0])[:len("plain reversed grey")] != "plain reversed grey" {
+		t.Errorf("inversion changed the text: %q", ansi.Strip(lines[0]))
+	}
+
+	params, reversed := stripReverse("1;7;38;5;7;48;2;7;27;7", false)
+	if params != "1;38;5;7;48;2;7;27;7" || !reversed {
+		t.Errorf("stripReverse = %q, %v; want the color operands kept and reverse on", params, reversed)
+	}
+	if _, reversed := stripReverse("0", true); reversed {
+		t.Error("a reset did not clear the program's reverse video")
+	}
+
+	cell := win.Terminal.CellAt(0, 0)
+	if cell == nil || cell.Style.Attrs != 0 {
+		t.Error("inverting touched the emulator's cells")
+	}
+
+	m.ToggleInvert()
+	if win.Inverted {
+		t.Error("a second ToggleInvert left the window inverted")
+	}
+}
@@ -185,6 +185,7 @@ type WindowPositionInfo struct {
 	WindowY            int
 	ContentOffsetX     int
 	ContentOffsetY     int
+	ContentHeight      int // Rows of terminal content; the borders above and below it can differ
 	Width              int
 	Height             int
 	Visible            bool
@@ -110,12 +110,12 @@ func (m *OS) setupKittyPassthrough(window *terminal.Window) {
 
 		cursorPos := win.Terminal.CursorPosition()
 		scrollbackLen := win.Terminal.ScrollbackLen()
-		borderOff := win.BorderOffset()
+		offX := win.ContentOffsetX()
 		result := kp.ForwardCommand(
 			cmd, rawData, win.ID,
 			win.X, win.Y,
 			win.Width, win.Height,
-			borderOff, borderOff,
+			offX, win.ContentOffsetY(),
 			cursorPos.X, cursorPos.Y,
 			scrollbackLen,
 			win.IsAltScreen(),
@@ -84,12 +84,12 @@ func (kp *KittyPassthrough) RefreshAllPlacements(getAllWindows func() map[string
 		// with the window. The change detection below (posChanged check)
 		// ensures we only re-place if the position actually changed.
 
-		// Calculate viewport dimensions (accounting for window borders).
-		// For tiled/borderless windows BorderOffset=0, so content area is full
-		// Width×Height. For floating windows with a border, it's 1, so content
-		// is (Width-2)×(Height-2).
+		// Calculate viewport dimensions (accounting for window borders and
+		// content padding). For tiled/borderless windows without padding
+		// ContentOffsetX=0, so content area is full Width×Height. For floating
+		// windows with a border, it's 1, so content is (Width-2)×(Height-2).
 		viewportTop := info.ScrollbackLen - info.ScrollOffset
-		viewportHeight := info.Height - 2*info.ContentOffsetY
+		viewportHeight := info.ContentHeight
 		viewportWidth := info.Width - 2*info.ContentOffsetX
 
 		// Collect IDs to delete (for altscreen cleanup)
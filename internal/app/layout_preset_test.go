@@ -0,0 +1,62 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/layout"
+)
+
+// TestApplyLayoutPreset checks that a preset moves floating windows straight
+// into place and, with BSP tiling on, rebuilds the workspace tree so a later
+// retile keeps the arrangement instead of falling back to the spiral.
+func TestApplyLayoutPreset(t *testing.T) {
+	prevDock, prevShared, prevAnim := config.DockbarPosition, config.SharedBorders, config.AnimationsEnabled
+	defer func() {
+		config.DockbarPosition, config.SharedBorders, config.AnimationsEnabled = prevDock, prevShared, prevAnim
+	}()
+	config.DockbarPosition = "hidden"
+	config.SharedBorders = false
+	config.AnimationsEnabled = false
+
+	newOS := func() *OS {
+		m := newTestOS(newTestWindow(t, "preset-a-0001", 20, 10))
+		m.Windows = append(m.Windows,
+			newTestWindow(t, "preset-b-0001", 20, 10),
+			newTestWindow(t, "preset-c-0001", 20, 10))
+		m.Width, m.Height = 120, 40
+		return m
+	}
+	wantRows := func(t *testing.T, m *OS) {
+		t.Helper()
+		for i, w := range m.Windows {
+			if w.X != 0 || w.Y != i*13 || w.Width != 120 {
+				t.Errorf("window %d at (%d,%d) width %d, want a full-width row at y=%d", i, w.X, w.Y, w.Width, i*13)
+			}
+		}
+	}
+
+	m := newOS()
+	if !m.ApplyLayoutPreset(layout.PresetRows) {
+		t.Fatal("floating preset not applied")
+	}
+	wantRows(t, m)
+
+	m = newOS()
+	m.AutoTiling, m.UseBSPLayout = true, true
+	m.TileAllWindows()
+	if !m.ApplyLayoutPreset(layout.PresetRows) {
+		t.Fatal("tiled preset not applied")
+	}
+	wantRows(t, m)
+	m.TileAllWindows()
+	wantRows(t, m)
+
+	if m.ApplyLayoutPreset("diagonal") {
+		t.Error("unknown preset reported as applied")
+	}
+	m.UseScrollingLayout = true
+	if m.ApplyLayoutPreset(layout.PresetGrid) {
+		t.Error("preset applied to the scrolling layout")
+	}
+}
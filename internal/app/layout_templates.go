@@ -120,6 +120,10 @@ func SaveLayoutTemplate(name string, m *OS) error {
 			CustomName: w.CustomName,
 			Minimized:  w.Minimized,
 		}
+		if w.AutoNamed {
+			// The command running now is no name for the window the layout makes.
+			lw.CustomName = ""
+		}
 
 		// Capture working directory from the terminal's CWD if available
 		if w.Terminal != nil {
@@ -212,7 +216,7 @@ func ApplyLayoutTemplate(tmpl LayoutTemplate, m *OS) {
 				title = tw.Title
 			}
 			before := len(m.Windows)
-			m.AddWindow(title)
+			m.addWindow(title, tw.Command, tw.Args)
 			// In a daemon session the window does not exist yet: the daemon is
 			// creating it and will push it back. Only take the new window when
 			// one actually appeared, or this would grab the last existing window
@@ -252,10 +256,7 @@ func ApplyLayoutTemplate(tmpl LayoutTemplate, m *OS) {
 
 		// If template specifies a startup command, run it (only for newly created windows)
 		if i >= len(existingWindows) && tw.Command != "" {
-			cmd := tw.Command
-			if len(tw.Args) > 0 {
-				cmd += " " + strings.Join(tw.Args, " ")
-			}
+			cmd := win.SpawnCommandLine()
 			if win.Pty != nil {
 				_, _ = win.Pty.Write([]byte(cmd + "\n"))
 			} else if win.DaemonWriteFunc != nil {
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"log"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// StartupCommandMsg carries the result of startup.startup_command back to the
+// Update loop, which logs it.
+type StartupCommandMsg struct {
+	Output string
+	Err    error
+}
+
+// startupCommand returns a tea.Cmd running the configured startup command, or
+// nil when there is none. It is started from Init, so the command runs beside
+// the first frames rather than ahead of them.
+func (m *OS) startupCommand() tea.Cmd {
+	if m.UserConfig == nil {
+		return nil
+	}
+	command := strings.TrimSpace(m.UserConfig.Startup.StartupCommand)
+	if command == "" {
+		return nil
+	}
+	return func() tea.Msg {
+		out, err := runShellCommand(command, config.StartupCommandTimeout, true)
+		return StartupCommandMsg{Output: out, Err: err}
+	}
+}
+
+// logStartupCommand records a finished startup command in the log.
+func (m *OS) logStartupCommand(msg StartupCommandMsg) {
+	if msg.Err != nil {
+		m.LogWarn("startup_command failed: %v", msg.Err)
+	}
+	if out := strings.TrimSpace(msg.Output); out != "" {
+		m.Log("INFO", "startup_command: %s", out)
+	}
+}
+
+// RunShutdownCommand runs the configured shutdown command and waits for it, up
+// to config.ShutdownCommandTimeout. It is called once the program has stopped,
+// so its output goes to the process log rather than the log viewer.
+func (m *OS) RunShutdownCommand() {
+	if m.UserConfig == nil {
+		return
+	}
+	command := strings.TrimSpace(m.UserConfig.Startup.ShutdownCommand)
+	if command == "" {
+		return
+	}
+	out, err := runShellCommand(command, config.ShutdownCommandTimeout, true)
+	if err != nil {
+		log.Printf("shutdown_command failed: %v", err)
+	}
+	if out = strings.TrimSpace(out); out != "" {
+		log.Printf("shutdown_command: %s", out)
+	}
+}
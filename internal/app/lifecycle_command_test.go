@@ -0,0 +1,47 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// TestStartupCommandRunsAndLogs checks that the configured startup command
+// runs off the Update loop and that its output lands in the log, and that no
+// command means no tea.Cmd at all.
+func TestStartupCommandRunsAndLogs(t *testing.T) {
+	m := &OS{UserConfig: config.DefaultConfig()}
+	if m.startupCommand() != nil {
+		t.Fatal("startupCommand returned a command with none configured")
+	}
+
+	m.UserConfig.Startup.StartupCommand = "echo hello"
+	cmd := m.startupCommand()
+	if cmd == nil {
+		t.Fatal("startupCommand returned nil with a command configured")
+	}
+	msg, ok := cmd().(StartupCommandMsg)
+	if !ok || msg.Err != nil {
+		t.Fatalf("startup command result = %#v, want a successful StartupCommandMsg", msg)
+	}
+	m.logStartupCommand(msg)
+	if n := len(m.LogMessages); n == 0 || !strings.Contains(m.LogMessages[n-1].Message, "hello") {
+		t.Errorf("log = %v, want the command's output", m.LogMessages)
+	}
+}
+
+// TestRunShutdownCommand checks that the shutdown command has finished by the
+// time RunShutdownCommand returns, since the process exits right after it.
+func TestRunShutdownCommand(t *testing.T) {
+	marker := filepath.Join(t.TempDir(), "done")
+	m := &OS{UserConfig: config.DefaultConfig()}
+	m.UserConfig.Startup.ShutdownCommand = "touch " + marker
+
+	m.RunShutdownCommand()
+	if _, err := os.Stat(marker); err != nil {
+		t.Errorf("shutdown command did not run before returning: %v", err)
+	}
+}
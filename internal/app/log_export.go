@@ -0,0 +1,61 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// String formats the message as the log viewer shows it, without colour.
+func (l LogMessage) String() string {
+	return fmt.Sprintf("%s [%s] %s", l.Time.Format("15:04:05"), l.Level, l.Message)
+}
+
+// ExportLogs writes the messages held by the log viewer to a timestamped file
+// next to the crash logs and returns its path, so the trail leading up to a
+// problem can be attached to a bug report.
+func (m *OS) ExportLogs() (string, error) {
+	if len(m.LogMessages) == 0 {
+		return "", fmt.Errorf("no log messages to export")
+	}
+	dir := CrashLogDir()
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return "", fmt.Errorf("create log directory: %w", err)
+	}
+
+	now := time.Now()
+	path := filepath.Join(dir, fmt.Sprintf("logs-%s.log", now.Format("2006-01-02_15-04-05")))
+
+	var b strings.Builder
+	b.WriteString("tuios logs\n")
+	b.WriteString("==========\n\n")
+	fmt.Fprintf(&b, "Exported: %s\n", now.Format(time.RFC3339))
+	fmt.Fprintf(&b, "Since:    %s\n", m.LogMessages[0].Time.Format(time.RFC3339))
+	fmt.Fprintf(&b, "OS/Arch:  %s/%s\n", runtime.GOOS, runtime.GOARCH)
+	fmt.Fprintf(&b, "Messages: %d\n\n", len(m.LogMessages))
+	for _, msg := range m.LogMessages {
+		b.WriteString(msg.String())
+		b.WriteByte('\n')
+	}
+
+	if err := os.WriteFile(path, []byte(b.String()), 0600); err != nil {
+		return "", fmt.Errorf("write logs: %w", err)
+	}
+	return path, nil
+}
+
+// SaveLogs exports the logs and reports where they went, or why they could
+// not be written. Shared by the log viewer's s key and the command palette.
+func (m *OS) SaveLogs() {
+	path, err := m.ExportLogs()
+	if err != nil {
+		m.ShowNotification("Export logs: "+err.Error(), "error", config.NotificationDuration)
+		return
+	}
+	m.ShowNotification("Logs saved to "+path, "info", config.NotificationDuration)
+}
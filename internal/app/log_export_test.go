@@ -0,0 +1,66 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/adrg/xdg"
+)
+
+// TestExportLogsWritesViewerLines exports a few messages into a temp state dir
+// and checks each appears with its time and level as the viewer shows it.
+func TestExportLogsWritesViewerLines(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	xdg.Reload()
+	t.Cleanup(xdg.Reload)
+
+	m := newTestOS(newTestWindow(t, "logs", 20, 5))
+	if _, err := m.ExportLogs(); err == nil {
+		t.Fatal("exporting with no messages should fail")
+	}
+
+	m.Log("INFO", "started %d windows", 2)
+	m.Log("WARN", "slow frame")
+	m.Log("ERROR", "pty closed")
+
+	path, err := m.ExportLogs()
+	if err != nil {
+		t.Fatalf("ExportLogs: %v", err)
+	}
+	if filepath.Dir(path) != CrashLogDir() {
+		t.Errorf("exported to %s, want a file in %s", path, CrashLogDir())
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read export: %v", err)
+	}
+	for _, msg := range m.LogMessages {
+		if !strings.Contains(string(data), msg.String()+"\n") {
+			t.Errorf("export is missing %q", msg.String())
+		}
+	}
+	if !strings.Contains(string(data), "[WARN] slow frame") {
+		t.Errorf("export lines lack the level:\n%s", data)
+	}
+}
+
+// TestLogKeepsMaxLogMessages checks the buffer follows a configured retention.
+func TestLogKeepsMaxLogMessages(t *testing.T) {
+	prev := config.MaxLogMessages
+	config.MaxLogMessages = 10
+	t.Cleanup(func() { config.MaxLogMessages = prev })
+
+	m := newTestOS(newTestWindow(t, "logs", 20, 5))
+	for i := range 25 {
+		m.Log("WARN", "message %d", i)
+	}
+	if len(m.LogMessages) != 10 {
+		t.Fatalf("kept %d messages, want 10", len(m.LogMessages))
+	}
+	if got := m.LogMessages[0].Message; got != "message 15" {
+		t.Errorf("oldest kept message = %q, want %q", got, "message 15")
+	}
+}
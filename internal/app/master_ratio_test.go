@@ -0,0 +1,45 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// TestCycleMasterRatio checks the presets are stepped through in order and
+// wrap, the master window is retiled to each, a ratio that is no preset moves
+// to the first one above it, and the binding does nothing outside
+// master-stack tiling.
+func TestCycleMasterRatio(t *testing.T) {
+	prev := config.MasterRatioPresets
+	t.Cleanup(func() { config.MasterRatioPresets = prev })
+	config.MasterRatioPresets = []float64{0.33, 0.5, 0.67}
+
+	master := newTestWindow(t, "ratio-0001", 40, 10)
+	stack := newTestWindow(t, "ratio-0002", 40, 10)
+	m := newTestOS(master)
+	m.Windows = append(m.Windows, stack)
+	m.Width, m.Height = 120, 40
+	m.AutoTiling = true
+	m.MasterRatio = 0.5
+
+	for _, want := range []float64{0.67, 0.33, 0.5, 0.67} {
+		got, ok := m.CycleMasterRatio()
+		if !ok || got != want || m.MasterRatio != want {
+			t.Fatalf("CycleMasterRatio() = %v, %v (ratio %v), want %v", got, ok, m.MasterRatio, want)
+		}
+		if wantW := int(float64(m.GetRenderWidth()) * want); master.Width != wantW {
+			t.Errorf("at ratio %v the master is %d wide, want %d", want, master.Width, wantW)
+		}
+	}
+
+	m.MasterRatio = 0.4
+	if got, _ := m.CycleMasterRatio(); got != 0.5 {
+		t.Errorf("from a ratio of 0.4 the cycle went to %v, want the next preset up 0.5", got)
+	}
+
+	m.UseBSPLayout = true
+	if _, ok := m.CycleMasterRatio(); ok {
+		t.Error("CycleMasterRatio changed the ratio under BSP tiling")
+	}
+}
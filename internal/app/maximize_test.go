@@ -0,0 +1,72 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestToggleMaximizeRestoresGeometry checks that a second click on the
+// maximize button puts the window back where it was, instead of snapping to
+// fullscreen again.
+func TestToggleMaximizeRestoresGeometry(t *testing.T) {
+	prevAnim, prevAction := config.AnimationsEnabled, config.MaximizeButtonAction
+	defer func() { config.AnimationsEnabled, config.MaximizeButtonAction = prevAnim, prevAction }()
+	config.AnimationsEnabled = false
+	config.MaximizeButtonAction = config.MaximizeFullscreenToggle
+
+	win := newTestWindow(t, "max-win-a", 40, 12)
+	win.X, win.Y = 5, 3
+	m := newTestOS(win)
+	m.Width, m.Height = 120, 40
+
+	m.ToggleMaximize(0)
+	x, y, w, h := m.calculateSnapBounds(SnapFullScreen)
+	if win.X != x || win.Y != y || win.Width != w || win.Height != h {
+		t.Fatalf("after maximize got %d,%d %dx%d, want %d,%d %dx%d", win.X, win.Y, win.Width, win.Height, x, y, w, h)
+	}
+
+	m.ToggleMaximize(0)
+	if win.X != 5 || win.Y != 3 || win.Width != 40 || win.Height != 12 {
+		t.Fatalf("after restore got %d,%d %dx%d, want 5,3 40x12", win.X, win.Y, win.Width, win.Height)
+	}
+	if win.Maximized {
+		t.Error("window still marked maximized after restore")
+	}
+}
+
+// TestToggleMaximizeWorkspaceFullscreen checks that workspace-fullscreen
+// minimizes the other windows on the workspace and brings them back on the
+// second click, leaving other workspaces alone.
+func TestToggleMaximizeWorkspaceFullscreen(t *testing.T) {
+	prevAnim, prevAction := config.AnimationsEnabled, config.MaximizeButtonAction
+	defer func() { config.AnimationsEnabled, config.MaximizeButtonAction = prevAnim, prevAction }()
+	config.AnimationsEnabled = false
+	config.MaximizeButtonAction = config.MaximizeWorkspaceFullscreen
+
+	main := newTestWindow(t, "max-win-main", 40, 12)
+	other := newTestWindow(t, "max-win-other", 30, 10)
+	away := newTestWindow(t, "max-win-away", 30, 10)
+	main.Workspace, other.Workspace, away.Workspace = 1, 1, 2
+	m := newTestOS(main)
+	m.Windows = []*terminal.Window{main, other, away}
+	m.CurrentWorkspace = 1
+	m.Width, m.Height = 120, 40
+
+	m.ToggleMaximize(0)
+	if !other.Minimized {
+		t.Fatal("the other window on the workspace was not minimized")
+	}
+	if away.Minimized {
+		t.Fatal("a window on another workspace was minimized")
+	}
+
+	m.ToggleMaximize(0)
+	if other.Minimized {
+		t.Error("the minimized window was not restored")
+	}
+	if m.FocusedWindow != 0 {
+		t.Errorf("focus on %d after restore, want the maximized window", m.FocusedWindow)
+	}
+}
@@ -0,0 +1,61 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// ToggleMonocle turns the monocle layout on or off for the current workspace.
+// In monocle every tiled window fills the tiling area and only the focused one
+// is seen; cycling windows brings the next one to the top. The BSP tree and
+// master ratio are left as they are, so turning monocle off puts the windows
+// back where they were. It reports false when there is no tiling layout to
+// apply it to: tiling is off, or the scrolling layout is in use.
+func (m *OS) ToggleMonocle() bool {
+	if !m.AutoTiling || m.UseScrollingLayout {
+		return false
+	}
+	if m.WorkspaceMonocle == nil {
+		m.WorkspaceMonocle = make(map[int]bool)
+	}
+	if m.WorkspaceMonocle[m.CurrentWorkspace] {
+		delete(m.WorkspaceMonocle, m.CurrentWorkspace)
+		m.ShowNotification("Monocle off", "info", config.NotificationDuration)
+	} else {
+		m.WorkspaceMonocle[m.CurrentWorkspace] = true
+		m.ShowNotification("Monocle on", "info", config.NotificationDuration)
+	}
+	m.TileAllWindows()
+	m.MarkAllDirty()
+	return true
+}
+
+// MonocleActive reports whether the current workspace is laid out as a
+// monocle.
+func (m *OS) MonocleActive() bool {
+	return m.AutoTiling && !m.UseScrollingLayout && m.WorkspaceMonocle[m.CurrentWorkspace]
+}
+
+// monocleLabel is the dock indicator for monocle, "MONOCLE [2/5]": the
+// focused window's place among the workspace's tiled windows and their count.
+// It is empty when monocle is off.
+func (m *OS) monocleLabel() string {
+	if !m.MonocleActive() {
+		return ""
+	}
+	pos, total := 0, 0
+	for i, w := range m.Windows {
+		if w.Workspace != m.CurrentWorkspace || w.Minimized || w.Minimizing || w.IsFloating {
+			continue
+		}
+		total++
+		if i == m.FocusedWindow {
+			pos = total
+		}
+	}
+	if pos == 0 {
+		return fmt.Sprintf("MONOCLE [%d]", total)
+	}
+	return fmt.Sprintf("MONOCLE [%d/%d]", pos, total)
+}
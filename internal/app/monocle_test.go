@@ -0,0 +1,96 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// TestToggleMonocle checks monocle maximizes every tiled window without
+// touching the BSP tree, reports the focused window's place, restores the
+// split layout when turned off, and ends when its last window closes or tiling
+// is disabled.
+func TestToggleMonocle(t *testing.T) {
+	prevAnim := config.AnimationsEnabled
+	config.AnimationsEnabled = false
+	defer func() { config.AnimationsEnabled = prevAnim }()
+
+	m := &OS{
+		CurrentWorkspace: 1,
+		WorkspaceFocus:   map[int]int{},
+		Width:            120,
+		Height:           40,
+		AutoTiling:       true,
+		UseBSPLayout:     true,
+		FocusedWindow:    1,
+	}
+	for _, id := range []string{"window-a", "window-b", "window-c"} {
+		w := newTestWindow(t, id, 20, 10)
+		w.Workspace = 1
+		m.Windows = append(m.Windows, w)
+	}
+	m.TileAllWindows()
+	split := m.Windows[0].Width
+	bounds := m.GetBSPBounds()
+	if split == bounds.W {
+		t.Fatal("windows were not split before monocle")
+	}
+	treeIDs := len(m.WorkspaceTrees[1].GetAllWindowIDs())
+
+	if !m.ToggleMonocle() {
+		t.Fatal("ToggleMonocle reported no tiling layout")
+	}
+	for _, w := range m.Windows {
+		if w.X != bounds.X || w.Y != bounds.Y || w.Width != bounds.W || w.Height != bounds.H {
+			t.Errorf("%s at %d,%d %dx%d, want the whole area", w.ID, w.X, w.Y, w.Width, w.Height)
+		}
+	}
+	if got := len(m.WorkspaceTrees[1].GetAllWindowIDs()); got != treeIDs {
+		t.Errorf("monocle changed the tree: %d windows, want %d", got, treeIDs)
+	}
+	if got := m.monocleLabel(); got != "MONOCLE [2/3]" {
+		t.Errorf("label %q", got)
+	}
+
+	m.ToggleMonocle()
+	if m.Windows[0].Width != split {
+		t.Errorf("width %d after monocle off, want the split width %d", m.Windows[0].Width, split)
+	}
+
+	// Closing the shown window shows the next one; closing the last ends
+	// monocle.
+	m.ToggleMonocle()
+	m.deleteWindowNow(m.FocusedWindow)
+	if !m.MonocleActive() {
+		t.Fatal("monocle ended while windows were left")
+	}
+	if w := m.GetFocusedWindow(); w == nil || w.Width != bounds.W {
+		t.Error("no window fills the area after closing the zoomed one")
+	}
+	for len(m.Windows) > 1 {
+		m.deleteWindowNow(0)
+	}
+	m.deleteWindowNow(0)
+	if m.MonocleActive() {
+		t.Error("monocle survived closing every window")
+	}
+
+	for _, id := range []string{"window-d", "window-e"} {
+		w := newTestWindow(t, id, 20, 10)
+		w.Workspace = 1
+		m.Windows = append(m.Windows, w)
+	}
+	m.FocusedWindow = 0
+	m.TileAllWindows()
+	m.ToggleMonocle()
+	m.DisableAllTiling()
+	m.AutoTiling = true
+	if m.MonocleActive() {
+		t.Error("monocle survived turning tiling off")
+	}
+
+	m.UseScrollingLayout = true
+	if m.ToggleMonocle() {
+		t.Error("monocle applied to the scrolling layout")
+	}
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestMultifocusWorkspace checks that workspace broadcast adds every visible
+// window on the current workspace, leaves other workspaces' members alone,
+// and removes the workspace again when pressed a second time.
+func TestMultifocusWorkspace(t *testing.T) {
+	m := &OS{CurrentWorkspace: 1}
+	m.Windows = []*terminal.Window{
+		{ID: "mf-win-a", Workspace: 1},
+		{ID: "mf-win-b", Workspace: 1},
+		{ID: "mf-win-c", Workspace: 1, Minimized: true},
+		{ID: "mf-win-d", Workspace: 2},
+	}
+	m.MultifocusSet = map[string]bool{"mf-win-d": true}
+
+	m.MultifocusWorkspace()
+	for id, want := range map[string]bool{"mf-win-a": true, "mf-win-b": true, "mf-win-c": false, "mf-win-d": true} {
+		if m.MultifocusSet[id] != want {
+			t.Errorf("after first toggle %s in set = %v, want %v", id, m.MultifocusSet[id], want)
+		}
+	}
+
+	m.MultifocusWorkspace()
+	if m.MultifocusSet["mf-win-a"] || m.MultifocusSet["mf-win-b"] {
+		t.Error("second toggle should remove the workspace's windows")
+	}
+	if !m.MultifocusSet["mf-win-d"] {
+		t.Error("a member on another workspace was removed")
+	}
+}
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// stackBeneath is where config.NewFloatingZOrder puts a new floating window
+// that does not go on top.
+type stackBeneath struct {
+	z       int    // Z the new window takes; windows at or above it move up one
+	focusID string // window that keeps focus instead of the new one
+}
+
+// newFloatingBeneath decides, before a window is created, whether
+// config.NewFloatingZOrder keeps it off the top of the stack. It reports false
+// when the window should be focused above everything as usual: the setting is
+// top, the window will be tiled, there is no focused window to keep, or, for
+// respect-cursor, the mouse is not over a window.
+func (m *OS) newFloatingBeneath() (stackBeneath, bool) {
+	focused := m.GetFocusedWindow()
+	if m.AutoTiling || focused == nil {
+		return stackBeneath{}, false
+	}
+	switch config.NewFloatingZOrder {
+	case config.NewFloatingZBottom:
+		return stackBeneath{z: -1, focusID: focused.ID}, true
+	case config.NewFloatingZRespectCursor:
+		if m.LastMouseX <= 0 || m.LastMouseY <= 0 {
+			return stackBeneath{}, false
+		}
+		if under := m.windowAtPoint(m.LastMouseX, m.LastMouseY); under != nil {
+			return stackBeneath{z: under.Z, focusID: focused.ID}, true
+		}
+	}
+	return stackBeneath{}, false
+}
+
+// windowAtPoint returns the topmost window on the current workspace covering
+// the cell at x, y, or nil.
+func (m *OS) windowAtPoint(x, y int) *terminal.Window {
+	var top *terminal.Window
+	for _, w := range m.GetVisibleWindows() {
+		if x < w.X || x >= w.X+w.Width || y < w.Y || y >= w.Y+w.Height {
+			continue
+		}
+		if top == nil || w.Z > top.Z {
+			top = w
+		}
+	}
+	return top
+}
+
+// placeBeneath stacks w, already in m.Windows, at s.z and leaves focus with
+// s.focusID, which the new window would otherwise have taken.
+func (m *OS) placeBeneath(w *terminal.Window, s stackBeneath) {
+	for _, other := range m.Windows {
+		if other != w && other.Z >= s.z {
+			other.Z++
+		}
+	}
+	w.Z = s.z
+	for i, other := range m.Windows {
+		if other.ID == s.focusID {
+			m.FocusWindow(i)
+			break
+		}
+	}
+	m.RecalcZOrder()
+}
@@ -0,0 +1,99 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// setNewFloatingZOrder switches config.NewFloatingZOrder for one test.
+func setNewFloatingZOrder(t *testing.T, v string) {
+	t.Helper()
+	prev := config.NewFloatingZOrder
+	config.NewFloatingZOrder = v
+	t.Cleanup(func() { config.NewFloatingZOrder = prev })
+}
+
+// bottomWindow returns the window with the lowest Z.
+func bottomWindow(m *OS) *terminal.Window {
+	bottom := m.Windows[0]
+	for _, w := range m.Windows[1:] {
+		if w.Z < bottom.Z {
+			bottom = w
+		}
+	}
+	return bottom
+}
+
+// TestNewFloatingWindowAtBottom spawns a window with new_floating_z_order =
+// bottom: it goes beneath the others, focus stays put, and it is still at the
+// bottom after focus moves on.
+func TestNewFloatingWindowAtBottom(t *testing.T) {
+	m := newStartupOS(t, false, false)
+	defer closeWindows(m)
+
+	m.AddWindow("first")
+	m.AddWindow("second")
+	if len(m.Windows) != 2 {
+		t.Fatalf("expected 2 windows, got %d", len(m.Windows))
+	}
+
+	setNewFloatingZOrder(t, config.NewFloatingZBottom)
+	m.AddWindow("monitor")
+	if len(m.Windows) != 3 {
+		t.Fatalf("expected 3 windows, got %d", len(m.Windows))
+	}
+	monitor := m.Windows[2]
+
+	if got := m.GetFocusedWindow(); got == nil || got.CustomName != "second" {
+		t.Fatalf("focus moved to the new window; want it on %q", "second")
+	}
+	if bottomWindow(m) != monitor {
+		t.Fatalf("new window Z = %d, want it beneath every other window", monitor.Z)
+	}
+
+	m.FocusWindow(0)
+	if bottomWindow(m) != monitor {
+		t.Errorf("new window rose to Z %d after a focus change, want it still at the bottom", monitor.Z)
+	}
+}
+
+// TestNewFloatingWindowRespectsCursor checks respect-cursor: over empty
+// desktop the window goes on top as usual, over a window it goes just beneath
+// that window.
+func TestNewFloatingWindowRespectsCursor(t *testing.T) {
+	setNewFloatingZOrder(t, config.NewFloatingZRespectCursor)
+
+	a := newTestWindow(t, "stack-a", 20, 10)
+	b := newTestWindow(t, "stack-b", 20, 10)
+	a.Workspace, b.Workspace = 1, 1
+	a.X, a.Y = 0, 0
+	b.X, b.Y = 40, 0
+	m := newTestOS(a)
+	m.CurrentWorkspace = 1
+	m.Windows = append(m.Windows, b)
+	m.RecalcZOrder()
+
+	m.LastMouseX, m.LastMouseY = 70, 30
+	if _, ok := m.newFloatingBeneath(); ok {
+		t.Error("mouse over empty desktop: new window should go on top")
+	}
+
+	m.LastMouseX, m.LastMouseY = 45, 5
+	s, ok := m.newFloatingBeneath()
+	if !ok {
+		t.Fatal("mouse over a window: new window should go beneath it")
+	}
+	c := newTestWindow(t, "stack-c", 20, 10)
+	c.Workspace = 1
+	m.Windows = append(m.Windows, c)
+	m.placeBeneath(c, s)
+
+	if m.GetFocusedWindow() != a {
+		t.Error("focus left the window that had it")
+	}
+	if !(c.Z < b.Z) {
+		t.Errorf("new window Z %d is not beneath the window under the mouse (Z %d)", c.Z, b.Z)
+	}
+}
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// TestNotificationsDropOldestPastMax checks the stack keeps only the newest
+// config.MaxNotifications messages.
+func TestNotificationsDropOldestPastMax(t *testing.T) {
+	prev := config.MaxNotifications
+	config.MaxNotifications = 2
+	defer func() { config.MaxNotifications = prev }()
+
+	m := newTestOS(newTestWindow(t, "window-a", 20, 5))
+	for i := range 4 {
+		m.ShowNotification(fmt.Sprintf("note %d", i), "info", config.NotificationDuration)
+	}
+	if len(m.Notifications) != 2 {
+		t.Fatalf("kept %d notifications, want 2", len(m.Notifications))
+	}
+	if m.Notifications[0].Message != "note 2" || m.Notifications[1].Message != "note 3" {
+		t.Errorf("kept %q and %q, want the two newest", m.Notifications[0].Message, m.Notifications[1].Message)
+	}
+}
+
+// TestNotificationPositionBottomCenter checks a bottom stack is centred and
+// ends just above the dock, the newest lowest.
+func TestNotificationPositionBottomCenter(t *testing.T) {
+	prev := config.NotificationPosition
+	config.NotificationPosition = config.NotificationBottomCenter
+	defer func() { config.NotificationPosition = prev }()
+
+	m := newTestOS(newTestWindow(t, "window-a", 20, 5))
+	m.Width, m.Height = 100, 40
+	m.ShowNotification("first", "info", config.NotificationDuration)
+	m.ShowNotification("second", "info", config.NotificationDuration)
+
+	bottom := m.GetTopMargin() + m.GetUsableHeight()
+	x, y := m.notificationPosition(20, 1, 4)
+	if x != 40 {
+		t.Errorf("x = %d, want 40 to centre a 20-wide box", x)
+	}
+	if y+3 != bottom {
+		t.Errorf("newest ends at row %d, want %d", y+3, bottom)
+	}
+	if _, older := m.notificationPosition(20, 0, 4); older != y-4 {
+		t.Errorf("older notification at row %d, want %d above the newest", older, y-4)
+	}
+}
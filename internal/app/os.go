@@ -142,18 +142,35 @@ type OS struct {
 	LastCPUUpdate      time.Time                  // Last time CPU was updated
 	RAMUsage           float64                    // Cached RAM usage percentage
 	LastRAMUpdate      time.Time                  // Last time RAM was updated
+	StatusText         string                     // Cached config.StatusCommand output shown in the dock
+	statusLastRun      time.Time                  // When config.StatusCommand was last started
+	statusRunning      bool                       // A config.StatusCommand run is in flight
+	ScrollbackTrimmed  int64                      // Bytes of scrollback dropped to stay within config.TotalScrollbackBudgetMB
+	scrollbackChecked  time.Time                  // When the scrollback budget was last checked
+	autoRenameChecked  time.Time                  // When windows were last checked for a new foreground command
 	AutoTiling         bool                       // Automatic tiling mode enabled
-	MasterRatio        float64                    // Master window width ratio for tiling (0.3-0.7)
+	MasterRatio        float64                    // Master window width ratio for tiling (config.MasterRatioMin-Max)
+	MasterRatioPreset  int                        // Index into config.MasterRatioPresets last chosen by CycleMasterRatio
 	// BSP tiling state
 	WorkspaceTrees        map[int]*layout.BSPTree // BSP tree per workspace
 	PreselectionDir       layout.PreselectionDir  // Pending preselection direction (0 = none)
 	TilingScheme          layout.AutoScheme       // Default auto-insertion scheme
 	SplitTargetWindowID   string                  // Window ID to split (set before AddWindow for splits)
+	SplitShare            float64                 // Share of the split pane the new window gets (set before AddWindow for splits; 0 = configured default)
+	SplitCount            string                  // Digits typed in window mode that may be a split percentage
+	SplitCountAt          time.Time               // When the last split-count digit was typed
+	SplitCountOrigin      string                  // Window focused when the split count began
 	WindowToBSPID         map[string]int          // Maps window UUID to stable BSP integer ID
 	BSPIDToWindowID       map[int]string          // Reverse of WindowToBSPID: BSP integer ID to window UUID (speed-up for getWindowByIntID)
 	NextBSPWindowID       int                     // Next BSP window ID to assign (starts at 1)
 	RenamingWindow        bool                    // True when renaming a window
 	RenameBuffer          string                  // Buffer for new window name
+	JumpingToWindow       bool                    // True while the jump-to-window prompt is open (Ctrl+B, f)
+	ComparePicking        bool                    // True while picking two windows to compare by pane number (Ctrl+B, t, d)
+	CompareFirst          string                  // ID of the first window picked for a comparison
+	Compare               *WindowCompare          // Open window comparison overlay, nil when closed
+	JumpBuffer            string                  // Query typed into the jump-to-window prompt
+	JumpSelected          int                     // Selected match in the jump-to-window prompt
 	PrefixActive          bool                    // True when prefix key was pressed (tmux-style)
 	WorkspacePrefixActive bool                    // True when Ctrl+B, w was pressed (workspace sub-prefix)
 	MinimizePrefixActive  bool                    // True when Ctrl+B, m was pressed (minimize sub-prefix)
@@ -167,9 +184,11 @@ type OS struct {
 	CurrentWorkspace      int                     // Current active workspace (1-9)
 	NumWorkspaces         int                     // Total number of workspaces
 	WorkspaceFocus        map[int]int             // Remembers focused window per workspace
+	WorkspaceLastFocus    map[int]string          // ID of the window focused before the current one, per workspace
 	WorkspaceLayouts      map[int][]WindowLayout  // Stores custom layouts per workspace
 	WorkspaceHasCustom    map[int]bool            // Tracks if workspace has custom layout
 	WorkspaceMasterRatio  map[int]float64         // Stores master ratio per workspace
+	WorkspaceMonocle      map[int]bool            // Workspaces whose tiled windows are all maximized (see monocle.go)
 	ShowLogs              bool                    // True when showing log overlay
 	LogMessages           []LogMessage            // Store log messages
 	LogScrollOffset       int                     // Scroll offset for log viewer
@@ -237,6 +256,10 @@ type OS struct {
 	// and a deliberate quit reports an error. Written only on the Bubble Tea
 	// goroutine, like ExitReason.
 	QuitRequested bool
+	// Detached records that this client told the daemon it is detaching. The
+	// connection is no longer attached to the session after that, so nothing
+	// more is synced over it on the way out.
+	Detached bool
 	// Multi-client effective size (min of all clients in session)
 	EffectiveWidth  int // Effective width for rendering (min of all clients, 0 = use terminal size)
 	EffectiveHeight int // Effective height for rendering (min of all clients, 0 = use terminal size)
@@ -244,6 +267,9 @@ type OS struct {
 	KeyboardEnhancementsEnabled bool // True when terminal supports keyboard enhancements
 	// Keybind registry for user-configurable keybindings
 	KeybindRegistry *config.KeybindRegistry
+	// LastAction is the last repeatable registry action that ran (a snap,
+	// swap, resize step or split), replayed by repeat_last_action.
+	LastAction string
 	// ConfigWarnings holds the problems found in the loaded config, reported to
 	// the user once the TUI is up (see reportConfigWarnings).
 	ConfigWarnings []string
@@ -265,6 +291,14 @@ type OS struct {
 	// passes, whichever comes first.
 	ScriptWaitRegex    *regexp.Regexp
 	ScriptWaitDeadline time.Time
+	// WaitFor playback state. When ScriptWaitText is set, playback blocks until
+	// the window ScriptWaitWindow refers to shows it, and stops if
+	// ScriptWaitDeadline passes first.
+	ScriptWaitText   string
+	ScriptWaitWindow string
+	// tapeRunStart is the state a played tape started from, put back before
+	// each further run of a looping tape.
+	tapeRunStart *tapeRunStart
 	// Tape manager UI
 	ShowTapeManager    bool              // True when showing tape manager overlay
 	TapeManager        *TapeManagerState // Tape manager state
@@ -272,6 +306,29 @@ type OS struct {
 	TapeRecordingName  string            // Name of current recording
 	TapePrefixActive   bool              // True when Ctrl+B, T was pressed (tape sub-prefix)
 	LayoutPrefixActive bool              // True when Ctrl+B, L was pressed (layout sub-prefix)
+	SignalPrefixActive bool              // True when Ctrl+B, k was pressed (signal sub-prefix)
+	MergePrefixActive  bool              // True when Ctrl+B, w, M was pressed (pick the workspace to merge into)
+	SendPrefixActive   bool              // True when Ctrl+B, w, s was pressed (pick the workspace to send the focused window to)
+	PeekPrefixActive   bool              // True when Ctrl+B, w, p was pressed (pick the workspace to peek at)
+	PeekedWorkspace    int               // Workspace shown beside the current one (Ctrl+B, w, p); 0 when not peeking
+	HeaderPrefixActive bool              // True when Ctrl+B, t, h was pressed (pick how many header rows to freeze)
+	PrefixPassthrough  bool              // Terminal mode sends the leader key to the pane instead of starting a prefix
+	MergeConfirmTarget int               // Workspace the current one is about to be merged into; 0 when not confirming
+	PaneNumbersUntil   time.Time         // When the pane-number overlay (Ctrl+B, #) hides; zero when not shown
+	ShowWindowIndices  bool              // Prefix each window title with its selection number (Ctrl+B, I)
+	HoverFocusTarget   string            // Window hover focus is waiting to move to (config.FocusMode hover); empty when none
+	HoverFocusSeq      int               // Bumped per hover target so a stale HoverFocusMsg is ignored
+	TooltipLabel       string            // Label of the title-bar button or dock item under the pointer; empty when none
+	TooltipX           int               // Pointer position the tooltip is drawn next to
+	TooltipY           int               // Pointer position the tooltip is drawn next to
+	TooltipVisible     bool              // True once the pointer has rested on TooltipLabel for config.TooltipDelay
+	TooltipSeq         int               // Bumped per hover target so a stale TooltipMsg is ignored
+	ContextMenu        *ContextMenu      // Open right-click menu; nil when none
+	// Dock auto-hide (config.DockAutoHide). DockTucked is the state the layout
+	// was last computed for; DockRevealed holds the dock out while the mouse is
+	// at its edge.
+	DockTucked   bool
+	DockRevealed bool
 	// Remote command processing
 	ProcessingRemoteKeys bool // True when processing remote send-keys (disables animations)
 	// Remote tape script progress (used instead of ScriptPlayer for tape exec)
@@ -304,6 +361,9 @@ type OS struct {
 	// memory of handled directories, debounce bookkeeping, and the current
 	// passive indicator). See tape_detect.go.
 	tapeDetect tapeDetectState
+	// resizeSettle coalesces bursts of host terminal resizes into one
+	// relayout. See resize_settle.go.
+	resizeSettle resizeSettleState
 	// ShowTapeReview is true when the project-tape review/trust dialog is open.
 	// TapeReview holds its state (path, trust status, reviewed content, header).
 	// See tape_review.go.
@@ -338,7 +398,11 @@ type OS struct {
 	ShowLayoutPicker bool
 	LayoutCycleIndex int             // Current index in saved layouts for cycling
 	MultifocusSet    map[string]bool // Window IDs that receive keystrokes simultaneously
+	SyncScroll       bool            // Review mode: scrolling the focused window scrolls every visible window on the workspace
 	UseBSPLayout     bool            // true = BSP tiling, false = master-stack
+	// ScrollLinks pairs windows whose scrollback follows each other, keyed
+	// both ways by window ID (see LinkScroll).
+	ScrollLinks map[string]string
 	// Scrolling tiling (niri-like) layout
 	UseScrollingLayout        bool                            // true = scrolling columns mode
 	WorkspaceScrollingLayouts map[int]*layout.ScrollingLayout // per-workspace scrolling layouts
@@ -365,6 +429,11 @@ type OS struct {
 	ThemePickerScroll   int
 	ThemePickerOriginal string // theme active when the picker opened, for cancel
 
+	// Theme cycling state (leader > and <): themes are previewed one step at a
+	// time until Enter keeps the current one or Esc restores ThemeCycleOriginal.
+	ThemeCycleActive   bool
+	ThemeCycleOriginal string
+
 	// Floating overlay placement + mouse hit-testing. Each overlay kind keeps
 	// its own drag displacement in OverlayOffsets so panels (e.g. settings and
 	// the theme picker) can be moved independently. OverlayHits records every
@@ -393,6 +462,24 @@ type OS struct {
 	// terminal mode is deferred until that window materializes through a state
 	// sync and can be focused.
 	pendingStartTerminalMode bool
+
+	// AttachedToEmpty is set by the attach path when the session it joined
+	// already existed and had no windows, typically because its last shell
+	// exited while nobody was attached. startup.on_empty_session_attach
+	// decides what that means.
+	AttachedToEmpty bool
+
+	// scrollbackFiles maps a window opened by OpenScrollbackInPager to the temp
+	// file it is showing, so the file is removed when that window closes.
+	scrollbackFiles map[string]string
+
+	// pendingPager is a pager window asked of the daemon that has not arrived
+	// yet; its command is typed into the window once a state sync brings it.
+	pendingPager *pendingPager
+
+	// pendingBeneath is where a window asked of the daemon is stacked when it
+	// arrives, set when config.NewFloatingZOrder keeps it off the top.
+	pendingBeneath *stackBeneath
 }
 
 // Notification represents a temporary notification message.
@@ -463,6 +550,8 @@ func (m *OS) SwitchToSession(targetSession string) error {
 	m.NextBSPWindowID = 1
 	m.Animations = nil
 	m.MultifocusSet = nil
+	m.SyncScroll = false
+	m.ScrollLinks = nil
 	// Default to workspace 1, not 0: a brand-new target session has no windows,
 	// so RestoreFromState (which repairs the workspace) never runs, and any
 	// window then created would land on workspace 0, which SwitchToWorkspace
@@ -525,6 +614,7 @@ func (m *OS) SwitchToSession(targetSession string) error {
 // TUIClient.Close is idempotent, so calling Cleanup more than once is safe.
 // State should be synced to the daemon before Cleanup, on the UI goroutine.
 func (m *OS) Cleanup() {
+	m.removeAllScrollbackFiles()
 	if m.DaemonClient != nil {
 		_ = m.DaemonClient.Close()
 	}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/ui"
 )
 
 // Snap snaps the window at index i to the specified position.
@@ -31,6 +32,74 @@ func (m *OS) Snap(i int, quarter SnapQuarter) *OS {
 
 	return m
 }
+
+// ToggleMaximize is the title-bar maximize button, following
+// config.MaximizeButtonAction. A window the button filled the screen with goes
+// back to the geometry it had before; one that has since been moved or resized
+// counts as not maximized, so the next click maximizes it again.
+func (m *OS) ToggleMaximize(i int) {
+	if i < 0 || i >= len(m.Windows) {
+		return
+	}
+	win := m.Windows[i]
+
+	if config.MaximizeButtonAction == config.MaximizeZoom {
+		m.FocusWindow(i)
+		m.ToggleZoom()
+		return
+	}
+
+	x, y, w, h := m.calculateSnapBounds(SnapFullScreen)
+	if win.Maximized && win.X == x && win.Y == y && win.Width == w && win.Height == h {
+		m.restoreMaximized(i)
+		return
+	}
+
+	win.Maximized = true
+	win.PreMaximizeX = win.X
+	win.PreMaximizeY = win.Y
+	win.PreMaximizeWidth = win.Width
+	win.PreMaximizeHeight = win.Height
+	win.MaximizeMinimized = nil
+
+	if config.MaximizeButtonAction == config.MaximizeWorkspaceFullscreen {
+		for j, other := range m.Windows {
+			if j == i || other.Workspace != win.Workspace || other.Minimized || other.Minimizing || other.Closing {
+				continue
+			}
+			win.MaximizeMinimized = append(win.MaximizeMinimized, other.ID)
+			m.MinimizeWindow(j)
+		}
+	}
+
+	m.FocusWindow(i)
+	m.Snap(i, SnapFullScreen)
+}
+
+// restoreMaximized returns a maximized window to its pre-maximize geometry and
+// brings back any windows workspace-fullscreen minimized for it.
+func (m *OS) restoreMaximized(i int) {
+	win := m.Windows[i]
+	win.Maximized = false
+
+	anim := ui.NewSnapAnimation(win, win.PreMaximizeX, win.PreMaximizeY,
+		win.PreMaximizeWidth, win.PreMaximizeHeight, config.GetAnimationDuration())
+	if anim != nil {
+		m.Animations = append(m.Animations, anim)
+	}
+
+	for _, id := range win.MaximizeMinimized {
+		for j, other := range m.Windows {
+			if other.ID == id && other.Minimized && other.Workspace == win.Workspace {
+				m.RestoreWindow(j)
+				break
+			}
+		}
+	}
+	win.MaximizeMinimized = nil
+	m.FocusWindow(i)
+}
+
 func (m *OS) calculateSnapBounds(quarter SnapQuarter) (x, y, width, height int) {
 	usableHeight := m.GetUsableHeight()
 	renderWidth := m.GetRenderWidth()
@@ -74,7 +143,7 @@ func (m *OS) ScaleWindowsToTerminal(oldWidth, oldHeight, newWidth, newHeight int
 	}
 
 	oldUsableHeight := oldHeight - m.GetTopMargin()
-	if config.DockbarPosition != "hidden" {
+	if m.DockPosition() != "hidden" {
 		oldUsableHeight -= 1
 	}
 
@@ -210,10 +279,10 @@ func (m *OS) ClampWindowsToView() {
 }
 
 // GetTopMargin returns the margin at the top (reserved space for the dockbar
-// when positioned at "top").
+// and config.DockMargin when positioned at "top").
 func (m *OS) GetTopMargin() int {
-	if config.DockbarPosition == "top" {
-		return config.DockHeight
+	if m.DockPosition() == "top" {
+		return config.DockHeight + config.DockMargin
 	}
 
 	return 0
@@ -237,15 +306,67 @@ func (m *OS) GetTimeYPosition() int {
 	return 0
 }
 
-// GetUsableHeight returns the usable height excluding the dock. Auto-hide
-// mode keeps the reservation so tiled windows have a stable layout  - the dock
-// only hides when a specific window (zoom/float) explicitly expands into its
-// rows.
+// DockPosition returns where the dock is drawn right now: the configured
+// config.DockbarPosition, or "hidden" while auto-hide has tucked it away.
+// Layout and hit-testing use this so the reclaimed rows go to windows.
+func (m *OS) DockPosition() string {
+	if m.DockTucked {
+		return "hidden"
+	}
+	return config.DockbarPosition
+}
+
+// syncDockAutoHide tucks the dock away or brings it back to match
+// config.DockAutoHide, the minimized windows in the workspace and the mouse
+// reveal, retiling when that changes the usable height.
+func (m *OS) syncDockAutoHide() {
+	tucked := config.DockAutoHide && config.DockbarPosition != "hidden" && !m.DockRevealed
+	if tucked {
+		for _, w := range m.Windows {
+			if w.Workspace == m.CurrentWorkspace && (w.Minimized || w.Minimizing) {
+				tucked = false
+				break
+			}
+		}
+	}
+	if tucked == m.DockTucked {
+		return
+	}
+	m.DockTucked = tucked
+	m.applyAppearanceLive(true)
+}
+
+// UpdateDockReveal reveals an auto-hidden dock when the mouse touches the
+// screen edge it lives on, and tucks it away again once the mouse leaves the
+// rows the dock occupies.
+func (m *OS) UpdateDockReveal(y int) {
+	if !config.DockAutoHide {
+		return
+	}
+	height := m.GetRenderHeight()
+	var atEdge, inDock bool
+	switch config.DockbarPosition {
+	case "top":
+		atEdge, inDock = y <= 0, y < config.DockHeight
+	case "bottom":
+		atEdge, inDock = y >= height-1, y >= height-config.DockHeight
+	}
+	if atEdge {
+		m.DockRevealed = true
+	} else if !inDock {
+		m.DockRevealed = false
+	}
+	m.syncDockAutoHide()
+}
+
+// GetUsableHeight returns the usable height excluding the dock and
+// config.DockMargin, or the full height while the dock is hidden or tucked
+// away by auto-hide.
 func (m *OS) GetUsableHeight() int {
-	if config.DockbarPosition == "hidden" {
+	if m.DockPosition() == "hidden" {
 		return m.GetRenderHeight()
 	}
-	return m.GetRenderHeight() - config.DockHeight
+	return m.GetRenderHeight() - config.DockHeight - config.DockMargin
 }
 
 // GetRenderWidth returns the width to use for rendering.
@@ -236,6 +236,7 @@ func (m *OS) EnableMasterStackLayout() {
 // DisableAllTiling disables all tiling modes and resets window state.
 func (m *OS) DisableAllTiling() {
 	m.AutoTiling = false
+	m.WorkspaceMonocle = nil
 	m.UseScrollingLayout = false
 	m.resetTiledFlags()
 	m.ShowNotification("Tiling disabled", "info", config.NotificationDuration)
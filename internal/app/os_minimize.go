@@ -159,14 +159,7 @@ func (m *OS) ToggleZoom() {
 		fw.Zoomed = true
 
 		// Calculate zoom dimensions, respecting the dockbar's reserved space.
-		topMargin := 0
-		if config.DockbarPosition == "top" {
-			topMargin = config.DockHeight
-		}
-		bottomMargin := 0
-		if config.DockbarPosition == "bottom" {
-			bottomMargin = config.DockHeight
-		}
+		topMargin := m.GetTopMargin()
 		screenWidth := m.GetRenderWidth()
 		zoomWidth := screenWidth
 		// If ZoomMaxWidth is set, cap width and center horizontally
@@ -176,7 +169,7 @@ func (m *OS) ToggleZoom() {
 		fw.X = (screenWidth - zoomWidth) / 2
 		fw.Y = topMargin
 		fw.Width = zoomWidth
-		fw.Height = m.GetRenderHeight() - topMargin - bottomMargin
+		fw.Height = m.GetUsableHeight()
 		fw.InvalidateCache()
 		// Resize terminal to match zoomed dimensions
 		termW := fw.ContentWidth()
@@ -218,7 +211,7 @@ func (m *OS) FocusNextVisibleWindow() {
 
 	// First pass: find any visible window in current workspace
 	for i := range len(m.Windows) {
-		if m.Windows[i].Workspace == m.CurrentWorkspace && !m.Windows[i].Minimized && !m.Windows[i].Minimizing {
+		if m.Windows[i].Workspace == m.CurrentWorkspace && !m.Windows[i].Minimized && !m.Windows[i].Minimizing && !m.Windows[i].Closing {
 			m.FocusWindow(i)
 			return
 		}
@@ -37,7 +37,7 @@ func (m *OS) Log(level, format string, args ...any) {
 		wasAtBottom = m.LogScrollOffset >= maxScroll-2
 	}
 
-	// Keep only last MaxLogMessages messages
+	// Keep only the last config.MaxLogMessages messages
 	m.LogMessages = append(m.LogMessages, logMsg)
 	if len(m.LogMessages) > config.MaxLogMessages {
 		m.LogMessages = m.LogMessages[len(m.LogMessages)-config.MaxLogMessages:]
@@ -121,6 +121,7 @@ func (m *OS) ShowNotification(message, notifType string, duration time.Duration)
 	}
 
 	m.Notifications = append(m.Notifications, notif)
+	m.trimNotifications()
 
 	// Also log the notification
 	switch notifType {
@@ -145,4 +146,36 @@ func (m *OS) CleanupNotifications() {
 	}
 
 	m.Notifications = active
+	m.trimNotifications()
+}
+
+// trimNotifications drops the oldest notifications past config.MaxNotifications.
+func (m *OS) trimNotifications() {
+	if extra := len(m.Notifications) - max(config.MaxNotifications, 1); extra > 0 {
+		m.Notifications = m.Notifications[extra:]
+	}
+}
+
+// notificationPosition places the i-th of the stacked notifications, width
+// cells wide, by config.NotificationPosition. Each takes spacing rows.
+func (m *OS) notificationPosition(width, i, spacing int) (x, y int) {
+	renderWidth := m.GetRenderWidth()
+	switch config.NotificationPosition {
+	case config.NotificationTopLeft, config.NotificationBottomLeft:
+		x = 2
+	case config.NotificationTopCenter, config.NotificationBottomCenter:
+		x = (renderWidth - width) / 2
+	default:
+		x = renderWidth - width - 2
+	}
+
+	switch config.NotificationPosition {
+	case config.NotificationBottomRight, config.NotificationBottomLeft, config.NotificationBottomCenter:
+		// Stack up from just above the dock, the newest lowest.
+		bottom := m.GetTopMargin() + m.GetUsableHeight()
+		y = bottom - (len(m.Notifications)-i)*spacing + 1
+	default:
+		y = 1 + i*spacing
+	}
+	return max(x, 0), max(y, 0)
 }
@@ -1,5 +1,12 @@
 package app
 
+import (
+	"fmt"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
 // MarkAllDirty marks all windows as dirty for re-rendering. It goes through
 // MarkContentDirty so ContentDirty always implies the cached content string is
 // dropped; otherwise renderTerminal's unfocused early return would hand back
@@ -15,6 +22,19 @@ func (m *OS) MarkAllDirty() {
 	m.cachedViewContent = "" // Invalidate view cache
 }
 
+// updateThrottleIndicators redraws a window whose throttled indicator
+// (config.MaxPtyBytesPerSec) has come on or lapsed since it was last drawn. The
+// PTY reader only records when the throttle last bit; the title is drawn from
+// ThrottleShown so it changes on the UI goroutine, here.
+func (m *OS) updateThrottleIndicators() {
+	for _, w := range m.Windows {
+		if throttled := w.IsThrottled(); throttled != w.ThrottleShown {
+			w.ThrottleShown = throttled
+			w.InvalidateCache()
+		}
+	}
+}
+
 // MarkTerminalsWithNewContent marks terminals that have new content as dirty.
 func (m *OS) MarkTerminalsWithNewContent() bool {
 	// Fast path: no windows
@@ -35,6 +55,7 @@ func (m *OS) MarkTerminalsWithNewContent() bool {
 	hasChanges := false
 	activeTerminals := 0
 	focusedWindowIndex := m.FocusedWindow
+	occluder := m.occludingWindow()
 
 	for i := range m.Windows {
 		window := m.Windows[i]
@@ -65,6 +86,13 @@ func (m *OS) MarkTerminalsWithNewContent() bool {
 			continue
 		}
 
+		// A window hidden behind a fullscreen app cannot be seen, so leave its
+		// output pending until it can; HasNewOutput stays set, and the window
+		// is redrawn on the first tick after the app exits or loses focus.
+		if occluder != nil && window != occluder && isOccludedBy(window, occluder) {
+			continue
+		}
+
 		// Only mark dirty when the terminal actually received new output.
 		// This avoids the old unconditional dirty-marking that defeated frame skipping.
 		newOutput := window.HasNewOutput.Swap(false)
@@ -73,7 +101,7 @@ func (m *OS) MarkTerminalsWithNewContent() bool {
 		}
 
 		// Mark window as dirty. Focused windows always update immediately.
-		// Background windows update every 3rd cycle to reduce CPU, but
+		// Background windows update every Nth cycle to reduce CPU, but
 		// keep HasNewOutput set so they update when focused.
 		isFocused := i == focusedWindowIndex
 		if isFocused {
@@ -81,7 +109,7 @@ func (m *OS) MarkTerminalsWithNewContent() bool {
 			hasChanges = true
 		} else {
 			window.UpdateCounter++
-			if window.UpdateCounter%3 == 0 {
+			if window.UpdateCounter%backgroundUpdateDivisor(window) == 0 {
 				window.MarkContentDirty()
 				hasChanges = true
 			} else {
@@ -96,6 +124,86 @@ func (m *OS) MarkTerminalsWithNewContent() bool {
 	return hasChanges
 }
 
+// backgroundUpdateDivisor is how many render cycles apart an unfocused window
+// with new output is redrawn: its own UpdateDivisor when set, otherwise
+// config.BackgroundUpdateDivisor.
+func backgroundUpdateDivisor(w *terminal.Window) int {
+	if w.UpdateDivisor > 0 {
+		return w.UpdateDivisor
+	}
+	return max(config.BackgroundUpdateDivisor, 1)
+}
+
+// describeUpdateDivisor renders a divisor as the rate it gives at the normal
+// frame rate, e.g. "every 3rd frame (~20 Hz)".
+func describeUpdateDivisor(divisor int) string {
+	hz := float64(config.NormalFPS) / float64(divisor)
+	rate := fmt.Sprintf("~%.0f Hz", hz)
+	if hz < 1 {
+		rate = fmt.Sprintf("~%.1f Hz", hz)
+	}
+	if divisor == 1 {
+		return "every frame (" + rate + ")"
+	}
+	return fmt.Sprintf("every %d frames (%s)", divisor, rate)
+}
+
+// CycleFocusedUpdateRate steps the focused window's background redraw rate
+// through the global default, every frame and about once a second, and
+// returns a description of the new rate.
+func (m *OS) CycleFocusedUpdateRate() (string, bool) {
+	w := m.GetFocusedWindow()
+	if w == nil {
+		return "", false
+	}
+	oneHz := min(max(config.NormalFPS, 1), config.MaxBackgroundUpdateDivisor)
+	switch w.UpdateDivisor {
+	case 0:
+		w.UpdateDivisor = 1
+	case 1:
+		w.UpdateDivisor = oneHz
+	default:
+		w.UpdateDivisor = 0
+	}
+	w.UpdateCounter = 0
+	if w.UpdateDivisor == 0 {
+		return "default, " + describeUpdateDivisor(backgroundUpdateDivisor(w)), true
+	}
+	return describeUpdateDivisor(w.UpdateDivisor), true
+}
+
+// occludingWindow returns the focused window when it is running a fullscreen
+// alternate-screen app (an editor, a pager, btop) and is zoomed or covers the
+// whole viewport, so that windows behind it cannot be seen. It returns nil
+// otherwise.
+func (m *OS) occludingWindow() *terminal.Window {
+	fw := m.GetFocusedWindow()
+	if fw == nil || fw.Minimized || fw.Workspace != m.CurrentWorkspace || !fw.IsAltScreen() {
+		return nil
+	}
+	if fw.Zoomed {
+		return fw
+	}
+	top := m.GetTopMargin()
+	if fw.X <= 0 && fw.Y <= top && fw.X+fw.Width >= m.GetRenderWidth() && fw.Y+fw.Height >= top+m.GetUsableHeight() {
+		return fw
+	}
+	return nil
+}
+
+// isOccludedBy reports whether w is entirely hidden behind occluder: every
+// other window is while occluder is zoomed, and otherwise w must sit below it
+// and inside its bounds.
+func isOccludedBy(w, occluder *terminal.Window) bool {
+	if occluder.Zoomed {
+		return true
+	}
+	return w.Z < occluder.Z &&
+		w.X >= occluder.X && w.Y >= occluder.Y &&
+		w.X+w.Width <= occluder.X+occluder.Width &&
+		w.Y+w.Height <= occluder.Y+occluder.Height
+}
+
 // FlushPTYBuffersAfterResize flushes buffered PTY content and forces content polling
 // after a resize operation completes. This ensures that shell prompt redraws in response
 // to SIGWINCH are properly processed and displayed.
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// TestOccludedWindowsWaitForFullscreenApp checks that a window hidden behind a
+// zoomed alt-screen app keeps its output pending instead of being redrawn, and
+// is redrawn once the app leaves the alternate screen.
+func TestOccludedWindowsWaitForFullscreenApp(t *testing.T) {
+	front := newTestWindow(t, "occluder-0001", 80, 24)
+	back := newTestWindow(t, "occluded-0001", 40, 10)
+	m := newTestOS(front)
+	m.Windows = append(m.Windows, back)
+	front.Workspace, back.Workspace = m.CurrentWorkspace, m.CurrentWorkspace
+	front.Zoomed = true
+	front.SetAltScreen(true)
+
+	back.ContentDirty = false
+	back.UpdateCounter = 2 // next background update would mark it dirty
+	back.HasNewOutput.Store(true)
+	m.MarkTerminalsWithNewContent()
+	if back.ContentDirty {
+		t.Error("window behind a zoomed fullscreen app was redrawn")
+	}
+	if !back.HasNewOutput.Load() {
+		t.Error("occluded window's pending output was dropped")
+	}
+
+	front.SetAltScreen(false)
+	m.MarkTerminalsWithNewContent()
+	if !back.ContentDirty {
+		t.Error("window not redrawn once the fullscreen app exited")
+	}
+}
+
+func TestIsOccludedBy(t *testing.T) {
+	front := newTestWindow(t, "occluder-0002", 80, 24)
+	inside := newTestWindow(t, "inside-00001", 20, 5)
+	inside.X, inside.Y = 10, 5
+	front.Z, inside.Z = 2, 1
+	if !isOccludedBy(inside, front) {
+		t.Error("window below and inside the occluder should be occluded")
+	}
+	inside.Z = 3
+	if isOccludedBy(inside, front) {
+		t.Error("window above the occluder is visible")
+	}
+	inside.Z, inside.X = 1, 70
+	if isOccludedBy(inside, front) {
+		t.Error("window sticking out past the occluder is visible")
+	}
+}
+
+// TestBackgroundUpdateDivisor checks that unfocused windows are redrawn every
+// config.BackgroundUpdateDivisor cycles unless their own UpdateDivisor is set.
+func TestBackgroundUpdateDivisor(t *testing.T) {
+	original := config.BackgroundUpdateDivisor
+	defer func() { config.BackgroundUpdateDivisor = original }()
+
+	front := newTestWindow(t, "focused-00001", 40, 10)
+	back := newTestWindow(t, "backgrnd-0001", 40, 10)
+	m := newTestOS(front)
+	m.Windows = append(m.Windows, back)
+	back.Workspace = m.CurrentWorkspace
+
+	redraws := func(cycles int) int {
+		n := 0
+		back.UpdateCounter = 0
+		for range cycles {
+			back.ContentDirty = false
+			back.HasNewOutput.Store(true)
+			m.MarkTerminalsWithNewContent()
+			if back.ContentDirty {
+				n++
+			}
+		}
+		return n
+	}
+
+	config.BackgroundUpdateDivisor = 5
+	if got := redraws(10); got != 2 {
+		t.Errorf("global divisor 5: %d redraws in 10 cycles, want 2", got)
+	}
+	back.UpdateDivisor = 1
+	if got := redraws(10); got != 10 {
+		t.Errorf("window divisor 1: %d redraws in 10 cycles, want 10", got)
+	}
+
+	if rate, _ := m.CycleFocusedUpdateRate(); front.UpdateDivisor != 1 || rate != "every frame (~60 Hz)" {
+		t.Errorf("first cycle: divisor %d, rate %q", front.UpdateDivisor, rate)
+	}
+	m.CycleFocusedUpdateRate()
+	if front.UpdateDivisor != config.NormalFPS {
+		t.Errorf("second cycle: divisor %d, want %d", front.UpdateDivisor, config.NormalFPS)
+	}
+	m.CycleFocusedUpdateRate()
+	if front.UpdateDivisor != 0 {
+		t.Errorf("third cycle should return to the default, got %d", front.UpdateDivisor)
+	}
+}
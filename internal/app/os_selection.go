@@ -8,6 +8,7 @@ import (
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+	uv "github.com/charmbracelet/ultraviolet"
 )
 
 // EditScrollbackInEditor captures the focused pane's scrollback to a temp file
@@ -93,6 +94,8 @@ func (m *OS) MoveSelectionCursor(window *terminal.Window, dx, dy int, extending
 				if window.ScrollbackOffset > scrollbackLen {
 					window.ScrollbackOffset = scrollbackLen
 				}
+				// The content moved down a row; keep the anchor on it.
+				window.SelectionStart.Y++
 				window.InvalidateCache()
 			}
 		}
@@ -104,6 +107,8 @@ func (m *OS) MoveSelectionCursor(window *terminal.Window, dx, dy int, extending
 			if window.ScrollbackOffset < 0 {
 				window.ScrollbackOffset = 0
 			}
+			// The content moved up a row; keep the anchor on it.
+			window.SelectionStart.Y--
 			window.InvalidateCache()
 		}
 		newY = maxY - 1 // Keep cursor at bottom
@@ -131,8 +136,7 @@ func (m *OS) MoveSelectionCursor(window *terminal.Window, dx, dy int, extending
 		window.SelectionEnd = window.SelectionCursor
 
 		// Extract selected text
-		selectedText := m.extractSelectedText(window)
-		window.SelectedText = selectedText
+		window.SelectedText = m.ExtractSelectedText(window)
 
 	} else {
 		// Just moving cursor - start new selection
@@ -151,54 +155,71 @@ func (m *OS) MoveSelectionCursor(window *terminal.Window, dx, dy int, extending
 	window.InvalidateCache()
 }
 
-// extractSelectedText extracts text from the terminal within the selected region.
-func (m *OS) extractSelectedText(window *terminal.Window) string {
+// ExtractSelectedText returns the text between SelectionStart and
+// SelectionEnd. Both are viewport rows, read through the window's current
+// scrollback offset, so either end may lie above or below the viewport once
+// the selection has been extended by scrolling; those rows come from the
+// scrollback buffer or the live screen like any other. Mouse and keyboard
+// selection both read through here.
+func (m *OS) ExtractSelectedText(window *terminal.Window) string {
 	if window.Terminal == nil {
 		return ""
 	}
+	screen := window.Terminal
 
-	// Ensure selection coordinates are valid
-	startX := window.SelectionStart.X
-	startY := window.SelectionStart.Y
-	endX := window.SelectionEnd.X
-	endY := window.SelectionEnd.Y
+	startX, startY := window.SelectionStart.X, window.SelectionStart.Y
+	endX, endY := window.SelectionEnd.X, window.SelectionEnd.Y
 
-	// Normalize selection (ensure start comes before end)
+	// Normalize selection (ensure start is before end)
 	if startY > endY || (startY == endY && startX > endX) {
 		startX, endX = endX, startX
 		startY, endY = endY, startY
 	}
 
-	var selectedLines []string
-
-	// Extract text line by line
-	for y := startY; y <= endY; y++ {
-		var lineBuilder strings.Builder
+	screenHeight := screen.Height()
+	screenWidth := screen.Width()
+	scrollbackLen := window.ScrollbackLen()
 
-		// Determine start and end columns for this line
-		lineStartX := 0
-		lineEndX := window.ContentWidth() // Account for borders
+	// cellAt resolves a viewport row to the scrollback line or screen row
+	// currently shown there (or that would be, for rows outside the viewport).
+	cellAt := func(x, y int) *uv.Cell {
+		line := scrollbackLen - window.ScrollbackOffset + y
+		switch {
+		case line < 0:
+			return nil
+		case line < scrollbackLen:
+			scrollbackLine := window.ScrollbackLine(line)
+			if x < len(scrollbackLine) {
+				return &scrollbackLine[x]
+			}
+			return nil
+		case line-scrollbackLen < screenHeight:
+			return screen.CellAt(x, line-scrollbackLen)
+		}
+		return nil
+	}
 
+	var selectedText strings.Builder
+	for y := startY; y <= endY; y++ {
+		lineStartX, lineEndX := 0, screenWidth-1
 		if y == startY {
 			lineStartX = startX
 		}
 		if y == endY {
-			lineEndX = endX
+			lineEndX = min(endX, screenWidth-1)
 		}
-
-		// Extract characters from the terminal for this line
-		for x := lineStartX; x <= lineEndX && x < window.ContentWidth(); x++ {
-			// Get the cell from the terminal at this position
-			cell := window.Terminal.CellAt(x, y)
+		for x := lineStartX; x <= lineEndX; x++ {
+			cell := cellAt(x, y)
 			if cell != nil && cell.Content != "" {
-				lineBuilder.WriteString(string(cell.Content))
+				selectedText.WriteString(cell.Content)
 			} else {
-				lineBuilder.WriteByte(' ')
+				selectedText.WriteRune(' ')
 			}
 		}
-
-		selectedLines = append(selectedLines, lineBuilder.String())
+		if y < endY {
+			selectedText.WriteRune('\n')
+		}
 	}
 
-	return strings.Join(selectedLines, "\n")
+	return strings.TrimSpace(selectedText.String())
 }
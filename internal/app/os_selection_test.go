@@ -0,0 +1,47 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestKeyboardSelectionExtendsIntoScrollback checks that extending a keyboard
+// selection past the top of the window scrolls back, keeps the anchor on the
+// line it started on, and copies the lines that came from scrollback.
+func TestKeyboardSelectionExtendsIntoScrollback(t *testing.T) {
+	win := newTestWindow(t, "select-sb-0001", 40, 8)
+	m := newTestOS(win)
+
+	var out strings.Builder
+	for i := range 20 {
+		if i > 0 {
+			out.WriteString("\r\n")
+		}
+		fmt.Fprintf(&out, "line-%02d", i)
+	}
+	win.LockIO()
+	_, _ = win.Terminal.Write([]byte(out.String()))
+	win.UnlockIO()
+
+	m.MoveSelectionCursor(win, 0, 0, false)
+	anchor := win.SelectionStart.Y
+	for range 10 {
+		m.MoveSelectionCursor(win, 0, -1, true)
+	}
+
+	if win.ScrollbackOffset == 0 {
+		t.Fatal("extending above the top of the window did not scroll back")
+	}
+	if got, want := win.SelectionStart.Y, anchor+win.ScrollbackOffset; got != want {
+		t.Errorf("anchor row = %d, want %d (moved down with the scrolled content)", got, want)
+	}
+	for _, want := range []string{"line-10", "line-11", "line-19"} {
+		if !strings.Contains(win.SelectedText, want) {
+			t.Errorf("selection %q is missing %s", win.SelectedText, want)
+		}
+	}
+	if strings.Contains(win.SelectedText, "line-08") {
+		t.Errorf("selection %q reaches past where it was extended to", win.SelectedText)
+	}
+}
@@ -16,14 +16,8 @@ import (
 	"github.com/Gaurav-Gosain/tuios/internal/theme"
 )
 
-// scriptDoneLinger is how long the "DONE" completion indicator stays on screen
-// after a tape script finishes. Once it elapses, script mode is left entirely
-// (see maybeExitFinishedScript). It matches the auto-hide window used by the
-// script indicator renderer.
-const scriptDoneLinger = 2 * time.Second
-
 // maybeExitFinishedScript leaves script mode once a finished tape's completion
-// indicator has been shown for scriptDoneLinger. This is what re-arms Ctrl+P:
+// indicator has been shown for config.TapeFinishHide. This is what re-arms Ctrl+P:
 // while ScriptMode is set, Ctrl+P is intercepted for script pause/resume
 // (internal/input/handler.go) and never reaches the command palette. Neither the
 // local playback finish path nor the remote-exec done path cleared ScriptMode,
@@ -32,13 +26,48 @@ const scriptDoneLinger = 2 * time.Second
 // covers both the local (ScriptPlayer) and remote (RemoteScript*) paths because
 // both stamp ScriptFinishedTime on completion.
 //
-// It returns true when it actually left script mode, so the caller can force a
-// render to clear the indicator.
+// With config.TapeLoop a locally played tape starts another run instead, and with
+// config.TapeFinishHold script mode is kept until DismissFinishedScript.
+//
+// It returns true when it actually left script mode or rewound, so the caller
+// can force a render to clear the indicator.
 func (m *OS) maybeExitFinishedScript() bool {
 	if !m.ScriptMode || m.ScriptFinishedTime.IsZero() {
 		return false
 	}
-	if time.Since(m.ScriptFinishedTime) < scriptDoneLinger {
+	if time.Since(m.ScriptFinishedTime) < config.TapeFinishHide {
+		return false
+	}
+	if player, ok := m.loopingTapePlayer(); ok {
+		player.NextRun()
+		m.ScriptFinishedTime = time.Time{}
+		m.ScriptSleepUntil = time.Time{}
+		m.ScriptWaitRegex = nil
+		m.ScriptWaitText = ""
+		return true
+	}
+	if config.TapeFinishHold {
+		return false
+	}
+	m.exitScriptMode()
+	return true
+}
+
+// holdingFinishedScript reports whether a finished tape is being kept on
+// screen by config.TapeFinishHold, waiting for a key.
+func (m *OS) holdingFinishedScript() bool {
+	if !m.ScriptMode || m.ScriptFinishedTime.IsZero() || !config.TapeFinishHold {
+		return false
+	}
+	_, looping := m.loopingTapePlayer()
+	return !looping
+}
+
+// DismissFinishedScript leaves script mode for a finished tape held on screen
+// by config.TapeFinishHold. It returns true when it did, so the caller can
+// swallow the key that dismissed it.
+func (m *OS) DismissFinishedScript() bool {
+	if !m.holdingFinishedScript() {
 		return false
 	}
 	m.exitScriptMode()
@@ -57,8 +86,11 @@ func (m *OS) exitScriptMode() {
 	m.ScriptFinishedTime = time.Time{}
 	m.ScriptWaitRegex = nil
 	m.ScriptWaitDeadline = time.Time{}
+	m.ScriptWaitText = ""
+	m.ScriptWaitWindow = ""
 	m.RemoteScriptIndex = 0
 	m.RemoteScriptTotal = 0
+	m.tapeRunStart = nil
 }
 
 // The following methods implement the tape.Executor interface for
@@ -513,6 +545,7 @@ func (m *OS) RenameWindowByID(windowID, name string) error {
 	for _, w := range m.Windows {
 		if w.ID == windowID {
 			w.CustomName = name
+			w.AutoNamed = false
 			m.MarkAllDirty()
 			return nil
 		}
@@ -1285,6 +1318,62 @@ func (m *OS) checkScriptWaitRegex() bool {
 	return false
 }
 
+// startScriptWaitFor arms a WaitFor condition for tape playback: Args[0] is the
+// window reference, Args[1] the text and Args[2] (optional) the timeout,
+// defaulting to 5s. The window is resolved on each check rather than here, so
+// a tape can wait on a window an earlier command is still bringing up.
+func (m *OS) startScriptWaitFor(cmd *tape.Command) {
+	if len(cmd.Args) < 2 || cmd.Args[1] == "" {
+		m.ShowNotification("WaitFor: missing window or text", "error", config.NotificationDuration)
+		return
+	}
+	timeout := 5 * time.Second
+	if len(cmd.Args) > 2 {
+		if d, err := tape.ParseDuration(cmd.Args[2]); err == nil && d > 0 {
+			timeout = d
+		}
+	}
+	m.ScriptWaitWindow = cmd.Args[0]
+	m.ScriptWaitText = cmd.Args[1]
+	m.ScriptWaitDeadline = time.Now().Add(timeout)
+}
+
+// checkScriptWaitFor reports whether a pending WaitFor condition is satisfied,
+// so playback may resume. Unlike WaitUntilRegex, a timeout is a failure: the
+// steps after a WaitFor assume what it waited for, so playback stops with an
+// error rather than running them against the wrong screen.
+func (m *OS) checkScriptWaitFor() bool {
+	if m.ScriptWaitText == "" {
+		return true
+	}
+
+	if id, err := m.resolveWindowTarget(m.ScriptWaitWindow); err == nil {
+		for _, win := range m.Windows {
+			if win.ID != id || win.Terminal == nil {
+				continue
+			}
+			win.RLockIO()
+			content := win.Terminal.String()
+			win.RUnlockIO()
+			if strings.Contains(content, m.ScriptWaitText) {
+				m.ScriptWaitText = ""
+				m.ScriptWaitWindow = ""
+				m.ScriptWaitDeadline = time.Time{}
+				return true
+			}
+		}
+	}
+
+	if !m.ScriptWaitDeadline.IsZero() && time.Now().After(m.ScriptWaitDeadline) {
+		m.ShowNotification(fmt.Sprintf("WaitFor: timed out waiting for %q in %s, playback stopped",
+			m.ScriptWaitText, m.ScriptWaitWindow), "error", config.NotificationDuration)
+		m.exitScriptMode()
+		return false
+	}
+
+	return false
+}
+
 // capturePane captures the content of a pane.
 // flags is a comma-separated string of options: "scrollback", "ansi".
 func (m *OS) capturePane(windowTarget, flags string) (string, error) {
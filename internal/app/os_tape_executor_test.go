@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/session"
 	"github.com/Gaurav-Gosain/tuios/internal/tape"
 	"github.com/Gaurav-Gosain/tuios/internal/terminal"
@@ -87,6 +88,40 @@ func TestCheckScriptWaitRegexBlocksThenTimesOut(t *testing.T) {
 	}
 }
 
+// TestCheckScriptWaitForTargetsWindow checks that WaitFor looks at the named
+// window rather than the focused one, and that a timeout stops playback.
+func TestCheckScriptWaitForTargetsWindow(t *testing.T) {
+	m := focusedOS(t, "focused prompt\n")
+	em := vt.NewEmulator(80, 24)
+	_, _ = em.Write([]byte("server listening on :8080\n"))
+	m.Windows = append(m.Windows, &terminal.Window{ID: "server-window-01", CustomName: "server", Terminal: em, Workspace: 1})
+	m.ScriptMode = true
+
+	m.startScriptWaitFor(&tape.Command{Type: tape.CommandTypeWaitFor, Args: []string{"server", "listening", "2s"}})
+	if got := time.Until(m.ScriptWaitDeadline); got < time.Second || got > 3*time.Second {
+		t.Errorf("timeout deadline = %v, want ~2s", got)
+	}
+	if !m.checkScriptWaitFor() {
+		t.Error("expected the server window's text to resume playback")
+	}
+	if m.ScriptWaitText != "" {
+		t.Error("expected wait state cleared after match")
+	}
+
+	// The focused window shows "focused prompt", but WaitFor asked about server.
+	m.startScriptWaitFor(&tape.Command{Type: tape.CommandTypeWaitFor, Args: []string{"server", "focused prompt"}})
+	if m.checkScriptWaitFor() {
+		t.Error("expected to keep waiting: the text is only in another window")
+	}
+	m.ScriptWaitDeadline = time.Now().Add(-time.Millisecond)
+	if m.checkScriptWaitFor() {
+		t.Error("expected a timeout not to resume playback")
+	}
+	if m.ScriptMode || m.ScriptWaitText != "" {
+		t.Errorf("timeout left ScriptMode=%v wait=%q, want playback stopped", m.ScriptMode, m.ScriptWaitText)
+	}
+}
+
 // TestParseKeyToMessage tests the key parsing function
 func TestParseKeyToMessage(t *testing.T) {
 	m := &OS{}
@@ -563,3 +598,117 @@ func TestApplyStateSyncSkipsInvalidWindows(t *testing.T) {
 		t.Errorf("Windows count = %d, want 0", len(m.Windows))
 	}
 }
+
+// finishedScriptOS returns an OS whose tape finished long enough ago for the
+// DONE indicator to have expired under the default TapeFinishHide.
+func finishedScriptOS() (*OS, *tape.Player) {
+	player := tape.NewPlayer([]tape.Command{{Type: tape.CommandTypeSleep}})
+	player.Advance()
+	return &OS{
+		ScriptMode:         true,
+		ScriptPlayer:       player,
+		ScriptFinishedTime: time.Now().Add(-time.Hour),
+	}, player
+}
+
+func TestFinishedScriptHideHoldAndLoop(t *testing.T) {
+	defer func(h bool, l bool) { config.TapeFinishHold, config.TapeLoop = h, l }(config.TapeFinishHold, config.TapeLoop)
+
+	config.TapeFinishHold, config.TapeLoop = false, false
+	m, _ := finishedScriptOS()
+	if !m.maybeExitFinishedScript() || m.ScriptMode {
+		t.Error("default: script mode should be left once TapeFinishHide passes")
+	}
+
+	config.TapeFinishHold = true
+	m, _ = finishedScriptOS()
+	if m.maybeExitFinishedScript() || !m.ScriptMode {
+		t.Fatal("hold: script mode should stay until a key is pressed")
+	}
+	if !m.DismissFinishedScript() || m.ScriptMode {
+		t.Error("hold: DismissFinishedScript should leave script mode")
+	}
+	if m.DismissFinishedScript() {
+		t.Error("DismissFinishedScript should do nothing once dismissed")
+	}
+
+	// Loop wins over hold for a played tape: it rewinds and keeps playing.
+	config.TapeLoop = true
+	m, player := finishedScriptOS()
+	if !m.maybeExitFinishedScript() || !m.ScriptMode {
+		t.Fatal("loop: script mode should stay on")
+	}
+	if player.IsFinished() || player.CurrentIndex() != 0 || !m.ScriptFinishedTime.IsZero() {
+		t.Errorf("loop: player not rewound (finished=%v index=%d)", player.IsFinished(), player.CurrentIndex())
+	}
+	if player.Run() != 2 {
+		t.Errorf("loop: Run() = %d after the rewind, want 2", player.Run())
+	}
+	if m.DismissFinishedScript() {
+		t.Error("loop: a key should not end a looping tape")
+	}
+
+	// A run count from --loop wins over tape_loop: the tape stops after it.
+	config.TapeFinishHold = false
+	m, player = finishedScriptOS()
+	player.SetRuns(3)
+	if !m.maybeExitFinishedScript() || m.ScriptMode {
+		t.Error("loop with --loop 3: script mode should be left once the runs are done")
+	}
+}
+
+// TestTapeRunStartsFromTheSameScreen checks that a further run of a looping
+// tape closes the windows the previous run opened and goes back to the
+// workspace and tiling the tape started with.
+func TestTapeRunStartsFromTheSameScreen(t *testing.T) {
+	defer func(a bool) { config.AnimationsEnabled = a }(config.AnimationsEnabled)
+	config.AnimationsEnabled = false
+
+	existing := newTestWindow(t, "window-a", 40, 12)
+	existing.Workspace = 1
+	m := &OS{
+		Windows:              []*terminal.Window{existing},
+		FocusedWindow:        0,
+		CurrentWorkspace:     1,
+		NumWorkspaces:        9,
+		MasterRatio:          0.5,
+		WorkspaceFocus:       map[int]int{},
+		WorkspaceLayouts:     map[int][]WindowLayout{},
+		WorkspaceHasCustom:   map[int]bool{},
+		WorkspaceMasterRatio: map[int]float64{},
+	}
+	player := tape.NewPlayer([]tape.Command{{Type: tape.CommandTypeNewWindow}})
+	player.SetRuns(2)
+	m.trackTapeRun(player)
+
+	// What the first run leaves behind.
+	opened := newTestWindow(t, "window-b", 40, 12)
+	opened.Workspace = 1
+	m.Windows = append(m.Windows, opened)
+	m.SwitchToWorkspace(2)
+	m.AutoTiling = true
+	m.MasterRatio = 0.7
+
+	player.Advance()
+	if player.Run() != 2 {
+		t.Fatalf("Run() = %d, want 2", player.Run())
+	}
+	m.trackTapeRun(player)
+
+	if len(m.Windows) != 1 || m.Windows[0] != existing {
+		t.Errorf("windows after the new run started = %d, want only the one from before the tape", len(m.Windows))
+	}
+	if m.CurrentWorkspace != 1 {
+		t.Errorf("workspace = %d, want 1", m.CurrentWorkspace)
+	}
+	if m.AutoTiling || m.MasterRatio != 0.5 {
+		t.Errorf("tiling = %v, master ratio = %v; want false, 0.5", m.AutoTiling, m.MasterRatio)
+	}
+
+	// Within a run nothing is touched.
+	m.Windows = append(m.Windows, opened)
+	m.trackTapeRun(player)
+	if len(m.Windows) != 2 {
+		t.Error("a window opened mid-run was closed")
+	}
+}
@@ -1,11 +1,15 @@
 package app
 
 import (
+	"cmp"
 	"fmt"
 	"slices"
+	"syscall"
+	"time"
 
 	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/hooks"
+	"github.com/Gaurav-Gosain/tuios/internal/session"
 	"github.com/Gaurav-Gosain/tuios/internal/terminal"
 	"github.com/Gaurav-Gosain/tuios/internal/ui"
 )
@@ -93,6 +97,48 @@ func (m *OS) ToggleMultifocus(windowIndex int) {
 	}
 }
 
+// MultifocusWorkspace broadcasts to the whole current workspace: every window
+// on it that is not minimized joins the multifocus set. When they are all
+// members already, it removes them instead, so the same key turns workspace
+// broadcast on and off. Windows on other workspaces keep their membership.
+func (m *OS) MultifocusWorkspace() {
+	var members []*terminal.Window
+	allIn := true
+	for _, w := range m.Windows {
+		if w.Workspace != m.CurrentWorkspace || w.Minimized {
+			continue
+		}
+		members = append(members, w)
+		if !m.MultifocusSet[w.ID] {
+			allIn = false
+		}
+	}
+	if len(members) == 0 {
+		return
+	}
+
+	if allIn {
+		for _, w := range members {
+			delete(m.MultifocusSet, w.ID)
+			w.InvalidateCache()
+		}
+		if len(m.MultifocusSet) == 0 {
+			m.MultifocusSet = nil
+		}
+		m.ShowNotification("Multifocus: workspace removed", "info", config.NotificationDuration)
+		return
+	}
+
+	if m.MultifocusSet == nil {
+		m.MultifocusSet = make(map[string]bool)
+	}
+	for _, w := range members {
+		m.MultifocusSet[w.ID] = true
+		w.InvalidateCache()
+	}
+	m.ShowNotification(fmt.Sprintf("Multifocus: %d windows", len(m.MultifocusSet)), "info", config.NotificationDuration)
+}
+
 // ClearMultifocus removes all windows from the multifocus set.
 func (m *OS) ClearMultifocus() {
 	if m.MultifocusSet != nil {
@@ -129,6 +175,8 @@ func (m *OS) GetMultifocusWindows() []int {
 }
 
 // CycleToNextVisibleWindow cycles focus to the next visible window in the current workspace.
+// From the last window it wraps to the first, or, with config.WindowCycleWrap
+// off, stays put and says so.
 func (m *OS) CycleToNextVisibleWindow() {
 	if len(m.Windows) == 0 {
 		return
@@ -136,7 +184,7 @@ func (m *OS) CycleToNextVisibleWindow() {
 	// Find next visible (non-minimized and non-minimizing) window in current workspace
 	visibleWindows := []int{}
 	for i, w := range m.Windows {
-		if w.Workspace == m.CurrentWorkspace && !w.Minimized && !w.Minimizing {
+		if w.Workspace == m.CurrentWorkspace && !w.Minimized && !w.Minimizing && !w.Closing {
 			visibleWindows = append(visibleWindows, i)
 		}
 	}
@@ -156,12 +204,82 @@ func (m *OS) CycleToNextVisibleWindow() {
 	// Cycle to next visible window
 	if currentPos >= 0 && currentPos < len(visibleWindows)-1 {
 		m.FocusWindow(visibleWindows[currentPos+1])
+	} else if currentPos >= 0 && !config.WindowCycleWrap {
+		m.ShowNotification("Last window", "info", config.NotificationDuration)
 	} else {
 		m.FocusWindow(visibleWindows[0])
 	}
 }
 
+// CycleToNextFloatingWindow cycles focus through the floating windows of the
+// current workspace only, skipping tiled ones. It reports false when the
+// workspace has no floating window to move to.
+func (m *OS) CycleToNextFloatingWindow() bool {
+	return m.cycleToNextVisibleWindowWhere(func(w *terminal.Window) bool { return w.IsFloating })
+}
+
+// CycleToNextTiledWindow is CycleToNextFloatingWindow for the tiled windows.
+func (m *OS) CycleToNextTiledWindow() bool {
+	return m.cycleToNextVisibleWindowWhere(func(w *terminal.Window) bool { return !w.IsFloating })
+}
+
+// cycleToNextVisibleWindowWhere is CycleToNextVisibleWindow restricted to the
+// windows match accepts. When the focused window is not one of them, focus
+// goes to the first matching window after it in window order. From the last
+// matching window it wraps to the first, or, with config.WindowCycleWrap off,
+// stays put with the same notice CycleToNextVisibleWindow shows.
+func (m *OS) cycleToNextVisibleWindowWhere(match func(*terminal.Window) bool) bool {
+	candidates := []int{}
+	for i, w := range m.Windows {
+		if w.Workspace == m.CurrentWorkspace && !w.Minimized && !w.Minimizing && !w.Closing && match(w) {
+			candidates = append(candidates, i)
+		}
+	}
+	if len(candidates) == 0 {
+		return false
+	}
+
+	for _, idx := range candidates {
+		if idx > m.FocusedWindow {
+			m.FocusWindow(idx)
+			return true
+		}
+	}
+	if candidates[0] == m.FocusedWindow {
+		return false
+	}
+	if !config.WindowCycleWrap && candidates[len(candidates)-1] == m.FocusedWindow {
+		m.ShowNotification("Last window", "info", config.NotificationDuration)
+		return true
+	}
+	m.FocusWindow(candidates[0])
+	return true
+}
+
+// FocusLastWindow focuses the window that had focus before the current one in
+// this workspace, like vim's Ctrl-^. Pressing it again comes back, so it
+// alternates between the two most recent windows. It reports false when there
+// is no such window left to return to (closed, minimized or moved away).
+func (m *OS) FocusLastWindow() bool {
+	id, ok := m.WorkspaceLastFocus[m.CurrentWorkspace]
+	if !ok {
+		return false
+	}
+	for i, w := range m.Windows {
+		if w.ID != id {
+			continue
+		}
+		if w.Workspace != m.CurrentWorkspace || w.Minimized || w.Minimizing || w.Closing || i == m.FocusedWindow {
+			return false
+		}
+		m.FocusWindow(i)
+		return true
+	}
+	return false
+}
+
 // CycleToPreviousVisibleWindow cycles focus to the previous visible window in the current workspace.
+// It wraps from the first window to the last like CycleToNextVisibleWindow.
 func (m *OS) CycleToPreviousVisibleWindow() {
 	if len(m.Windows) == 0 {
 		return
@@ -169,7 +287,7 @@ func (m *OS) CycleToPreviousVisibleWindow() {
 	// Find previous visible (non-minimized and non-minimizing) window in current workspace
 	visibleWindows := []int{}
 	for i, w := range m.Windows {
-		if w.Workspace == m.CurrentWorkspace && !w.Minimized && !w.Minimizing {
+		if w.Workspace == m.CurrentWorkspace && !w.Minimized && !w.Minimizing && !w.Closing {
 			visibleWindows = append(visibleWindows, i)
 		}
 	}
@@ -189,6 +307,8 @@ func (m *OS) CycleToPreviousVisibleWindow() {
 	// Cycle to previous visible window
 	if currentPos > 0 {
 		m.FocusWindow(visibleWindows[currentPos-1])
+	} else if currentPos == 0 && !config.WindowCycleWrap {
+		m.ShowNotification("First window", "info", config.NotificationDuration)
 	} else {
 		m.FocusWindow(visibleWindows[len(visibleWindows)-1])
 	}
@@ -211,11 +331,27 @@ func (m *OS) FocusWindow(i int) *OS {
 	// ATOMIC: Set focus and Z-index in one operation
 	m.FocusedWindow = i
 
+	// Stamp both windows so the scrollback budget knows which was used last.
+	now := time.Now()
+	m.Windows[i].LastFocusTime = now
+	if oldFocused >= 0 && oldFocused < len(m.Windows) {
+		m.Windows[oldFocused].LastFocusTime = now
+	}
+
 	// Save focus for current workspace
 	if m.Windows[i].Workspace == m.CurrentWorkspace {
 		m.WorkspaceFocus[m.CurrentWorkspace] = i
 	}
 
+	// Remember the window we left so FocusLastWindow can come back to it.
+	// Only a move within one workspace counts.
+	if oldFocused >= 0 && oldFocused < len(m.Windows) && m.Windows[oldFocused].Workspace == m.Windows[i].Workspace {
+		if m.WorkspaceLastFocus == nil {
+			m.WorkspaceLastFocus = make(map[int]string)
+		}
+		m.WorkspaceLastFocus[m.Windows[i].Workspace] = m.Windows[oldFocused].ID
+	}
+
 	// Recalculate Z-ordering (floating always above non-floating)
 	m.RecalcZOrder()
 
@@ -240,13 +376,55 @@ func (m *OS) FocusWindow(i int) *OS {
 	return m
 }
 
+// ClearFocus leaves no window focused, returning to window management mode if
+// terminal mode was on. The workspace keeps its remembered focus, so coming
+// back to it after a switch focuses that window again.
+func (m *OS) ClearFocus() {
+	old := m.FocusedWindow
+	if old < 0 || old >= len(m.Windows) {
+		return
+	}
+	m.FocusedWindow = -1
+	if m.Mode == TerminalMode {
+		m.Mode = WindowManagementMode
+	}
+	m.Windows[old].MarkPositionDirty()
+	m.Windows[old].InvalidateCache()
+}
+
+// StartRename puts the focused window into rename mode. Renaming is a
+// window-management activity, so terminal mode is left first. Nothing happens
+// while window titles are hidden, since there is no title to edit.
+func (m *OS) StartRename() {
+	if config.WindowTitlePosition == "hidden" || len(m.Windows) == 0 || m.FocusedWindow < 0 {
+		return
+	}
+	focused := m.GetFocusedWindow()
+	if focused == nil {
+		return
+	}
+	m.Mode = WindowManagementMode
+	m.RenamingWindow = true
+	m.RenameBuffer = focused.CustomName
+	focused.InvalidateCache()
+}
+
 // RecalcZOrder recalculates Z-index values for all windows, ensuring floating
 // windows are always above non-floating windows. Call after toggling IsFloating.
 func (m *OS) RecalcZOrder() {
 	focused := m.FocusedWindow
+	// Unfocused windows keep their stacking relative to each other, so a
+	// window raised earlier stays above the ones it covered.
+	order := make([]int, len(m.Windows))
+	for j := range order {
+		order[j] = j
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(m.Windows[a].Z, m.Windows[b].Z)
+	})
 	z := 0
 	// Non-floating, non-focused first
-	for j := range m.Windows {
+	for _, j := range order {
 		if j != focused && !m.Windows[j].IsFloating {
 			m.Windows[j].Z = z
 			z++
@@ -258,7 +436,7 @@ func (m *OS) RecalcZOrder() {
 		z++
 	}
 	// Non-focused floating
-	for j := range m.Windows {
+	for _, j := range order {
 		if j != focused && m.Windows[j].IsFloating {
 			m.Windows[j].Z = z
 			z++
@@ -272,8 +450,9 @@ func (m *OS) RecalcZOrder() {
 }
 
 // NewWindowPlacement returns the position and size a freshly created window gets
-// on this client: half the usable screen, at the mouse in floating mode and
-// centered otherwise. Auto-tiling overwrites it on the next retile; floating mode
+// on this client: config.NewWindowWidth by config.NewWindowHeight (half the
+// usable screen by default), at the mouse in floating mode and centered
+// otherwise. Auto-tiling overwrites it on the next retile; floating mode
 // is where it is what the user actually sees.
 //
 // It is a property of the viewport, which is why the daemon cannot compute it and
@@ -288,8 +467,8 @@ func (m *OS) NewWindowPlacement() (x, y, width, height int) {
 		screenHeight = 24
 	}
 
-	width = screenWidth / 2
-	height = screenHeight / 2
+	width = config.ResolveWindowSize(config.NewWindowWidth, screenWidth, config.DefaultWindowWidth)
+	height = config.ResolveWindowSize(config.NewWindowHeight, screenHeight, config.DefaultWindowHeight)
 
 	if !m.AutoTiling && m.LastMouseX > 0 && m.LastMouseY > 0 {
 		// Spawn at the cursor, kept on screen.
@@ -297,7 +476,7 @@ func (m *OS) NewWindowPlacement() (x, y, width, height int) {
 		y = min(m.LastMouseY, screenHeight-height)
 		return max(x, 0), max(y, 0), width, height
 	}
-	return screenWidth / 4, screenHeight / 4, width, height
+	return (screenWidth - width) / 2, (screenHeight - height) / 2, width, height
 }
 
 // QuitSession performs a deliberate, user-initiated quit. In a daemon session
@@ -329,7 +508,25 @@ func (m *OS) QuitSession() {
 // the NewWindow verb takes and it means the same thing on both paths, which it
 // did not when the daemon set CustomName and the client set the shell title.
 func (m *OS) AddWindow(name string) *OS {
+	return m.addWindow(name, "", nil)
+}
+
+// addWindow is AddWindow for a window that will run command with args, as a
+// layout template's windows do. On the local path the command is recorded as
+// the window's spawn command and its window rule (config.WindowRules) is
+// applied before the window is focused and tiled; typing the command into the
+// shell is left to the caller. A daemon session gets its rule from the
+// command the daemon recorded, in placeUnplacedWindows.
+func (m *OS) addWindow(name, command string, args []string) *OS {
+	beneath, keepFocus := m.newFloatingBeneath()
+
 	if m.IsDaemonSession && m.DaemonClient != nil {
+		// The daemon focuses the window it creates; placeUnplacedWindows
+		// restacks it and hands focus back when it arrives.
+		m.pendingBeneath = nil
+		if keepFocus {
+			m.pendingBeneath = &beneath
+		}
 		var args []string
 		if name != "" {
 			args = []string{name}
@@ -360,6 +557,7 @@ func (m *OS) AddWindow(name string) *OS {
 
 	window.Workspace = m.CurrentWorkspace
 	window.CustomName = name
+	window.SpawnCommand, window.SpawnArgs = command, args
 
 	m.setupKittyPassthrough(window)
 	m.setupSixelPassthrough(window)
@@ -372,17 +570,30 @@ func (m *OS) AddWindow(name string) *OS {
 	m.LogInfo("Window created successfully: %s (ID: %s, total windows: %d)", title, newID[:8], len(m.Windows))
 	m.FireHook(hooks.AfterNewWindow, newID, title)
 
+	// A rule can float the window, size it or send it to another workspace,
+	// all of which decide how it is focused and tiled below. A window sent
+	// elsewhere is tiled when its workspace is next shown.
+	ruled := m.applyWindowRule(window, window.SpawnCommandLine())
+	if window.Workspace != m.CurrentWorkspace {
+		return m
+	}
+
 	// In scrolling mode, add to layout BEFORE focusing so that
 	// ScrollingOnFocusChange can find the window's column.
-	if m.AutoTiling && m.UseScrollingLayout {
+	if m.AutoTiling && m.UseScrollingLayout && !window.IsFloating {
 		m.ScrollingOnWindowAdded(window)
 	}
 
-	// Focus the new window, which will bring it to the front
-	m.FocusWindow(len(m.Windows) - 1)
+	// Focus the new window, which will bring it to the front, unless
+	// config.NewFloatingZOrder keeps it beneath the window that has focus.
+	if keepFocus {
+		m.placeBeneath(window, beneath)
+	} else {
+		m.FocusWindow(len(m.Windows) - 1)
+	}
 
 	// Auto-tile if in tiling mode
-	if m.AutoTiling {
+	if m.AutoTiling && !window.IsFloating {
 		if m.UseScrollingLayout {
 			m.TileAllWindows()
 		} else {
@@ -395,6 +606,11 @@ func (m *OS) AddWindow(name string) *OS {
 		}
 	}
 
+	if !ruled {
+		m.splitSecondWindow(window)
+	}
+	m.startOpenAnimation(window)
+
 	return m
 }
 
@@ -427,7 +643,11 @@ func (m *OS) applyStartupPreferences() {
 
 	// Open the first window through the same path the `n` key uses, so it is
 	// created, focused and (with tiling now on) tiled exactly like a manual one.
-	if s.OpenDefaultWindow {
+	// An existing session that lost its last window gets one too when
+	// on_empty_session_attach asks for it, so the attach lands in a shell.
+	openWindow := s.OpenDefaultWindow ||
+		(m.AttachedToEmpty && s.OnEmptySessionAttach == config.EmptyAttachSpawnShell)
+	if openWindow {
 		m.AddWindow("")
 	}
 
@@ -440,7 +660,7 @@ func (m *OS) applyStartupPreferences() {
 	// arrives (see maybeEnterPendingTerminalMode), but only when a window was
 	// actually requested. With neither a focused window nor one on the way, the
 	// session is left in window-management mode.
-	if s.StartInTerminalMode && (s.OpenDefaultWindow || m.hasFocusedWindow()) {
+	if s.StartInTerminalMode && (openWindow || m.hasFocusedWindow()) {
 		m.pendingStartTerminalMode = true
 		m.maybeEnterPendingTerminalMode()
 	}
@@ -472,6 +692,33 @@ func (m *OS) UpdateAllWindowThemes() {
 	}
 }
 
+// SignalWindow sends sig to the foreground job of the window with windowID,
+// whether or not it has focus, so a stuck process in a background pane can be
+// interrupted, stopped or killed without typing into it.
+//
+// In a daemon session the shell belongs to the daemon, so the signal is sent
+// there as a SignalWindow intent.
+func (m *OS) SignalWindow(windowID string, sig syscall.Signal) error {
+	var window *terminal.Window
+	for _, w := range m.Windows {
+		if w.ID == windowID {
+			window = w
+			break
+		}
+	}
+	if window == nil {
+		return fmt.Errorf("window %s not found", windowID)
+	}
+
+	if window.DaemonMode {
+		if m.DaemonClient == nil {
+			return fmt.Errorf("window %s has no daemon connection", windowID)
+		}
+		return m.DaemonClient.SendIntent("SignalWindow", windowID, session.SignalName(sig))
+	}
+	return window.Signal(sig)
+}
+
 // DeleteWindow removes the window at the specified index.
 //
 // In a daemon session this asks the daemon to close it rather than closing it
@@ -493,6 +740,20 @@ func (m *OS) DeleteWindow(i int) *OS {
 		return m
 	}
 
+	// A window already shrinking away is deleted when its animation ends.
+	if m.Windows[i].Closing {
+		return m
+	}
+	if m.startCloseAnimation(i) {
+		return m
+	}
+	return m.deleteWindowNow(i)
+}
+
+// deleteWindowNow removes the window at index i from this client immediately,
+// closing its PTY and repairing focus and the tiling layout. DeleteWindow calls
+// it directly, or once the close animation has finished.
+func (m *OS) deleteWindowNow(i int) *OS {
 	// Clean up window resources
 	deletedWindow := m.Windows[i]
 	m.LogInfo("Deleting window: %s (index: %d, ID: %s)", deletedWindow.Title(), i, deletedWindow.ID[:8])
@@ -532,7 +793,10 @@ func (m *OS) DeleteWindow(i int) *OS {
 		}
 	}
 
+	m.UnlinkScroll(deletedWindow.ID)
+
 	deletedWindow.Close()
+	m.removeScrollbackFile(deletedWindow.ID)
 
 	// Remove any animations referencing this window to prevent memory leaks
 	cleanedAnimations := make([]*ui.Animation, 0, len(m.Animations))
@@ -595,6 +859,9 @@ func (m *OS) DeleteWindow(i int) *OS {
 				if tree.IsEmpty() {
 					m.LogInfo("BSP: Tree is now empty, clearing workspace tree")
 					m.WorkspaceTrees[m.CurrentWorkspace] = nil
+					// Monocle ends with the last window it was showing, so the
+					// next window opened here is tiled normally.
+					delete(m.WorkspaceMonocle, m.CurrentWorkspace)
 				} else if len(m.Windows) > 0 {
 					m.ApplyBSPLayout()
 				}
@@ -616,6 +883,9 @@ func (m *OS) DeleteWindow(i int) *OS {
 		}
 	}
 
+	// A window closing in the background can empty its workspace.
+	m.pruneEmptyWorkspaces()
+
 	// Sync state to daemon after window deletion
 	m.SyncStateToDaemon()
 
@@ -9,6 +9,8 @@ import (
 	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/overlay"
 	"github.com/Gaurav-Gosain/tuios/internal/theme"
+	"github.com/Gaurav-Gosain/tuios/internal/ui"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // assertSolidRect fails if any line has a different display width than the
@@ -102,3 +104,27 @@ func TestHelpPanelRenders(t *testing.T) {
 	s, _ := m.RenderHelpMenu()
 	assertSolidRect(t, "help search", s)
 }
+
+// TestCacheStatsReportsWindows checks the cache stats overlay counts windows,
+// their layer caches and running animations alongside the style cache.
+func TestCacheStatsReportsWindows(t *testing.T) {
+	clean := newTestWindow(t, "stats-clean-01", 40, 10)
+	dirty := newTestWindow(t, "stats-dirty-01", 40, 10)
+	clean.CachedLayer = lipgloss.NewLayer("x")
+	clean.Dirty, clean.ContentDirty, clean.PositionDirty = false, false, false
+	dirty.MarkContentDirty()
+
+	m := newTestOS(clean)
+	m.Windows = append(m.Windows, dirty)
+	m.Animations = []*ui.Animation{{}}
+
+	if cached, nd := m.layerCacheCounts(); cached != 1 || nd != 1 {
+		t.Errorf("layerCacheCounts() = %d, %d, want 1, 1", cached, nd)
+	}
+	out := ansi.Strip(m.renderCacheStats())
+	for _, want := range []string{"Hit Rate:", "Windows:       2", "Cached Layers: 1 / 2", "Needs Redraw:  1", "Animations:    1", "Scrollback:"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("cache stats missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"strconv"
+	"time"
+
+	"charm.land/lipgloss/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/theme"
+)
+
+// ShowPaneNumbers briefly overlays each visible window's selection number, the
+// same number the leader-digit shortcuts jump to, like tmux's display-panes.
+// The overlay dismisses itself after config.PaneNumbersDuration.
+func (m *OS) ShowPaneNumbers() {
+	m.PaneNumbersUntil = time.Now().Add(config.PaneNumbersDuration)
+}
+
+// ToggleWindowIndices turns the selection number in every window's title on
+// or off. Unlike the pane-number overlay it stays up until toggled again.
+func (m *OS) ToggleWindowIndices() {
+	m.ShowWindowIndices = !m.ShowWindowIndices
+	for _, w := range m.Windows {
+		w.InvalidateCache()
+	}
+	m.MarkAllDirty()
+	state := "off"
+	if m.ShowWindowIndices {
+		state = "on"
+	}
+	m.ShowNotification("Window numbers in titles: "+state, "info", config.NotificationDuration)
+}
+
+// PaneNumbersVisible reports whether the pane-number overlay is up. It stays
+// up for as long as windows are being picked for a comparison.
+func (m *OS) PaneNumbersVisible() bool {
+	return m.ComparePicking || (!m.PaneNumbersUntil.IsZero() && time.Now().Before(m.PaneNumbersUntil))
+}
+
+// expirePaneNumbers clears the overlay once its time is up. It reports true
+// exactly once, on the tick that hides it, so that tick draws the frame that
+// removes the numbers instead of leaving them on a cached frame.
+func (m *OS) expirePaneNumbers() bool {
+	if m.PaneNumbersUntil.IsZero() || m.PaneNumbersVisible() {
+		return false
+	}
+	m.PaneNumbersUntil = time.Time{}
+	return true
+}
+
+// paneNumberLabel returns the digit that selects the window at a 1-based
+// workspace position. Position 10 is reached with 0; windows past it have no
+// digit and get no label.
+func paneNumberLabel(position int) (string, bool) {
+	switch {
+	case position >= 1 && position <= 9:
+		return strconv.Itoa(position), true
+	case position == 10:
+		return "0", true
+	default:
+		return "", false
+	}
+}
+
+// renderPaneNumbers draws one badge centered in every visible window. The
+// focused window's badge uses the accent color so it stands out.
+func (m *OS) renderPaneNumbers() []*lipgloss.Layer {
+	if !m.PaneNumbersVisible() {
+		return nil
+	}
+
+	ui := theme.UI()
+	focused := m.GetFocusedWindow()
+	var layers []*lipgloss.Layer
+	for _, window := range m.GetVisibleWindows() {
+		label, ok := paneNumberLabel(m.workspacePosition(window))
+		if !ok {
+			continue
+		}
+
+		background := ui.Card
+		if window == focused {
+			background = ui.Accent
+		}
+		badge := lipgloss.NewStyle().
+			Foreground(ui.Fg).
+			Background(background).
+			Bold(true).
+			Padding(1, 3).
+			Render(label)
+
+		x := window.X + (window.Width-lipgloss.Width(badge))/2
+		y := window.Y + (window.Height-lipgloss.Height(badge))/2
+		layers = append(layers, lipgloss.NewLayer(badge).
+			X(max(x, 0)).
+			Y(max(y, 0)).
+			Z(config.ZIndexPaneNumbers).
+			ID("pane-number-"+window.ID))
+	}
+	return layers
+}
@@ -0,0 +1,122 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestPaneNumbersMatchDigitShortcuts checks that the overlay labels each
+// window with the digit the leader-digit shortcut uses for it, including 0 for
+// the tenth window, and leaves windows past the tenth unlabelled.
+func TestPaneNumbersMatchDigitShortcuts(t *testing.T) {
+	m := &OS{CurrentWorkspace: 1, FocusedWindow: 0}
+	for i := range 11 {
+		m.Windows = append(m.Windows, &terminal.Window{
+			ID:        "win-" + string(rune('a'+i)),
+			Workspace: 1,
+			X:         i * 10,
+			Width:     10,
+			Height:    5,
+		})
+	}
+	// A window on another workspace must not shift the numbering.
+	m.Windows = append(m.Windows[:1], append([]*terminal.Window{{
+		ID:        "other",
+		Workspace: 2,
+		Width:     10,
+		Height:    5,
+	}}, m.Windows[1:]...)...)
+
+	if layers := m.renderPaneNumbers(); layers != nil {
+		t.Fatalf("overlay rendered %d layers before it was shown", len(layers))
+	}
+
+	m.ShowPaneNumbers()
+	layers := m.renderPaneNumbers()
+	if len(layers) != 10 {
+		t.Fatalf("got %d badges, want 10 (the eleventh window has no digit)", len(layers))
+	}
+
+	tests := []struct {
+		position int
+		want     string
+		ok       bool
+	}{
+		{1, "1", true},
+		{9, "9", true},
+		{10, "0", true},
+		{11, "", false},
+		{0, "", false},
+	}
+	for _, tt := range tests {
+		got, ok := paneNumberLabel(tt.position)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("paneNumberLabel(%d) = %q, %v; want %q, %v", tt.position, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+// TestPaneNumbersExpire checks that the overlay dismisses itself and that the
+// expiry is reported exactly once, so the tick redraws the frame only then.
+func TestPaneNumbersExpire(t *testing.T) {
+	m := &OS{}
+	m.ShowPaneNumbers()
+	if !m.PaneNumbersVisible() {
+		t.Fatal("overlay not visible right after being shown")
+	}
+	if m.expirePaneNumbers() {
+		t.Fatal("overlay expired while still within its duration")
+	}
+
+	m.PaneNumbersUntil = time.Now().Add(-time.Millisecond)
+	if m.PaneNumbersVisible() {
+		t.Fatal("overlay still visible after its duration")
+	}
+	if !m.expirePaneNumbers() {
+		t.Fatal("expiry not reported on the first tick past the deadline")
+	}
+	if m.expirePaneNumbers() {
+		t.Fatal("expiry reported twice")
+	}
+}
+
+// TestWindowIndicesInTitles checks that the title prefix uses the same digit
+// as the overlay, shows on an untitled window too, and stays out of the way
+// while the window is being renamed.
+func TestWindowIndicesInTitles(t *testing.T) {
+	win := newTestWindow(t, "indices-0001", 60, 20)
+	win.CustomName = "vim"
+
+	if got := getWindowTitle(win, 2, false, "", false, 60); got != "vim" {
+		t.Errorf("title with indices off = %q, want %q", got, "vim")
+	}
+	if got := getWindowTitle(win, 2, false, "", true, 60); got != "[2] vim" {
+		t.Errorf("title with indices on = %q, want %q", got, "[2] vim")
+	}
+	if got := getWindowTitle(win, 10, false, "", true, 60); got != "[0] vim" {
+		t.Errorf("tenth window title = %q, want %q", got, "[0] vim")
+	}
+	if got := getWindowTitle(win, 11, false, "", true, 60); got != "vim" {
+		t.Errorf("window past the tenth = %q, want no number", got)
+	}
+	if got := getWindowTitle(win, 2, true, "ed", true, 60); got != "ed_" {
+		t.Errorf("title while renaming = %q, want the rename buffer alone", got)
+	}
+
+	untitled := &terminal.Window{ID: "indices-0002"}
+	if got := getWindowTitle(untitled, 3, false, "", true, 60); got != "[3]" {
+		t.Errorf("untitled window = %q, want %q", got, "[3]")
+	}
+
+	m := newTestOS(win)
+	m.ToggleWindowIndices()
+	if !m.ShowWindowIndices {
+		t.Error("toggle did not turn window numbers on")
+	}
+	m.ToggleWindowIndices()
+	if m.ShowWindowIndices {
+		t.Error("second toggle did not turn window numbers off")
+	}
+}
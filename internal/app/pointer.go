@@ -47,11 +47,11 @@ func (m *OS) UpdatePointerForPosition(x, y int) {
 
 	// Check dock area
 	topMargin := m.GetTopMargin()
-	if config.DockbarPosition == "top" && y < topMargin {
+	if m.DockPosition() == "top" && y < topMargin {
 		SetPointerShape(PointerDefault)
 		return
 	}
-	if config.DockbarPosition == "bottom" && y >= topMargin+m.GetUsableHeight() {
+	if m.DockPosition() == "bottom" && y >= topMargin+m.GetUsableHeight() {
 		SetPointerShape(PointerDefault)
 		return
 	}
@@ -110,7 +110,7 @@ func (m *OS) UpdatePointerForPosition(x, y int) {
 
 	onLeft := x == win.X
 	onRight := x == win.X+win.Width-1
-	onTop := y == win.Y
+	onTop := y == win.Y && win.TopOffset() > 0
 	onBottom := y == win.Y+win.Height-1
 
 	// Corners → diagonal resize
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestPromoteToMaster checks the focused window swaps into the master slot,
+// the master swaps with the top of the stack, and focus follows the window or
+// stays on its slot as promote_keeps_focus_slot says.
+func TestPromoteToMaster(t *testing.T) {
+	original := config.PromoteKeepsFocusSlot
+	defer func() { config.PromoteKeepsFocusSlot = original }()
+
+	newOS := func() *OS {
+		m := &OS{CurrentWorkspace: 1, FocusedWindow: 2, WorkspaceFocus: map[int]int{}, AutoTiling: true}
+		m.Windows = []*terminal.Window{
+			{ID: "master", Workspace: 1},
+			{ID: "float", Workspace: 1, IsFloating: true},
+			{ID: "stack", Workspace: 1},
+		}
+		return m
+	}
+	order := func(m *OS) string {
+		var ids string
+		for _, w := range m.Windows {
+			ids += w.ID + " "
+		}
+		return ids
+	}
+
+	config.PromoteKeepsFocusSlot = false
+	m := newOS()
+	if !m.PromoteToMaster() {
+		t.Fatal("PromoteToMaster reported nothing to promote")
+	}
+	if got := order(m); got != "stack float master " {
+		t.Fatalf("order after promote = %q", got)
+	}
+	if m.Windows[m.FocusedWindow].ID != "stack" {
+		t.Errorf("focus on %q, want it to follow the promoted window", m.Windows[m.FocusedWindow].ID)
+	}
+	if !m.PromoteToMaster() || order(m) != "master float stack " {
+		t.Errorf("promoting the master did not swap it with the stack: %q", order(m))
+	}
+
+	config.PromoteKeepsFocusSlot = true
+	m = newOS()
+	m.PromoteToMaster()
+	if m.FocusedWindow != 2 || m.Windows[2].ID != "master" {
+		t.Errorf("focus on slot %d (%q), want slot 2 holding the old master", m.FocusedWindow, m.Windows[m.FocusedWindow].ID)
+	}
+
+	m = newOS()
+	m.FocusedWindow = 1
+	if m.PromoteToMaster() {
+		t.Error("a floating window was promoted")
+	}
+	m.FocusedWindow = 2
+	m.UseBSPLayout = true
+	if m.PromoteToMaster() {
+		t.Error("promoted outside the master-stack layout")
+	}
+}
@@ -0,0 +1,122 @@
+package app
+
+import (
+	"errors"
+
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// ReloadFocusedWindow kills the focused window's shell and starts a fresh one
+// in the same pane, in the directory the old one was in, then types the
+// command the window was started with (a layout template's command, or the
+// one new-window was given) into it. Geometry, workspace, name and the
+// window's slot in the BSP tree or scrolling layout all stay put; only the
+// PTY and its child process are replaced.
+//
+// The replacement is a new terminal.Window with a new ID, swapped into the
+// old one's index and layout slot. Reusing the old ID would let the old
+// process's exit notification, which arrives after the swap, close the new
+// window. A daemon window is reloaded by the daemon instead, which owns the
+// PTY; see rebindDaemonPTY.
+func (m *OS) ReloadFocusedWindow() error {
+	old := m.GetFocusedWindow()
+	if old == nil {
+		return errors.New("no window to reload")
+	}
+	if old.DaemonMode {
+		if m.DaemonClient == nil {
+			return errors.New("not connected to the daemon")
+		}
+		return m.DaemonClient.SendIntent("ReloadWindow", old.ID)
+	}
+
+	newID := createID()
+	w := terminal.NewWindowInDir(newID, "", focusedCwd(old), old.X, old.Y, old.Width, old.Height, old.Z, m.WindowExitChan, m.PTYDataChan)
+	if w == nil {
+		return errors.New("could not start a new shell")
+	}
+	if old.CellPixelWidth > 0 && old.CellPixelHeight > 0 {
+		w.SetCellPixelDimensions(old.CellPixelWidth, old.CellPixelHeight)
+	}
+	w.Workspace = old.Workspace
+	w.CustomName = old.CustomName
+	w.AutoNamed = old.AutoNamed
+	w.IsFloating = old.IsFloating
+	w.IsPinned = old.IsPinned
+	w.Zoomed = old.Zoomed
+	w.PreZoomX, w.PreZoomY = old.PreZoomX, old.PreZoomY
+	w.PreZoomWidth, w.PreZoomHeight = old.PreZoomWidth, old.PreZoomHeight
+	w.LastFocusTime = old.LastFocusTime
+	w.SpawnCommand, w.SpawnArgs = old.SpawnCommand, old.SpawnArgs
+	w.SetTiled(old.Tiled)
+	if line := w.SpawnCommandLine(); line != "" && w.Pty != nil {
+		_, _ = w.Pty.Write([]byte(line + "\n"))
+	}
+
+	m.setupKittyPassthrough(w)
+	m.setupSixelPassthrough(w)
+	m.setupTextSizingPassthrough(w)
+	m.setupClipboardPassthrough(w)
+	m.setupNotificationPassthrough(w)
+	m.setupCwdWatch(w)
+
+	// Hand the old window's stable integer ID to the new one, so the layout
+	// trees, which only know integer IDs, keep it in the same slot.
+	if intID, ok := m.WindowToBSPID[old.ID]; ok {
+		delete(m.WindowToBSPID, old.ID)
+		m.WindowToBSPID[newID] = intID
+		if m.BSPIDToWindowID != nil {
+			m.BSPIDToWindowID[intID] = newID
+		}
+	}
+	for ws, id := range m.WorkspaceLastFocus {
+		if id == old.ID {
+			m.WorkspaceLastFocus[ws] = newID
+		}
+	}
+	if m.MultifocusSet[old.ID] {
+		delete(m.MultifocusSet, old.ID)
+		m.MultifocusSet[newID] = true
+	}
+
+	m.Windows[m.FocusedWindow] = w
+	old.Close()
+	m.LogInfo("Reloaded window %s as %s", old.ID[:8], newID[:8])
+	m.MarkAllDirty()
+	return nil
+}
+
+// rebindDaemonPTY points a daemon window at the PTY the daemon swapped in for
+// it on a reload. The window keeps its ID, so nothing in the layout moves; its
+// I/O, exit handler and subscription follow the new PTY, and the old screen is
+// cleared so the fresh shell starts on a blank pane.
+func (m *OS) rebindDaemonPTY(w *terminal.Window, ptyID string) {
+	m.unsubscribeFromPTY(w)
+	w.PTYID = ptyID
+	w.WriteOutput([]byte("\x1b[H\x1b[2J\x1b[3J"))
+	if m.DaemonClient == nil {
+		return
+	}
+
+	w.DaemonWriteFunc = func(data []byte) error {
+		return m.DaemonClient.WritePTY(ptyID, data)
+	}
+	w.DaemonResizeFunc = func(width, height int) error {
+		return m.DaemonClient.ResizePTY(ptyID, width, height)
+	}
+
+	windowID := w.ID
+	m.DaemonClient.OnPTYClosed(ptyID, func() {
+		if m.WindowExitChan != nil {
+			m.WindowExitChan <- windowID
+		}
+	})
+
+	if w.Workspace == m.CurrentWorkspace {
+		if termState, err := m.DaemonClient.GetTerminalState(ptyID, true); err == nil && termState != nil {
+			m.restoreTerminalContent(w, termState)
+		}
+		m.subscribeToPTY(w)
+	}
+	m.LogInfo("Rebound window %s to PTY %s", shortID(w.ID), shortID(ptyID))
+}
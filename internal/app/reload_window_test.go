@@ -0,0 +1,72 @@
+package app
+
+import "testing"
+
+// TestReloadFocusedWindowKeepsSlot reloads the left of two tiled windows and
+// checks the replacement is a different window with a fresh process, sitting
+// at the same index, geometry and BSP slot under the same name and spawn
+// command.
+func TestReloadFocusedWindowKeepsSlot(t *testing.T) {
+	m := newStartupOS(t, false, false)
+	defer closeWindows(m)
+	m.ToggleAutoTiling()
+	m.AddWindow("server")
+	m.AddWindow("")
+	m.FocusWindow(0)
+
+	old := m.Windows[0]
+	old.SpawnCommand, old.SpawnArgs = "sleep", []string{"60"}
+	oldIntID := m.getWindowIntID(old.ID)
+	oldPid := old.Pid()
+	x, y, w, h := old.X, old.Y, old.Width, old.Height
+
+	if err := m.ReloadFocusedWindow(); err != nil {
+		t.Fatalf("ReloadFocusedWindow: %v", err)
+	}
+	defer old.Close()
+
+	got := m.Windows[0]
+	if got == old || got.ID == old.ID {
+		t.Fatal("focused window was not replaced")
+	}
+	if got.Pid() == 0 || got.Pid() == oldPid {
+		t.Errorf("new window pid = %d, want a fresh process (old %d)", got.Pid(), oldPid)
+	}
+	if got.X != x || got.Y != y || got.Width != w || got.Height != h {
+		t.Errorf("geometry moved: got (%d,%d %dx%d), want (%d,%d %dx%d)", got.X, got.Y, got.Width, got.Height, x, y, w, h)
+	}
+	if got.CustomName != "server" || got.Workspace != old.Workspace {
+		t.Errorf("name/workspace = %q/%d, want %q/%d", got.CustomName, got.Workspace, "server", old.Workspace)
+	}
+	if line := got.SpawnCommandLine(); line != "sleep 60" {
+		t.Errorf("spawn command = %q, want %q", line, "sleep 60")
+	}
+	if id := m.getWindowIntID(got.ID); id != oldIntID {
+		t.Errorf("BSP int ID = %d, want the old slot %d", id, oldIntID)
+	}
+	if _, ok := m.WindowToBSPID[old.ID]; ok {
+		t.Error("old window ID still mapped in the BSP table")
+	}
+	if m.FocusedWindow != 0 || len(m.Windows) != 2 {
+		t.Errorf("focus=%d windows=%d, want 0 and 2", m.FocusedWindow, len(m.Windows))
+	}
+
+	// Retiling must put the new window where the old one was.
+	m.TileAllWindows()
+	if got.X != x || got.Width != w {
+		t.Errorf("after retile: x=%d width=%d, want %d and %d", got.X, got.Width, x, w)
+	}
+}
+
+// TestReloadFocusedWindowLeavesDaemonWindowsToDaemon checks a daemon window
+// is never swapped for a local shell: the daemon owns its PTY and reloads it.
+func TestReloadFocusedWindowLeavesDaemonWindowsToDaemon(t *testing.T) {
+	win := newTestWindow(t, "reload-daemon-01", 40, 10)
+	m := newTestOS(win)
+	if err := m.ReloadFocusedWindow(); err == nil {
+		t.Fatal("expected an error reloading a daemon window with no daemon")
+	}
+	if m.Windows[0] != win {
+		t.Error("daemon window was replaced")
+	}
+}
@@ -3,6 +3,7 @@ package app
 import (
 	"image/color"
 	"os"
+	"strings"
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
@@ -116,6 +117,12 @@ func (m *OS) GetCanvas(render bool) *lipgloss.Canvas {
 			zIndex = config.ZIndexAnimating
 		}
 
+		// The shadow shares the window's depth: it lies outside the window, so
+		// the tie never matters, and it stays above everything the window is.
+		if config.WindowShadows && !window.Tiled && !window.Zoomed {
+			layers = append(layers, windowShadowLayers(window, zIndex, viewportWidth, viewportHeight+topMargin)...)
+		}
+
 		if window.CachedLayer != nil && !window.Dirty && !window.ContentDirty && !window.PositionDirty {
 			if renderTraceEnabled {
 				traceLayerHold(window, isFocused, "clean")
@@ -210,7 +217,7 @@ func (m *OS) GetCanvas(render bool) *lipgloss.Canvas {
 		overlays := m.renderOverlays()
 		layers = append(layers, overlays...)
 
-		if config.DockbarPosition != "hidden" {
+		if m.DockPosition() != "hidden" {
 			dockLayer := m.renderDock()
 			layers = append(layers, dockLayer)
 		}
@@ -226,6 +233,11 @@ func (m *OS) GetCanvas(render bool) *lipgloss.Canvas {
 // path so both produce identical output.
 func (m *OS) renderWindowBox(window *terminal.Window, index int, isFocused bool, borderColorObj color.Color) string {
 	content := m.renderTerminal(window, isFocused, m.Mode == TerminalMode)
+	if !(window.IsBeingManipulated && m.Resizing) {
+		content = withFrozenHeader(window, content)
+		content = withInversion(window, content)
+	}
+	content = withContentPadding(window, content)
 	if window.Tiled && (!window.Zoomed || config.SharedBorders) {
 		return content
 	}
@@ -237,7 +249,7 @@ func (m *OS) renderWindowBox(window *terminal.Window, index int, isFocused bool,
 	isRenaming := m.RenamingWindow && index == m.FocusedWindow
 	return addToBorder(
 		box.Width(window.Width).
-			Height(window.Height-1).
+			Height(window.Height-window.TopOffset()).
 			BorderForeground(borderColorObj).
 			Render(content),
 		borderColorObj,
@@ -245,6 +257,7 @@ func (m *OS) renderWindowBox(window *terminal.Window, index int, isFocused bool,
 		m.workspacePosition(window),
 		isRenaming,
 		m.RenameBuffer,
+		m.ShowWindowIndices,
 		m.AutoTiling,
 	)
 }
@@ -273,7 +286,8 @@ func (m *OS) fullscreenFastWindow() (*terminal.Window, bool) {
 	if m.ShowHelp || m.ShowCommandPalette || m.ShowSessionSwitcher || m.ShowLayoutPicker ||
 		m.ShowQuitConfirm || m.ShowScrollbackBrowser || m.ShowLogs || m.ShowCacheStats ||
 		m.ShowAggregateView || m.ShowTapeManager || m.ShowTapeReview || m.ShowSettings || m.ShowThemePicker ||
-		m.PrefixActive {
+		m.ThemeCycleActive || m.PrefixActive || m.MergeConfirmTarget != 0 || m.ContextMenu != nil || m.JumpingToWindow ||
+		m.ComparePicking || m.Compare != nil || m.PeekedWorkspace != 0 || m.TooltipVisible {
 		return nil, false
 	}
 	if (config.ShowClock && !config.HideClock) || (m.TapeRecorder != nil && m.TapeRecorder.IsRecording()) {
@@ -357,14 +371,17 @@ func (m *OS) buildFullscreenFrame(window *terminal.Window) string {
 	// rewinding the window a frame. Keep CachedContent for the render fast path.
 	window.CachedLayer = nil
 
-	if config.DockbarPosition == "hidden" {
+	if m.DockPosition() == "hidden" {
 		return boxContent
 	}
 	dockStr, _ := m.renderDockString()
-	if config.DockbarPosition == "top" {
-		return dockStr + "\n" + boxContent
+	// config.DockMargin blank rows keep the window off the dock, matching
+	// what the compositor leaves between them.
+	gap := strings.Repeat("\n", config.DockMargin+1)
+	if m.DockPosition() == "top" {
+		return dockStr + gap + boxContent
 	}
-	return boxContent + "\n" + dockStr
+	return boxContent + gap + dockStr
 }
 
 func (m *OS) View() tea.View {
@@ -393,29 +410,7 @@ func (m *OS) View() tea.View {
 
 	view.AltScreen = true
 
-	// Dynamically select mouse tracking mode based on the child app's actual needs:
-	// - Window management mode: AllMotion for hover effects (dock, UI)
-	// - Terminal mode + child requested mode 1003 (any-event): AllMotion
-	// - Terminal mode + child requested mode 1002 (button-event): CellMotion
-	// - Terminal mode + child requested mode 1000/1001 (click only): CellMotion
-	// - Terminal mode + no mouse mode (kakoune default, nano): CellMotion
-	//
-	// Using AllMotion for apps that only need click tracking (mode 1000) causes
-	// a flood of motion events that get forwarded as phantom keypresses (#78).
-	if m.Mode == TerminalMode {
-		fw := m.GetFocusedWindow()
-		useAllMotion := false
-		if fw != nil && fw.Terminal != nil {
-			useAllMotion = fw.Terminal.HasAllMotionMode()
-		}
-		if useAllMotion {
-			view.MouseMode = tea.MouseModeAllMotion
-		} else {
-			view.MouseMode = tea.MouseModeCellMotion
-		}
-	} else {
-		view.MouseMode = tea.MouseModeAllMotion
-	}
+	view.MouseMode = m.mouseMode()
 
 	view.ReportFocus = true
 	view.DisableBracketedPasteMode = false
@@ -432,7 +427,8 @@ func (m *OS) View() tea.View {
 		hasOverlay := m.ShowHelp || m.ShowCommandPalette || m.ShowSessionSwitcher ||
 			m.ShowLayoutPicker || m.ShowQuitConfirm || m.ShowScrollbackBrowser ||
 			m.ShowLogs || m.ShowCacheStats || m.ShowAggregateView ||
-			m.ShowSettings || m.ShowThemePicker || m.ShowTapeManager || m.ShowTapeReview
+			m.ShowSettings || m.ShowThemePicker || m.ShowTapeManager || m.ShowTapeReview ||
+			m.MergeConfirmTarget != 0
 		if hasOverlay {
 			if m.KittyPassthrough != nil && m.KittyPassthrough.HasPlacements() {
 				m.KittyPassthrough.HideAllPlacements()
@@ -522,8 +518,9 @@ func (m *OS) GetKittyGraphicsCmd() tea.Cmd {
 				backing[n] = WindowPositionInfo{
 					WindowX:            w.X,
 					WindowY:            w.Y,
-					ContentOffsetX:     w.BorderOffset(),
-					ContentOffsetY:     w.BorderOffset(),
+					ContentOffsetX:     w.ContentOffsetX(),
+					ContentOffsetY:     w.ContentOffsetY(),
+					ContentHeight:      w.ContentHeight(),
 					Width:              w.Width,
 					Height:             w.Height,
 					Visible:            visible,
@@ -592,8 +589,9 @@ func (m *OS) GetSixelGraphicsCmd() tea.Cmd {
 			m.sixelPosValue = WindowPositionInfo{
 				WindowX:            w.X,
 				WindowY:            w.Y,
-				ContentOffsetX:     w.BorderOffset(),
-				ContentOffsetY:     w.BorderOffset(),
+				ContentOffsetX:     w.ContentOffsetX(),
+				ContentOffsetY:     w.ContentOffsetY(),
+				ContentHeight:      w.ContentHeight(),
 				Width:              w.Width,
 				Height:             w.Height,
 				Visible:            true,
@@ -621,3 +619,33 @@ func (m *OS) GetSixelGraphicsCmd() tea.Cmd {
 	_, _ = os.Stdout.Write([]byte("\x1b[?2026l")) // sync end
 	return nil
 }
+
+// mouseMode selects the mouse tracking mode based on the child app's actual needs:
+// - Window management mode: AllMotion for hover effects (dock, UI)
+// - Terminal mode + child requested mode 1003 (any-event): AllMotion
+// - Terminal mode + child requested mode 1002 (button-event): CellMotion
+// - Terminal mode + child requested mode 1000/1001 (click only): CellMotion
+// - Terminal mode + no mouse mode (kakoune default, nano): CellMotion
+//
+// Using AllMotion for apps that only need click tracking (mode 1000) causes
+// a flood of motion events that get forwarded as phantom keypresses (#78).
+// Bare motion is not forwarded to those apps, so the hover features below
+// can still ask for it.
+func (m *OS) mouseMode() tea.MouseMode {
+	if m.Mode != TerminalMode {
+		return tea.MouseModeAllMotion
+	}
+	fw := m.GetFocusedWindow()
+	useAllMotion := false
+	if fw != nil && fw.Terminal != nil {
+		useAllMotion = fw.Terminal.HasAllMotionMode()
+	}
+	// Hover focus needs to see the pointer move with no button held, and so
+	// does an auto-hidden dock: it is revealed by the pointer reaching its
+	// edge and tucked away again once the pointer leaves it.
+	dockAutoHide := config.DockAutoHide && (m.DockTucked || m.DockRevealed)
+	if useAllMotion || dockAutoHide || config.FocusMode == config.FocusModeHover {
+		return tea.MouseModeAllMotion
+	}
+	return tea.MouseModeCellMotion
+}
@@ -192,6 +192,9 @@ func (m *OS) renderDockString() (string, int) {
 		if config.ShowRAM {
 			sysInfoParts = append(sysInfoParts, m.GetRAMUsage())
 		}
+		if m.StatusText != "" {
+			sysInfoParts = append(sysInfoParts, m.StatusText)
+		}
 		if len(sysInfoParts) > 0 {
 			rightInfo = sysInfoStyle.Render(strings.Join(sysInfoParts, " "))
 		}
@@ -60,8 +60,9 @@ func isDefaultTitle(title, windowID string) bool {
 // getWindowTitle returns the display name for a window, truncated to fit within maxWidth.
 // Returns empty string if title should be hidden or doesn't fit.
 // position is the window's 1-based place in its workspace, used by the {index}
-// placeholder of appearance.window_title_format.
-func getWindowTitle(window *terminal.Window, position int, isRenaming bool, renameBuffer string, maxWidth int) string {
+// placeholder of appearance.window_title_format. showIndex prefixes the title
+// with the digit that selects the window (OS.ShowWindowIndices).
+func getWindowTitle(window *terminal.Window, position int, isRenaming bool, renameBuffer string, showIndex bool, maxWidth int) string {
 	windowName := ""
 	if window.CustomName != "" {
 		windowName = window.CustomName
@@ -80,6 +81,16 @@ func getWindowTitle(window *terminal.Window, position int, isRenaming bool, rena
 		windowName = config.FormatWindowTitle(windowName, position, window.CWD())
 	}
 
+	if window.ThrottleShown && !isRenaming {
+		windowName = strings.TrimSpace(windowName + " [throttled]")
+	}
+
+	if showIndex && !isRenaming {
+		if label, ok := paneNumberLabel(position); ok {
+			windowName = strings.TrimSpace("[" + label + "] " + windowName)
+		}
+	}
+
 	if windowName == "" {
 		return ""
 	}
@@ -103,7 +114,7 @@ func getWindowTitle(window *terminal.Window, position int, isRenaming bool, rena
 	return windowName
 }
 
-func addToBorder(content string, color color.Color, window *terminal.Window, position int, isRenaming bool, renameBuffer string, isTiling bool) string {
+func addToBorder(content string, color color.Color, window *terminal.Window, position int, isRenaming bool, renameBuffer string, showIndex bool, isTiling bool) string {
 	width := max(lipgloss.Width(content)-2, 0)
 	titlePos := config.WindowTitlePosition
 
@@ -141,7 +152,7 @@ func addToBorder(content string, color color.Color, window *terminal.Window, pos
 
 	windowName := ""
 	if titlePos != "hidden" {
-		windowName = getWindowTitle(window, position, isRenaming, renameBuffer, titleMaxWidth)
+		windowName = getWindowTitle(window, position, isRenaming, renameBuffer, showIndex, titleMaxWidth)
 	}
 
 	borderStyle := style.Foreground(color)
@@ -188,6 +199,10 @@ func addToBorder(content string, color color.Color, window *terminal.Window, pos
 	if len(lines) > 0 {
 		lines[len(lines)-1] = bottomBorder
 	}
+	// A hidden title bar gave its row to the content.
+	if window.TitleBarHidden {
+		return strings.Join(lines, "\n")
+	}
 	return topBorder + "\n" + strings.Join(lines, "\n")
 }
 
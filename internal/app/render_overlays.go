@@ -7,6 +7,7 @@ import (
 
 	"charm.land/lipgloss/v2"
 	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/overlay"
 	"github.com/Gaurav-Gosain/tuios/internal/tape"
 	"github.com/Gaurav-Gosain/tuios/internal/terminal"
 	"github.com/Gaurav-Gosain/tuios/internal/theme"
@@ -161,6 +162,16 @@ func (m *OS) renderOverlays() []*lipgloss.Layer {
 		layers = append(layers, quitLayer)
 	}
 
+	if m.MergeConfirmTarget != 0 {
+		content, geo, _ := m.simpleOverlayPanel("", "Merge workspace?",
+			[]string{
+				fmt.Sprintf("Move %d window(s) from workspace %d", m.GetWorkspaceWindowCount(m.CurrentWorkspace), m.CurrentWorkspace),
+				fmt.Sprintf("into workspace %d.", m.MergeConfirmTarget),
+			},
+			[]overlay.Hint{{Key: "y", Label: "merge"}, {Key: "n", Label: "cancel"}, {Key: "esc", Label: "cancel"}})
+		layers = m.placeOverlayPanel(layers, "merge-confirm", content, geo, nil)
+	}
+
 	if m.ShowHelp {
 		content, geo := m.RenderHelpMenu()
 		layers = m.placeOverlayPanel(layers, "help", content, geo, nil)
@@ -177,62 +188,7 @@ func (m *OS) renderOverlays() []*lipgloss.Layer {
 	}
 
 	if m.ShowCacheStats {
-		stats := GetGlobalStyleCache().GetStats()
-
-		statsTitle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("14")).
-			Bold(true).
-			Render("Style Cache Statistics")
-
-		labelStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("11")).
-			Render
-
-		valueStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("10")).
-			Bold(true).
-			Render
-
-		var statsLines []string
-		statsLines = append(statsLines, statsTitle)
-		statsLines = append(statsLines, "")
-		statsLines = append(statsLines, labelStyle("Hit Rate:      ")+valueStyle(fmt.Sprintf("%.2f%%", stats.HitRate)))
-		statsLines = append(statsLines, labelStyle("Cache Hits:    ")+valueStyle(fmt.Sprintf("%d", stats.Hits)))
-		statsLines = append(statsLines, labelStyle("Cache Misses:  ")+valueStyle(fmt.Sprintf("%d", stats.Misses)))
-		statsLines = append(statsLines, labelStyle("Total Lookups: ")+valueStyle(fmt.Sprintf("%d", stats.Hits+stats.Misses)))
-		statsLines = append(statsLines, labelStyle("Evictions:     ")+valueStyle(fmt.Sprintf("%d", stats.Evicts)))
-		statsLines = append(statsLines, "")
-		statsLines = append(statsLines, labelStyle("Cache Size:    ")+valueStyle(fmt.Sprintf("%d / %d entries", stats.Size, stats.Capacity)))
-		statsLines = append(statsLines, labelStyle("Fill Rate:     ")+valueStyle(fmt.Sprintf("%.1f%%", float64(stats.Size)/float64(stats.Capacity)*100.0)))
-		statsLines = append(statsLines, "")
-
-		perfLabel := "Performance: "
-		var perfText, perfColor string
-		if stats.HitRate >= 95.0 {
-			perfText = "Excellent"
-			perfColor = "10"
-		} else if stats.HitRate >= 85.0 {
-			perfText = "Good"
-			perfColor = "11"
-		} else if stats.HitRate >= 70.0 {
-			perfText = "Fair"
-			perfColor = "214"
-		} else {
-			perfText = "Poor"
-			perfColor = "9"
-		}
-
-		statsLines = append(statsLines, labelStyle(perfLabel)+lipgloss.NewStyle().
-			Foreground(lipgloss.Color(perfColor)).
-			Bold(true).
-			Render(perfText))
-
-		statsLines = append(statsLines, "")
-		statsLines = append(statsLines, lipgloss.NewStyle().
-			Foreground(lipgloss.Color("8")).
-			Render("Press 'q'/'esc' to exit, 'r' to reset stats"))
-
-		statsContent := strings.Join(statsLines, "\n")
+		statsContent := m.renderCacheStats()
 
 		statsBox := lipgloss.NewStyle().
 			Border(getBorder()).
@@ -306,7 +262,7 @@ func (m *OS) renderOverlays() []*lipgloss.Layer {
 		logLines = append(logLines, "")
 		logLines = append(logLines, lipgloss.NewStyle().
 			Foreground(lipgloss.Color("8")).
-			Render("q:close  j/k:scroll  E:copy errors  A:copy all"))
+			Render("q:close  j/k:scroll  s:save to file"))
 
 		logContent := strings.Join(logLines, "\n")
 
@@ -324,7 +280,7 @@ func (m *OS) renderOverlays() []*lipgloss.Layer {
 	showScriptIndicator := true
 	if m.ScriptMode && !m.ScriptFinishedTime.IsZero() {
 		elapsed := time.Since(m.ScriptFinishedTime)
-		if elapsed > scriptDoneLinger {
+		if elapsed > config.TapeFinishHide && !m.holdingFinishedScript() {
 			showScriptIndicator = false
 		}
 	}
@@ -335,6 +291,7 @@ func (m *OS) renderOverlays() []*lipgloss.Layer {
 		// Check for remote script progress first (tape exec), then local player (tape play)
 		var currentCmd, totalCmds, progress int
 		var isFinished bool
+		var runLabel string
 
 		if m.RemoteScriptTotal > 0 {
 			// Remote script execution (tape exec)
@@ -351,12 +308,21 @@ func (m *OS) renderOverlays() []*lipgloss.Layer {
 				currentCmd = player.CurrentIndex()
 				totalCmds = player.TotalCommands()
 				isFinished = player.IsFinished()
+				switch {
+				case player.Runs() == 0:
+					runLabel = fmt.Sprintf(" • run %d", player.Run())
+				case player.Runs() > 1:
+					runLabel = fmt.Sprintf(" • run %d/%d", player.Run(), player.Runs())
+				}
 			}
 		}
 
 		if totalCmds > 0 {
 			if isFinished {
 				scriptStatus = fmt.Sprintf("DONE • %d/%d commands", totalCmds, totalCmds)
+				if m.holdingFinishedScript() {
+					scriptStatus += " • any key to close"
+				}
 			} else {
 				barWidth := 15
 				filledWidth := (progress * barWidth) / 100
@@ -377,6 +343,7 @@ func (m *OS) renderOverlays() []*lipgloss.Layer {
 				} else {
 					scriptStatus = fmt.Sprintf("RUNNING • %s %d%% • %d/%d", bar.String(), progress, displayCmd, totalCmds)
 				}
+				scriptStatus += runLabel
 			}
 		} else {
 			scriptStatus = "TAPE"
@@ -431,6 +398,21 @@ func (m *OS) renderOverlays() []*lipgloss.Layer {
 		} else if m.LayoutPrefixActive {
 			title = "Layout"
 			bindings = config.GetPrefixKeybindings("layout")
+		} else if m.SignalPrefixActive {
+			title = "Signal"
+			bindings = config.GetPrefixKeybindings("signal")
+		} else if m.MergePrefixActive {
+			title = "Merge"
+			bindings = config.GetPrefixKeybindings("merge")
+		} else if m.SendPrefixActive {
+			title = "Send"
+			bindings = config.GetPrefixKeybindings("send")
+		} else if m.PeekPrefixActive {
+			title = "Peek"
+			bindings = config.GetPrefixKeybindings("peek")
+		} else if m.HeaderPrefixActive {
+			title = "Freeze Header"
+			bindings = config.GetPrefixKeybindings("header")
 		} else {
 			title = "Prefix"
 			bindings = config.GetPrefixKeybindings("", m.IsDaemonSession)
@@ -535,16 +517,31 @@ func (m *OS) renderOverlays() []*lipgloss.Layer {
 		layers = append(layers, whichKeyLayer)
 	}
 
+	layers = append(layers, m.renderPaneNumbers()...)
+	if banner := m.renderThemeCycle(); banner != nil {
+		layers = append(layers, banner)
+	}
+	if tooltip := m.renderTooltip(); tooltip != nil {
+		layers = append(layers, tooltip)
+	}
+	if menu := m.renderContextMenu(); menu != nil {
+		layers = append(layers, menu)
+	}
+	if jump := m.renderWindowJump(); jump != nil {
+		layers = append(layers, jump)
+	}
+	if compare := m.renderCompare(); compare != nil {
+		layers = append(layers, compare)
+	}
+	if peek := m.renderPeek(); peek != nil {
+		layers = append(layers, peek)
+	}
+
 	if len(m.Notifications) > 0 {
 		m.CleanupNotifications()
 
-		notifY := 1
 		notifSpacing := 4
 		for i, notif := range m.Notifications {
-			if i >= 3 {
-				break
-			}
-
 			opacity := 1.0
 			if notif.Animation != nil {
 				elapsed := time.Since(notif.Animation.StartTime)
@@ -600,8 +597,7 @@ func (m *OS) renderOverlays() []*lipgloss.Layer {
 				MaxWidth(maxNotifWidth).
 				Render(notifContent)
 
-			notifX := max(m.GetRenderWidth()-lipgloss.Width(notifBox)-2, 0)
-			currentY := notifY + (i * notifSpacing)
+			notifX, currentY := m.notificationPosition(lipgloss.Width(notifBox), i, notifSpacing)
 
 			notifLayer := lipgloss.NewLayer(notifBox).
 				X(notifX).Y(currentY).Z(config.ZIndexNotifications).
@@ -621,6 +617,9 @@ func (m *OS) renderOverlays() []*lipgloss.Layer {
 		currentMatch := focusedWindow.CopyMode.CurrentMatch
 
 		searchText := "/" + searchQuery + "█"
+		if focusedWindow.CopyMode.QuickFind {
+			searchText = "find: " + searchQuery + "█"
+		}
 		if matchCount > 0 {
 			searchText += fmt.Sprintf(" [%d/%d]", currentMatch+1, matchCount)
 		} else if searchQuery != "" {
@@ -657,7 +656,7 @@ func (m *OS) renderOverlays() []*lipgloss.Layer {
 
 			rightMargin := 2
 			dockOffset := 0
-			if config.DockbarPosition == "bottom" {
+			if m.DockPosition() == "bottom" {
 				dockOffset = config.DockHeight
 			}
 
@@ -681,3 +680,140 @@ func (m *OS) renderOverlays() []*lipgloss.Layer {
 
 	return layers
 }
+
+// renderCacheStats builds the body of the cache stats overlay (leader D c):
+// the style cache, the per-window render caches, scrollback memory and
+// running animations.
+func (m *OS) renderCacheStats() string {
+	stats := GetGlobalStyleCache().GetStats()
+
+	statsTitle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("14")).
+		Bold(true).
+		Render("Cache Statistics")
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("11")).
+		Render
+
+	valueStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("10")).
+		Bold(true).
+		Render
+
+	sectionStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("14")).
+		Render
+
+	var statsLines []string
+	statsLines = append(statsLines, statsTitle)
+	statsLines = append(statsLines, "")
+	statsLines = append(statsLines, sectionStyle("Style Cache"))
+	statsLines = append(statsLines, labelStyle("Hit Rate:      ")+valueStyle(fmt.Sprintf("%.2f%%", stats.HitRate)))
+	statsLines = append(statsLines, labelStyle("Cache Hits:    ")+valueStyle(fmt.Sprintf("%d", stats.Hits)))
+	statsLines = append(statsLines, labelStyle("Cache Misses:  ")+valueStyle(fmt.Sprintf("%d", stats.Misses)))
+	statsLines = append(statsLines, labelStyle("Total Lookups: ")+valueStyle(fmt.Sprintf("%d", stats.Hits+stats.Misses)))
+	statsLines = append(statsLines, labelStyle("Evictions:     ")+valueStyle(fmt.Sprintf("%d", stats.Evicts)))
+	statsLines = append(statsLines, "")
+	statsLines = append(statsLines, labelStyle("Cache Size:    ")+valueStyle(fmt.Sprintf("%d / %d entries", stats.Size, stats.Capacity)))
+	statsLines = append(statsLines, labelStyle("Fill Rate:     ")+valueStyle(fmt.Sprintf("%.1f%%", float64(stats.Size)/float64(stats.Capacity)*100.0)))
+	statsLines = append(statsLines, "")
+
+	perfLabel := "Performance: "
+	var perfText, perfColor string
+	if stats.HitRate >= 95.0 {
+		perfText = "Excellent"
+		perfColor = "10"
+	} else if stats.HitRate >= 85.0 {
+		perfText = "Good"
+		perfColor = "11"
+	} else if stats.HitRate >= 70.0 {
+		perfText = "Fair"
+		perfColor = "214"
+	} else {
+		perfText = "Poor"
+		perfColor = "9"
+	}
+
+	statsLines = append(statsLines, labelStyle(perfLabel)+lipgloss.NewStyle().
+		Foreground(lipgloss.Color(perfColor)).
+		Bold(true).
+		Render(perfText))
+
+	cached, dirty := m.layerCacheCounts()
+	scrollback := formatFileSize(int64(m.ScrollbackUsage()))
+	if config.TotalScrollbackBudgetMB > 0 {
+		scrollback += fmt.Sprintf(" / %dMB", config.TotalScrollbackBudgetMB)
+	}
+	statsLines = append(statsLines, "")
+	statsLines = append(statsLines, sectionStyle("Windows"))
+	statsLines = append(statsLines, labelStyle("Windows:       ")+valueStyle(fmt.Sprintf("%d", len(m.Windows))))
+	statsLines = append(statsLines, labelStyle("Cached Layers: ")+valueStyle(fmt.Sprintf("%d / %d", cached, len(m.Windows))))
+	statsLines = append(statsLines, labelStyle("Needs Redraw:  ")+valueStyle(fmt.Sprintf("%d", dirty)))
+	statsLines = append(statsLines, labelStyle("Animations:    ")+valueStyle(fmt.Sprintf("%d", len(m.Animations))))
+	statsLines = append(statsLines, labelStyle("Scrollback:    ")+valueStyle(scrollback))
+	statsLines = append(statsLines, labelStyle("Trimmed:       ")+valueStyle(formatFileSize(m.ScrollbackTrimmed)))
+
+	statsLines = append(statsLines, "")
+	statsLines = append(statsLines, sectionStyle("Background Updates"))
+	statsLines = append(statsLines, labelStyle("Default:       ")+valueStyle(describeUpdateDivisor(max(config.BackgroundUpdateDivisor, 1))))
+	for _, w := range m.Windows {
+		if w.UpdateDivisor > 0 {
+			statsLines = append(statsLines, labelStyle(fmt.Sprintf("%-15s", truncateString(m.getWindowDisplayName(w)+":", 14)))+valueStyle(describeUpdateDivisor(w.UpdateDivisor)))
+		}
+	}
+
+	reads, readBytes, fullReads := m.ptyReadTotals()
+	statsLines = append(statsLines, "")
+	statsLines = append(statsLines, sectionStyle("PTY Reads"))
+	statsLines = append(statsLines, labelStyle("Buffer Size:   ")+valueStyle(formatFileSize(int64(config.PtyReadBufferSize))))
+	statsLines = append(statsLines, labelStyle("Reads:         ")+valueStyle(fmt.Sprintf("%d", reads)))
+	statsLines = append(statsLines, labelStyle("Bytes Read:    ")+valueStyle(formatFileSize(int64(readBytes))))
+	if reads > 0 {
+		statsLines = append(statsLines, labelStyle("Avg Read:      ")+valueStyle(formatFileSize(int64(readBytes/reads))))
+		statsLines = append(statsLines, labelStyle("Full Buffer:   ")+valueStyle(fmt.Sprintf("%.1f%%", float64(fullReads)/float64(reads)*100.0)))
+	}
+
+	statsLines = append(statsLines, "")
+	statsLines = append(statsLines, lipgloss.NewStyle().
+		Foreground(lipgloss.Color("8")).
+		Render("Press 'q'/'esc' to exit, 'r' to reset stats"))
+
+	return strings.Join(statsLines, "\n")
+}
+
+// ptyReadTotals sums the PTY read counters of every window. A high share of
+// reads that fill the buffer means output arrives faster than one read can
+// take it, and a larger pty_read_buffer_size would need fewer reads.
+func (m *OS) ptyReadTotals() (reads, bytes, full uint64) {
+	for _, w := range m.Windows {
+		r, b, f := w.PTYReadStats()
+		reads += r
+		bytes += b
+		full += f
+	}
+	return reads, bytes, full
+}
+
+// ResetCacheStats zeroes the counters the cache stats overlay shows: the
+// style cache's and every window's PTY read counters.
+func (m *OS) ResetCacheStats() {
+	GetGlobalStyleCache().ResetStats()
+	for _, w := range m.Windows {
+		w.ResetPTYReadStats()
+	}
+}
+
+// layerCacheCounts reports how many windows have a cached layer the
+// compositor can reuse, and how many are marked for a redraw.
+func (m *OS) layerCacheCounts() (cached, dirty int) {
+	for _, w := range m.Windows {
+		if w.CachedLayer != nil {
+			cached++
+		}
+		if w.Dirty || w.ContentDirty || w.PositionDirty {
+			dirty++
+		}
+	}
+	return cached, dirty
+}
@@ -104,9 +104,8 @@ func renderScrollbarLayer(window *terminal.Window, borderColor color.Color, zInd
 		sb.WriteString(thumbFg + thumbChar + reset)
 	}
 
-	borderOff := window.BorderOffset()
 	x := window.X + window.Width - 1
-	y := window.Y + borderOff + thumbPos
+	y := window.Y + window.ContentOffsetY() + thumbPos
 
 	return lipgloss.NewLayer(sb.String()).
 		X(x).Y(y).Z(zIndex).
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// shadowStyle paints the drop shadow: a dark band rather than a shade glyph,
+// so it looks the same with and without Nerd Fonts.
+var shadowStyle = lipgloss.NewStyle().Background(lipgloss.Color("8"))
+
+// windowShadowLayers returns the drop shadow of a floating window: a one-column
+// band down its right side and a one-row band along its bottom, both offset by
+// one cell, clipped to the viewport like the window itself. The bands are
+// rebuilt every frame; they are two short strings, and caching them would
+// mean tracking every move and resize of the window.
+func windowShadowLayers(window *terminal.Window, zIndex, viewportWidth, viewportHeight int) []*lipgloss.Layer {
+	if window.Width <= 0 || window.Height <= 0 {
+		return nil
+	}
+	bands := []struct {
+		content string
+		x, y    int
+	}{
+		{strings.TrimSuffix(strings.Repeat(shadowStyle.Render(" ")+"\n", window.Height), "\n"), window.X + window.Width, window.Y + 1},
+		{shadowStyle.Render(strings.Repeat(" ", window.Width)), window.X + 1, window.Y + window.Height},
+	}
+	layers := make([]*lipgloss.Layer, 0, len(bands))
+	for _, b := range bands {
+		content, x, y := clipWindowContent(b.content, b.x, b.y, viewportWidth, viewportHeight)
+		if content == "" {
+			continue
+		}
+		layers = append(layers, lipgloss.NewLayer(content).X(x).Y(y).Z(zIndex))
+	}
+	return layers
+}
@@ -0,0 +1,35 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+func TestWindowShadowLayers(t *testing.T) {
+	win := &terminal.Window{X: 2, Y: 3, Width: 10, Height: 5}
+
+	layers := windowShadowLayers(win, 7, 80, 24)
+	if len(layers) != 2 {
+		t.Fatalf("got %d shadow layers, want 2", len(layers))
+	}
+	right, bottom := layers[0], layers[1]
+	if right.GetX() != 12 || right.GetY() != 4 || right.GetZ() != 7 {
+		t.Errorf("right band at (%d,%d) z%d, want (12,4) z7", right.GetX(), right.GetY(), right.GetZ())
+	}
+	if got := right.Height(); got != 5 {
+		t.Errorf("right band is %d rows, want 5", got)
+	}
+	if bottom.GetX() != 3 || bottom.GetY() != 8 {
+		t.Errorf("bottom band at (%d,%d), want (3,8)", bottom.GetX(), bottom.GetY())
+	}
+	if got := bottom.Width(); got != 10 {
+		t.Errorf("bottom band is %d cells wide, want 10", got)
+	}
+
+	// Flush against the right and bottom edges, both bands fall off screen.
+	win.X, win.Y = 70, 19
+	if layers := windowShadowLayers(win, 7, 80, 24); len(layers) != 0 {
+		t.Errorf("got %d shadow layers for a window touching the edges, want 0", len(layers))
+	}
+}
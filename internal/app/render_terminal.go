@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"charm.land/lipgloss/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/pool"
 	"github.com/Gaurav-Gosain/tuios/internal/terminal"
 	uv "github.com/charmbracelet/ultraviolet"
@@ -32,8 +33,22 @@ var (
 	searchMatchStyle = lipgloss.NewStyle().
 				Background(lipgloss.Color("#FF8700")).
 				Foreground(lipgloss.Color("#000000"))
+
+	// Backgrounds of a mouse or selection-mode selection and its cursor.
+	textSelectionBg   = lipgloss.Color("62")
+	selectionCursorBg = lipgloss.Color("208")
 )
 
+// selectionBackground returns the hex color override as a color, or fallback
+// when the override is empty. It resolves config.SelectionColor and
+// config.SelectionCursorColor.
+func selectionBackground(override string, fallback color.Color) color.Color {
+	if override == "" {
+		return fallback
+	}
+	return lipgloss.Color(override)
+}
+
 // isBlankRender reports whether a rendered frame carries no visible text, so
 // styling and cursor positioning alone do not count as content. It walks bytes
 // and returns on the first visible one, so the ordinary non-blank frame costs a
@@ -212,6 +227,18 @@ func (m *OS) renderTerminal(window *terminal.Window, isFocused bool, inTerminalM
 		copyModeCursorY = window.CopyMode.CursorY
 	}
 
+	// Selection colors can be overridden from config; resolve them once per
+	// frame rather than per cell.
+	cursorStyle, visualStyle := copyModeCursorStyle, visualSelectionStyle
+	if config.SelectionCursorColor != "" {
+		cursorStyle = cursorStyle.Background(lipgloss.Color(config.SelectionCursorColor))
+	}
+	if config.SelectionColor != "" {
+		visualStyle = visualStyle.Background(lipgloss.Color(config.SelectionColor))
+	}
+	selectedBg := selectionBackground(config.SelectionColor, textSelectionBg)
+	selectedCursorBg := selectionBackground(config.SelectionCursorColor, selectionCursorBg)
+
 	// Use pooled highlight grids to reduce allocations
 	var searchHighlights, currentMatchHighlight, visualSelection *pool.HighlightGrid
 
@@ -468,9 +495,11 @@ func (m *OS) renderTerminal(window *terminal.Window, isFocused bool, inTerminalM
 					}
 				}
 
+				char, charWidth = clipWideAtEdge(char, charWidth, x, maxX)
+
 				flushBatch()
 
-				builder.WriteString(renderStyledText(copyModeCursorStyle, char))
+				builder.WriteString(renderStyledText(cursorStyle, char))
 
 				prevCell = nil
 				prevIsCursor = false
@@ -504,19 +533,20 @@ func (m *OS) renderTerminal(window *terminal.Window, isFocused bool, inTerminalM
 			if cell != nil && cell.Content != "" {
 				char = string(cell.Content)
 			}
+			cellWidth := 1
+			if cell != nil && cell.Width > 1 {
+				cellWidth = cell.Width
+			}
+			char, cellWidth = clipWideAtEdge(char, cellWidth, x, maxX)
 
 			if inVisualMode && visualSelection != nil && visualSelection.Get(y, x) && x <= lineEndX {
 				flushBatch()
 
-				builder.WriteString(renderStyledText(visualSelectionStyle, char))
+				builder.WriteString(renderStyledText(visualStyle, char))
 				prevCell = cell
 				prevIsCursor = false
 				prevIsSelected = false
 				prevIsSelectionCursor = false
-				cellWidth := 1
-				if cell != nil && cell.Width > 1 {
-					cellWidth = cell.Width
-				}
 				x += cellWidth
 				continue
 			}
@@ -530,10 +560,6 @@ func (m *OS) renderTerminal(window *terminal.Window, isFocused bool, inTerminalM
 					prevIsCursor = false
 					prevIsSelected = false
 					prevIsSelectionCursor = false
-					cellWidth := 1
-					if cell != nil && cell.Width > 1 {
-						cellWidth = cell.Width
-					}
 					x += cellWidth
 					continue
 				}
@@ -546,10 +572,6 @@ func (m *OS) renderTerminal(window *terminal.Window, isFocused bool, inTerminalM
 					prevIsCursor = false
 					prevIsSelected = false
 					prevIsSelectionCursor = false
-					cellWidth := 1
-					if cell != nil && cell.Width > 1 {
-						cellWidth = cell.Width
-					}
 					x += cellWidth
 					continue
 				}
@@ -578,11 +600,11 @@ func (m *OS) renderTerminal(window *terminal.Window, isFocused bool, inTerminalM
 						}
 
 						if isSelected {
-							currentStyle = currentStyle.Background(lipgloss.Color("62")).Foreground(lipgloss.Color("15"))
+							currentStyle = currentStyle.Background(selectedBg).Foreground(lipgloss.Color("15"))
 						}
 
 						if isSelectionCursor {
-							currentStyle = currentStyle.Background(lipgloss.Color("208")).Foreground(lipgloss.Color("0"))
+							currentStyle = currentStyle.Background(selectedCursorBg).Foreground(lipgloss.Color("0"))
 						}
 						// The style was modified after the cache lookup, so the
 						// cached escape no longer matches it; flush via styleToANSI.
@@ -610,10 +632,6 @@ func (m *OS) renderTerminal(window *terminal.Window, isFocused bool, inTerminalM
 			prevIsSelected = isSelected
 			prevIsSelectionCursor = isSelectionCursor
 
-			cellWidth := 1
-			if cell != nil && cell.Width > 1 {
-				cellWidth = cell.Width
-			}
 			x += cellWidth
 		}
 
@@ -629,6 +647,19 @@ func (m *OS) renderTerminal(window *terminal.Window, isFocused bool, inTerminalM
 	return content
 }
 
+// clipWideAtEdge keeps a wide character from running past the right edge of a
+// maxX-column row. A glyph that starts at column x but does not fit (a CJK
+// character or emoji in the last column, typically from a scrollback line
+// wider than the window) is replaced by spaces for the columns that remain,
+// rather than drawing half of it into the border.
+func clipWideAtEdge(char string, width, x, maxX int) (string, int) {
+	if width > 1 && x+width > maxX {
+		width = max(maxX-x, 1)
+		return strings.Repeat(" ", width), width
+	}
+	return char, width
+}
+
 func (m *OS) renderResizeIndicator(window *terminal.Window) string {
 	termWidth := window.ContentWidth()
 	termHeight := window.ContentHeight()
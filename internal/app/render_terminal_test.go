@@ -0,0 +1,28 @@
+package app
+
+import "testing"
+
+func TestClipWideAtEdge(t *testing.T) {
+	tests := []struct {
+		name      string
+		char      string
+		width, x  int
+		wantChar  string
+		wantWidth int
+	}{
+		{"narrow at edge", "a", 1, 9, "a", 1},
+		{"wide inside", "漢", 2, 7, "漢", 2},
+		{"wide ending at edge", "漢", 2, 8, "漢", 2},
+		{"wide in last column", "漢", 2, 9, " ", 1},
+		{"emoji in last column", "🎨", 2, 9, " ", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			char, width := clipWideAtEdge(tt.char, tt.width, tt.x, 10)
+			if char != tt.wantChar || width != tt.wantWidth {
+				t.Errorf("clipWideAtEdge(%q, %d, %d, 10) = %q, %d; want %q, %d",
+					tt.char, tt.width, tt.x, char, width, tt.wantChar, tt.wantWidth)
+			}
+		})
+	}
+}
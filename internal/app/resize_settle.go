@@ -0,0 +1,83 @@
+package app
+
+import (
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// resizeSettleState coalesces bursts of host terminal resizes. The screen
+// size is taken from every WindowSizeMsg, but the relayout that follows is
+// deferred until the size has held for config.ResizeDebounce, so dragging a
+// host window or resizing a nested multiplexer does not retile and resize
+// every PTY once per event.
+type resizeSettleState struct {
+	// pending is true while a relayout is owed. fromWidth and fromHeight are
+	// the screen size before the burst began, which decides whether floating
+	// windows need pulling back into view.
+	pending               bool
+	fromWidth, fromHeight int
+	gen                   uint64
+}
+
+// resizeSettleMsg fires config.ResizeDebounce after a resize. Only the one
+// carrying the latest generation settles; the earlier ones were superseded.
+type resizeSettleMsg struct {
+	gen uint64
+}
+
+// scheduleResizeSettle records a resize from oldWidth x oldHeight and returns
+// the command that settles it once the size stops changing. The first size
+// the terminal reports, and every resize with the debounce off, settle at
+// once.
+func (m *OS) scheduleResizeSettle(oldWidth, oldHeight int) tea.Cmd {
+	if !m.resizeSettle.pending {
+		m.resizeSettle.pending = true
+		m.resizeSettle.fromWidth, m.resizeSettle.fromHeight = oldWidth, oldHeight
+	}
+	if config.ResizeDebounce <= 0 || oldWidth == 0 || oldHeight == 0 {
+		m.settleResize()
+		return nil
+	}
+	m.resizeSettle.gen++
+	gen := m.resizeSettle.gen
+	return tea.Tick(config.ResizeDebounce, func(time.Time) tea.Msg {
+		return resizeSettleMsg{gen: gen}
+	})
+}
+
+// handleResizeSettle settles the pending resize unless a later one superseded
+// this tick.
+func (m *OS) handleResizeSettle(gen uint64) {
+	if gen != m.resizeSettle.gen || !m.resizeSettle.pending {
+		return
+	}
+	m.settleResize()
+}
+
+// settleResize does the work a resize owes at the current size: it tells the
+// daemon, fits the windows to the screen and flushes the PTY buffers.
+func (m *OS) settleResize() {
+	fromWidth, fromHeight := m.resizeSettle.fromWidth, m.resizeSettle.fromHeight
+	m.resizeSettle.pending = false
+
+	// Notify daemon of our terminal size for multi-client size calculation
+	// This allows the daemon to compute effective size = min(all clients)
+	if m.IsDaemonSession && m.DaemonClient != nil {
+		_ = m.DaemonClient.NotifyTerminalSize(m.Width, m.Height)
+	}
+
+	// Retile windows if in tiling mode
+	if m.AutoTiling {
+		m.TileAllWindows()
+	} else if m.Width < fromWidth || m.Height < fromHeight {
+		// Terminal got smaller in floating mode - clamp windows back into view
+		m.ClampWindowsToView()
+	}
+
+	// Flush PTY buffers after resize to ensure TUI apps (btop, vim, etc.)
+	// redraw properly. The PTY resize sends SIGWINCH, but we also need to
+	// mark all content dirty and invalidate caches for the new output.
+	m.FlushPTYBuffersAfterResize()
+}
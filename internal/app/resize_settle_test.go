@@ -0,0 +1,57 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// TestResizeBurstSettlesOnce checks a burst of resizes updates the screen size
+// at once but retiles only when the last one's settle tick arrives, and that
+// with the debounce off every resize retiles straight away.
+func TestResizeBurstSettlesOnce(t *testing.T) {
+	original, prevAnim := config.ResizeDebounce, config.AnimationsEnabled
+	defer func() { config.ResizeDebounce, config.AnimationsEnabled = original, prevAnim }()
+	config.ResizeDebounce = 30 * time.Millisecond
+	config.AnimationsEnabled = false
+
+	m := NewOS(OSOptions{})
+	defer closeWindows(m)
+	win := newTestWindow(t, "window-a", 40, 10)
+	win.Workspace = m.CurrentWorkspace
+	m.Windows = append(m.Windows, win)
+	m.FocusedWindow = 0
+	m.AutoTiling = true
+
+	if _, cmd := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40}); cmd != nil {
+		t.Fatal("the first size should settle without a tick")
+	}
+	if win.Width != 120 {
+		t.Fatalf("first size tiled the window %d wide, want 120", win.Width)
+	}
+
+	m.Update(tea.WindowSizeMsg{Width: 110, Height: 40})
+	m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	if m.Width != 100 {
+		t.Fatalf("screen width %d, want the latest size", m.Width)
+	}
+	if win.Width != 120 {
+		t.Fatalf("window retiled to %d during the burst", win.Width)
+	}
+
+	m.Update(resizeSettleMsg{gen: m.resizeSettle.gen - 1})
+	if win.Width != 120 {
+		t.Fatal("a superseded settle tick retiled")
+	}
+	m.Update(resizeSettleMsg{gen: m.resizeSettle.gen})
+	if win.Width != 100 {
+		t.Fatalf("window %d wide after the burst settled, want 100", win.Width)
+	}
+
+	config.ResizeDebounce = 0
+	if _, cmd := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40}); cmd != nil || win.Width != 90 {
+		t.Errorf("with the debounce off the resize should apply at once, width %d", win.Width)
+	}
+}
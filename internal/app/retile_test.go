@@ -0,0 +1,73 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/layout"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestRetileNowRebuildsTreeInOpenOrder checks that retiling discards a tree
+// whose shape and ratios have drifted and rebuilds it from the workspace's
+// windows in the order they were opened.
+func TestRetileNowRebuildsTreeInOpenOrder(t *testing.T) {
+	prevAnim := config.AnimationsEnabled
+	config.AnimationsEnabled = false
+	defer func() { config.AnimationsEnabled = prevAnim }()
+
+	m := &OS{
+		CurrentWorkspace:   1,
+		WorkspaceFocus:     map[int]int{},
+		WorkspaceHasCustom: map[int]bool{1: true},
+		Width:              120,
+		Height:             40,
+		AutoTiling:         true,
+		UseBSPLayout:       true,
+	}
+	for _, id := range []string{"window-a", "window-b", "window-c"} {
+		m.Windows = append(m.Windows, &terminal.Window{ID: id, Workspace: 1, Width: 20, Height: 10})
+	}
+
+	// A drifted tree: opened in the wrong order with a lopsided ratio.
+	drifted := m.GetOrCreateBSPTree()
+	bounds := m.GetBSPBounds()
+	a, b, c := m.getWindowIntID("window-a"), m.getWindowIntID("window-b"), m.getWindowIntID("window-c")
+	drifted.InsertWindow(c, 0, layout.SplitNone, 0.5, bounds)
+	drifted.InsertWindow(a, c, layout.SplitHorizontal, 0.9, bounds)
+	drifted.InsertWindow(b, a, layout.SplitNone, 0.5, bounds)
+
+	if !m.RetileNow() {
+		t.Fatal("RetileNow reported tiling off")
+	}
+
+	tree := m.WorkspaceTrees[1]
+	if tree == drifted {
+		t.Fatal("retile reused the drifted tree")
+	}
+	got := tree.GetAllWindowIDs()
+	want := []int{a, b, c}
+	if len(got) != len(want) {
+		t.Fatalf("rebuilt tree has windows %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("rebuilt tree has windows %v, want %v", got, want)
+		}
+	}
+	if tree.Root.SplitRatio != 0.5 {
+		t.Errorf("root ratio %v survived the retile, want 0.5", tree.Root.SplitRatio)
+	}
+	if m.WorkspaceHasCustom[1] {
+		t.Error("workspace still marked custom after a retile")
+	}
+}
+
+// TestRetileNowNeedsTiling checks that retiling a floating workspace does
+// nothing and says so.
+func TestRetileNowNeedsTiling(t *testing.T) {
+	m := &OS{CurrentWorkspace: 1}
+	if m.RetileNow() {
+		t.Error("RetileNow reported success with tiling off")
+	}
+}
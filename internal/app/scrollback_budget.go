@@ -0,0 +1,77 @@
+package app
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// enforceScrollbackBudget trims scrollback when all windows together hold more
+// than config.TotalScrollbackBudgetMB. It is called from the tick and checks at
+// most once per config.ScrollbackBudgetInterval, since summing every window's
+// scrollback on each frame buys nothing.
+func (m *OS) enforceScrollbackBudget() {
+	if config.TotalScrollbackBudgetMB <= 0 {
+		return
+	}
+	if time.Since(m.scrollbackChecked) < config.ScrollbackBudgetInterval {
+		return
+	}
+	m.scrollbackChecked = time.Now()
+	m.trimScrollbackToBudget(config.TotalScrollbackBudgetMB * 1024 * 1024)
+}
+
+// ScrollbackUsage returns the approximate memory held by the scrollback of
+// every window, in bytes.
+func (m *OS) ScrollbackUsage() int {
+	total := 0
+	for _, w := range m.Windows {
+		total += w.ScrollbackBytesSync()
+	}
+	return total
+}
+
+// trimScrollbackToBudget drops the oldest scrollback lines, least recently
+// focused window first, until the total is back within budget bytes.
+func (m *OS) trimScrollbackToBudget(budget int) {
+	over := m.ScrollbackUsage() - budget
+	if over <= 0 {
+		return
+	}
+	for _, w := range m.scrollbackTrimOrder() {
+		freed := w.TrimScrollbackBytes(over)
+		if freed == 0 {
+			continue
+		}
+		m.ScrollbackTrimmed += int64(freed)
+		w.MarkContentDirty()
+		over -= freed
+		if over <= 0 {
+			return
+		}
+	}
+}
+
+// scrollbackTrimOrder lists the windows whose scrollback may be trimmed, least
+// recently focused first and the focused window last. Windows being read in
+// scrollback or copy mode are left alone, so lines are not pulled out from
+// under the user's offset.
+func (m *OS) scrollbackTrimOrder() []*terminal.Window {
+	focused := m.GetFocusedWindow()
+	var order []*terminal.Window
+	for _, w := range m.Windows {
+		if w == focused || w.ScrollbackMode || w.CopyMode != nil {
+			continue
+		}
+		order = append(order, w)
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].LastFocusTime.Before(order[j].LastFocusTime)
+	})
+	if focused != nil && !focused.ScrollbackMode && focused.CopyMode == nil {
+		order = append(order, focused)
+	}
+	return order
+}
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+func fillScrollback(t *testing.T, win *terminal.Window, lines int) {
+	t.Helper()
+	win.LockIO()
+	_, _ = win.Terminal.Write([]byte(strings.Repeat("line\r\n", lines)))
+	win.UnlockIO()
+	if win.ScrollbackLen() == 0 {
+		t.Fatal("setup: no scrollback was produced")
+	}
+}
+
+// TestScrollbackBudgetTrimsLeastRecentlyFocusedFirst checks that going over
+// the budget takes lines from the window focused longest ago, and leaves the
+// focused window alone while others can cover the excess.
+func TestScrollbackBudgetTrimsLeastRecentlyFocusedFirst(t *testing.T) {
+	focused := newTestWindow(t, "sb-focused-01", 40, 10)
+	recent := newTestWindow(t, "sb-recent-001", 40, 10)
+	stale := newTestWindow(t, "sb-stale-0001", 40, 10)
+	for _, w := range []*terminal.Window{focused, recent, stale} {
+		fillScrollback(t, w, 100)
+	}
+	now := time.Now()
+	stale.LastFocusTime = now.Add(-time.Hour)
+	recent.LastFocusTime = now.Add(-time.Minute)
+
+	m := newTestOS(focused)
+	m.Windows = append(m.Windows, recent, stale)
+
+	perWindow := focused.ScrollbackBytesSync()
+	total := m.ScrollbackUsage()
+	focusedLen, recentLen, staleLen := focused.ScrollbackLen(), recent.ScrollbackLen(), stale.ScrollbackLen()
+
+	// Over by half a window: only the stale window should lose lines.
+	m.trimScrollbackToBudget(total - perWindow/2)
+
+	if m.ScrollbackUsage() > total-perWindow/2 {
+		t.Errorf("usage %d still over budget %d", m.ScrollbackUsage(), total-perWindow/2)
+	}
+	if stale.ScrollbackLen() >= staleLen {
+		t.Errorf("stale window kept %d lines, want it trimmed", stale.ScrollbackLen())
+	}
+	if recent.ScrollbackLen() != recentLen || focused.ScrollbackLen() != focusedLen {
+		t.Errorf("recent/focused windows trimmed: %d/%d lines, want %d/%d",
+			recent.ScrollbackLen(), focused.ScrollbackLen(), recentLen, focusedLen)
+	}
+	if m.ScrollbackTrimmed == 0 {
+		t.Error("ScrollbackTrimmed not updated")
+	}
+}
+
+// TestScrollbackBudgetSkipsWindowsBeingRead checks that a window in copy
+// mode keeps its scrollback even when it is the least recently focused.
+func TestScrollbackBudgetSkipsWindowsBeingRead(t *testing.T) {
+	focused := newTestWindow(t, "sb-focused-02", 40, 10)
+	reading := newTestWindow(t, "sb-reading-01", 40, 10)
+	fillScrollback(t, focused, 100)
+	fillScrollback(t, reading, 100)
+	reading.LastFocusTime = time.Now().Add(-time.Hour)
+	reading.EnterCopyMode()
+
+	m := newTestOS(focused)
+	m.Windows = append(m.Windows, reading)
+	readingLen, focusedLen := reading.ScrollbackLen(), focused.ScrollbackLen()
+
+	m.trimScrollbackToBudget(m.ScrollbackUsage() - 1)
+
+	if reading.ScrollbackLen() != readingLen {
+		t.Errorf("copy-mode window trimmed to %d lines, want %d", reading.ScrollbackLen(), readingLen)
+	}
+	if focused.ScrollbackLen() >= focusedLen {
+		t.Error("focused window should have been trimmed as the last resort")
+	}
+}
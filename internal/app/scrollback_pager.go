@@ -0,0 +1,166 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/Gaurav-Gosain/tuios/internal/scrollback"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// pendingPager is a scrollback pager window requested from the daemon. The
+// daemon creates windows asynchronously, so the command that opens the file is
+// held here until the window named name shows up in a state sync.
+type pendingPager struct {
+	name    string
+	command string
+	path    string
+}
+
+// pagerProgram picks the program to view scrollback with: $EDITOR (then vi)
+// when editor is set, otherwise $PAGER (then less).
+func pagerProgram(editor bool) string {
+	if editor {
+		if p := os.Getenv("EDITOR"); p != "" {
+			return p
+		}
+		return "vi"
+	}
+	if p := os.Getenv("PAGER"); p != "" {
+		return p
+	}
+	return "less"
+}
+
+// pagerCommand is the line typed into the new window's shell. exec replaces
+// the shell, so quitting the pager closes the window, and the leading space
+// keeps the line out of the history of shells that ignore such lines.
+func pagerCommand(program, path string) string {
+	return fmt.Sprintf(" exec %s %q\n", program, path)
+}
+
+// OpenScrollbackInPager dumps the focused window's scrollback and screen to a
+// temp file and opens it in a new window running $PAGER, or $EDITOR when
+// editor is set. The file is removed when that window closes.
+func (m *OS) OpenScrollbackInPager(editor bool) error {
+	source := m.GetFocusedWindow()
+	if source == nil || source.Terminal == nil {
+		return errors.New("no terminal to open")
+	}
+
+	path, err := writeScrollbackFile(source)
+	if err != nil {
+		return err
+	}
+
+	name := "scrollback: " + m.getWindowDisplayName(source)
+	command := pagerCommand(pagerProgram(editor), path)
+
+	if m.IsDaemonSession && m.DaemonClient != nil {
+		m.dropPendingPager()
+		m.pendingPager = &pendingPager{name: name, command: command, path: path}
+		m.AddWindow(name)
+		return nil
+	}
+
+	before := len(m.Windows)
+	m.AddWindow(name)
+	if len(m.Windows) == before {
+		_ = os.Remove(path)
+		return errors.New("could not create a window")
+	}
+	m.startPager(m.Windows[len(m.Windows)-1], command, path)
+	return nil
+}
+
+// writeScrollbackFile writes the window's text to a new temp file and returns
+// its path.
+func writeScrollbackFile(w *terminal.Window) (string, error) {
+	w.RLockIO()
+	text := scrollback.PlainText(w.Terminal)
+	w.RUnlockIO()
+
+	f, err := os.CreateTemp("", "tuios-scrollback-*.txt")
+	if err != nil {
+		return "", fmt.Errorf("create scrollback file: %w", err)
+	}
+	if _, err := f.WriteString(text); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return "", fmt.Errorf("write scrollback file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return "", fmt.Errorf("write scrollback file: %w", err)
+	}
+	return f.Name(), nil
+}
+
+// startPager types the pager command into w's shell and ties the temp file to
+// the window's lifetime. The pager window takes the keyboard when it has focus,
+// so it can be scrolled straight away.
+func (m *OS) startPager(w *terminal.Window, command, path string) {
+	switch {
+	case w.Pty != nil:
+		_, _ = w.Pty.Write([]byte(command))
+	case w.DaemonWriteFunc != nil:
+		_ = w.DaemonWriteFunc([]byte(command))
+	}
+	if m.scrollbackFiles == nil {
+		m.scrollbackFiles = make(map[string]string)
+	}
+	m.scrollbackFiles[w.ID] = path
+	if m.GetFocusedWindow() == w {
+		m.EnterTerminalMode()
+	}
+}
+
+// startPendingPager hands the pending pager command to its window once the
+// daemon has created it. It is called after every state sync.
+func (m *OS) startPendingPager() {
+	p := m.pendingPager
+	if p == nil {
+		return
+	}
+	for _, w := range m.Windows {
+		if w.CustomName != p.name {
+			continue
+		}
+		if _, taken := m.scrollbackFiles[w.ID]; taken {
+			continue
+		}
+		m.pendingPager = nil
+		m.startPager(w, p.command, p.path)
+		return
+	}
+}
+
+// dropPendingPager forgets a pager window that never arrived and removes its
+// file.
+func (m *OS) dropPendingPager() {
+	if m.pendingPager != nil {
+		_ = os.Remove(m.pendingPager.path)
+		m.pendingPager = nil
+	}
+}
+
+// removeScrollbackFile deletes the temp file shown by a pager window that is
+// closing. Windows that are not pagers are ignored.
+func (m *OS) removeScrollbackFile(windowID string) {
+	path, ok := m.scrollbackFiles[windowID]
+	if !ok {
+		return
+	}
+	delete(m.scrollbackFiles, windowID)
+	_ = os.Remove(path)
+}
+
+// removeAllScrollbackFiles deletes every pager temp file still open when
+// TUIOS exits.
+func (m *OS) removeAllScrollbackFiles() {
+	for id := range m.scrollbackFiles {
+		m.removeScrollbackFile(id)
+	}
+	m.dropPendingPager()
+}
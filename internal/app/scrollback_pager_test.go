@@ -0,0 +1,92 @@
+package app
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestWriteScrollbackFileHoldsScrollbackAndScreen checks the dumped file has
+// the lines that scrolled off as well as the ones still on screen, in order.
+func TestWriteScrollbackFileHoldsScrollbackAndScreen(t *testing.T) {
+	w := newTestWindow(t, "pager-src", 40, 6)
+	w.WriteOutput([]byte("one\r\ntwo\r\nthree\r\nfour\r\nfive\r\nsix\r\nseven"))
+
+	path, err := writeScrollbackFile(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), "one\ntwo\nthree\nfour\nfive\nsix\nseven\n"; got != want {
+		t.Errorf("scrollback file = %q, want %q", got, want)
+	}
+}
+
+// TestPendingPagerStartsWhenItsWindowArrives covers the daemon path: the pager
+// command waits until the named window shows up, is typed into it once, and the
+// temp file goes away when that window closes.
+func TestPendingPagerStartsWhenItsWindowArrives(t *testing.T) {
+	src := newTestWindow(t, "pager-src", 40, 6)
+	m := newTestOS(src)
+
+	f, err := os.CreateTemp(t.TempDir(), "scrollback-*.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = f.Close()
+	m.pendingPager = &pendingPager{name: "scrollback: test", command: pagerCommand("less", f.Name()), path: f.Name()}
+
+	m.startPendingPager()
+	if m.pendingPager == nil {
+		t.Fatal("pending pager dropped before its window arrived")
+	}
+
+	pager := newTestWindow(t, "pager-win", 40, 6)
+	pager.CustomName = "scrollback: test"
+	var typed strings.Builder
+	pager.DaemonWriteFunc = func(b []byte) error {
+		typed.Write(b)
+		return nil
+	}
+	m.Windows = append(m.Windows, pager)
+
+	m.startPendingPager()
+	if m.pendingPager != nil {
+		t.Error("pending pager still set after its window arrived")
+	}
+	if want := pagerCommand("less", f.Name()); typed.String() != want {
+		t.Errorf("typed %q into the pager window, want %q", typed.String(), want)
+	}
+
+	m.removeScrollbackFile(pager.ID)
+	if _, err := os.Stat(f.Name()); !os.IsNotExist(err) {
+		t.Errorf("scrollback file still exists after its window closed: %v", err)
+	}
+}
+
+// TestPagerProgramFallsBack checks $PAGER and $EDITOR are honoured and that an
+// unset variable falls back to less or vi.
+func TestPagerProgramFallsBack(t *testing.T) {
+	t.Setenv("PAGER", "")
+	t.Setenv("EDITOR", "")
+	if got := pagerProgram(false); got != "less" {
+		t.Errorf("pager with $PAGER unset = %q, want less", got)
+	}
+	if got := pagerProgram(true); got != "vi" {
+		t.Errorf("editor with $EDITOR unset = %q, want vi", got)
+	}
+
+	t.Setenv("PAGER", "most")
+	t.Setenv("EDITOR", "nano")
+	if got := pagerProgram(false); got != "most" {
+		t.Errorf("pager = %q, want most", got)
+	}
+	if got := pagerProgram(true); got != "nano" {
+		t.Errorf("editor = %q, want nano", got)
+	}
+}
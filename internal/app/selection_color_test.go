@@ -0,0 +1,50 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestSelectionColorOverride checks that appearance.selection_color and
+// selection_cursor_color recolor the copy-mode visual range and cursor, and
+// that leaving them empty keeps the built-in colors.
+func TestSelectionColorOverride(t *testing.T) {
+	prevSel, prevCur := config.SelectionColor, config.SelectionCursorColor
+	defer func() { config.SelectionColor, config.SelectionCursorColor = prevSel, prevCur }()
+
+	win := newTestWindow(t, "selcolor-0001", 40, 10)
+	m := newTestOS(win)
+	win.LockIO()
+	_, _ = win.Terminal.Write([]byte("HELLO WORLD"))
+	win.UnlockIO()
+
+	win.EnterCopyMode()
+	win.CopyMode.State = terminal.CopyModeVisualChar
+	win.CopyMode.VisualStart = terminal.Position{X: 0, Y: win.ScrollbackLen()}
+	win.CopyMode.VisualEnd = terminal.Position{X: 4, Y: win.ScrollbackLen()}
+	win.CopyMode.CursorX, win.CopyMode.CursorY = 6, 0
+
+	render := func() string {
+		win.MarkContentDirty()
+		return m.renderTerminal(win, true, false)
+	}
+
+	config.SelectionColor, config.SelectionCursorColor = "", ""
+	builtin := render()
+	if strings.Contains(builtin, "18;52;86") || strings.Contains(builtin, "101;67;33") {
+		t.Fatal("override colors present with no override configured")
+	}
+
+	config.SelectionColor = "#123456"
+	config.SelectionCursorColor = "#654321"
+	out := render()
+	if !strings.Contains(out, "48;2;18;52;86") {
+		t.Errorf("visual range not drawn in selection_color: %q", out)
+	}
+	if !strings.Contains(out, "48;2;101;67;33") {
+		t.Errorf("copy-mode cursor not drawn in selection_cursor_color: %q", out)
+	}
+}
@@ -68,6 +68,7 @@ func (m *OS) BuildSessionState() *session.SessionState {
 		Width:            m.GetRenderWidth(),
 		Height:           m.GetRenderHeight(),
 		WorkspaceFocus:   make(map[int]string),
+		LastMode:         session.LastModeWindowManagement,
 		// Tell the daemon which of its versions this snapshot was built from, so
 		// it can reconcile rather than let a stale push undo its own mutations.
 		BaseVersion: m.DaemonStateVersion,
@@ -100,6 +101,7 @@ func (m *OS) BuildSessionState() *session.SessionState {
 			ID:           w.ID,
 			Title:        w.Title(),
 			CustomName:   w.CustomName,
+			AutoNamed:    w.AutoNamed,
 			X:            x,
 			Y:            y,
 			Width:        width,
@@ -113,9 +115,14 @@ func (m *OS) BuildSessionState() *session.SessionState {
 			PreMinimizeH: w.PreMinimizeHeight,
 			PTYID:        w.PTYID,
 			IsAltScreen:  w.IsAltScreen(), // Save alt screen state for mouse forwarding on restore
+			Command:      w.SpawnCommandLine(),
 		}
 	}
 
+	if m.Mode == TerminalMode {
+		state.LastMode = session.LastModeTerminal
+	}
+
 	// Set focused window ID
 	if m.FocusedWindow >= 0 && m.FocusedWindow < len(m.Windows) {
 		state.FocusedWindowID = m.Windows[m.FocusedWindow].ID
@@ -227,7 +234,7 @@ func (m *OS) RestoreFromState(state *session.SessionState) error {
 
 	// Create windows from state
 	for i, ws := range state.Windows {
-		m.LogInfo("[RESTORE] Creating window %d: ID=%s, PTYID=%s", i, ws.ID[:8], ws.PTYID[:8])
+		m.LogInfo("[RESTORE] Creating window %d: ID=%s, PTYID=%s", i, shortID(ws.ID), shortID(ws.PTYID))
 		window := terminal.NewDaemonWindow(
 			ws.ID,
 			ws.Title,
@@ -248,6 +255,8 @@ func (m *OS) RestoreFromState(state *session.SessionState) error {
 		}
 
 		window.CustomName = ws.CustomName
+		window.AutoNamed = ws.AutoNamed
+		window.SpawnCommand = ws.Command
 		window.Workspace = ws.Workspace
 		window.Minimized = ws.Minimized
 		window.PreMinimizeX = ws.PreMinimizeX
@@ -268,29 +277,18 @@ func (m *OS) RestoreFromState(state *session.SessionState) error {
 		m.setupNotificationPassthrough(window)
 		m.setupCwdWatch(window)
 
+		// The daemon could not respawn this window's shell after a restart. Keep
+		// the window so the layout is intact and say why it is empty.
+		if ws.PTYID == "" {
+			window.WriteOutput([]byte(unrestoredNotice(ws.Cwd)))
+		}
+
 		m.Windows = append(m.Windows, window)
-		m.LogInfo("[RESTORE] Window %d created: DaemonMode=%v, PTYID=%s", i, window.DaemonMode, window.PTYID[:8])
+		m.LogInfo("[RESTORE] Window %d created: DaemonMode=%v, PTYID=%s", i, window.DaemonMode, shortID(window.PTYID))
 	}
 
 	// Restore focused window
-	m.FocusedWindow = -1
-	if state.FocusedWindowID != "" {
-		for i, w := range m.Windows {
-			if w.ID == state.FocusedWindowID {
-				m.FocusedWindow = i
-				break
-			}
-		}
-	}
-	// If no focused window matched, focus the first visible window in current workspace
-	if m.FocusedWindow < 0 && len(m.Windows) > 0 {
-		for i, w := range m.Windows {
-			if w.Workspace == m.CurrentWorkspace && !w.Minimized {
-				m.FocusedWindow = i
-				break
-			}
-		}
-	}
+	m.FocusedWindow = m.restoredFocus(state)
 
 	// Start in terminal mode so input goes to the focused terminal immediately
 	// (previously stayed in WM mode, causing typing to not work until click)
@@ -370,10 +368,47 @@ func (m *OS) RestoreFromState(state *session.SessionState) error {
 		m.Mode = TerminalMode
 		m.TerminalModeEnteredAt = time.Now()
 	}
+	// With restore_mode_on_attach, a session left in window-management mode
+	// comes back in it rather than in terminal mode.
+	if state.LastMode == session.LastModeWindowManagement && m.UserConfig != nil && m.UserConfig.Startup.RestoreModeOnAttach {
+		m.Mode = WindowManagementMode
+	}
 
 	return nil
 }
 
+// restoredFocus picks the window index to focus when attaching to state. The
+// window that was focused wins if it is still there, visible and on the
+// current workspace. It may have closed, been minimized or been moved away
+// while nobody was attached, in which case the workspace's remembered focus
+// is next, and then its first visible window. It returns -1 when the current
+// workspace shows no window.
+func (m *OS) restoredFocus(state *session.SessionState) int {
+	focusable := func(id string) int {
+		if id == "" {
+			return -1
+		}
+		for i, w := range m.Windows {
+			if w.ID == id && w.Workspace == m.CurrentWorkspace && !w.Minimized {
+				return i
+			}
+		}
+		return -1
+	}
+	if i := focusable(state.FocusedWindowID); i >= 0 {
+		return i
+	}
+	if i := focusable(state.WorkspaceFocus[m.CurrentWorkspace]); i >= 0 {
+		return i
+	}
+	for i, w := range m.Windows {
+		if w.Workspace == m.CurrentWorkspace && !w.Minimized {
+			return i
+		}
+	}
+	return -1
+}
+
 // ApplyStateSync applies a state update from another client.
 // This handles window creation, deletion, and property updates.
 func (m *OS) ApplyStateSync(state *session.SessionState) error {
@@ -571,12 +606,18 @@ func (m *OS) ApplyStateSync(state *session.SessionState) error {
 
 // updateWindowFromState updates an existing window with state from sync
 func (m *OS) updateWindowFromState(w *terminal.Window, ws *session.WindowState) {
+	// A reload keeps the window and swaps the PTY behind it.
+	if w.DaemonMode && ws.PTYID != "" && ws.PTYID != w.PTYID {
+		m.rebindDaemonPTY(w, ws.PTYID)
+	}
+
 	// Check if size changed
 	sizeChanged := w.Width != ws.Width || w.Height != ws.Height
 
 	// Update all properties
 	w.SetTitle(ws.Title)
 	w.CustomName = ws.CustomName
+	w.AutoNamed = ws.AutoNamed
 	w.X = ws.X
 	w.Y = ws.Y
 	w.Width = ws.Width
@@ -653,6 +694,17 @@ func (m *OS) updateWindowFromState(w *terminal.Window, ws *session.WindowState)
 	}
 }
 
+// unrestoredNotice is written into a restored window that has no shell behind
+// it, in the same dim style as the daemon's restored-shell banner.
+func unrestoredNotice(cwd string) string {
+	msg := "-- tuios: could not start a fresh shell"
+	if cwd != "" {
+		msg += " in " + cwd
+	}
+	msg += "; close this window to dismiss it --"
+	return "\x1b[2m" + msg + "\x1b[0m\r\n"
+}
+
 // createWindowFromSync creates a new window from sync state
 func (m *OS) createWindowFromSync(ws *session.WindowState) *terminal.Window {
 	// Safety check for empty IDs
@@ -679,6 +731,8 @@ func (m *OS) createWindowFromSync(ws *session.WindowState) *terminal.Window {
 	}
 
 	window.CustomName = ws.CustomName
+	window.AutoNamed = ws.AutoNamed
+	window.SpawnCommand = ws.Command
 	window.Workspace = ws.Workspace
 	window.Minimized = ws.Minimized
 	window.PreMinimizeX = ws.PreMinimizeX
@@ -776,6 +830,7 @@ func (m *OS) closeWindowFromSync(w *terminal.Window) {
 	}
 
 	w.Close()
+	m.removeScrollbackFile(w.ID)
 }
 
 // placeUnplacedWindows gives a position and size to every window in state that
@@ -784,7 +839,8 @@ func (m *OS) closeWindowFromSync(w *terminal.Window) {
 // The daemon creates windows but has no viewport to place them in, so it hands
 // over a nominal box and says the box is not a decision. Only a client can turn
 // that into a position, and it does so with exactly the rule it uses for a window
-// it was asked for directly. The flag is cleared implicitly: the client's next
+// it was asked for directly, then applies any window rule matching the command
+// the window was started with. The flag is cleared implicitly: the client's next
 // sync never sets Unplaced, so placing a window and pushing the result is what
 // tells the daemon the question has been answered.
 func (m *OS) placeUnplacedWindows(state *session.SessionState) bool {
@@ -803,6 +859,13 @@ func (m *OS) placeUnplacedWindows(state *session.SessionState) bool {
 			continue
 		}
 		w.X, w.Y, w.Width, w.Height = m.NewWindowPlacement()
+		m.applyWindowRule(w, state.Windows[i].Command)
+		if m.pendingBeneath != nil {
+			if !m.AutoTiling {
+				m.placeBeneath(w, *m.pendingBeneath)
+			}
+			m.pendingBeneath = nil
+		}
 		if w.Terminal != nil {
 			w.Terminal.Resize(w.ContentWidth(), w.ContentHeight())
 		}
@@ -810,6 +873,7 @@ func (m *OS) placeUnplacedWindows(state *session.SessionState) bool {
 			_ = w.DaemonResizeFunc(w.ContentWidth(), w.ContentHeight())
 		}
 		w.InvalidateCache()
+		m.splitSecondWindow(w)
 		placed = true
 	}
 	return placed
@@ -1157,7 +1221,7 @@ func (m *OS) UnsubscribeWorkspaceWindows(workspace int) {
 // SyncStateToDaemon sends the current state to the daemon.
 // This should be called after state-changing operations.
 func (m *OS) SyncStateToDaemon() {
-	if m.DaemonClient == nil || !m.IsDaemonSession {
+	if m.DaemonClient == nil || !m.IsDaemonSession || m.Detached {
 		return
 	}
 
@@ -3,6 +3,7 @@ package app
 import (
 	"testing"
 
+	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/session"
 )
 
@@ -55,3 +56,112 @@ func TestApplyStateSyncLeavesModeAlone(t *testing.T) {
 		t.Errorf("Mode = %v after a state sync, want it untouched (%v)", m.Mode, WindowManagementMode)
 	}
 }
+
+// TestRestoreFromStateFocusFallback covers reattaching after the focused
+// window changed while nobody was attached: one that was minimized or moved to
+// another workspace gives way to the workspace's remembered focus, and then to
+// its first visible window.
+func TestRestoreFromStateFocusFallback(t *testing.T) {
+	windows := []session.WindowState{
+		{ID: "aaaaaaaa-1", PTYID: "pty-aaaaaaaa", Workspace: 1, Width: 20, Height: 6},
+		{ID: "bbbbbbbb-2", PTYID: "pty-bbbbbbbb", Workspace: 1, Width: 20, Height: 6},
+		{ID: "cccccccc-3", PTYID: "pty-cccccccc", Workspace: 1, Width: 20, Height: 6, Minimized: true},
+		{ID: "dddddddd-4", PTYID: "pty-dddddddd", Workspace: 2, Width: 20, Height: 6},
+	}
+	for _, tc := range []struct {
+		name, focused, remembered string
+		want                      int
+	}{
+		{"focused window still there", "bbbbbbbb-2", "", 1},
+		{"focused window closed", "gone", "bbbbbbbb-2", 1},
+		{"focused window minimized", "cccccccc-3", "", 0},
+		{"focused window moved away", "dddddddd-4", "bbbbbbbb-2", 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m := &OS{PTYDataChan: make(chan struct{}, 1)}
+			state := &session.SessionState{
+				Name:             "back",
+				Windows:          windows,
+				CurrentWorkspace: 1,
+				FocusedWindowID:  tc.focused,
+				WorkspaceFocus:   map[int]string{1: tc.remembered},
+			}
+			if err := m.RestoreFromState(state); err != nil {
+				t.Fatalf("RestoreFromState returned error: %v", err)
+			}
+			t.Cleanup(func() {
+				for _, w := range m.Windows {
+					w.Close()
+				}
+			})
+			if m.FocusedWindow != tc.want {
+				t.Errorf("FocusedWindow = %d, want %d", m.FocusedWindow, tc.want)
+			}
+			if m.Mode != TerminalMode {
+				t.Errorf("Mode = %v, want terminal mode with a window focused", m.Mode)
+			}
+		})
+	}
+}
+
+// TestRestoreFromStatePlaceholderWindow covers a window the daemon could not
+// respawn a shell for after a restart: it arrives with no PTY and is kept in
+// the layout with a notice written into it instead of being dropped.
+func TestRestoreFromStatePlaceholderWindow(t *testing.T) {
+	m := &OS{PTYDataChan: make(chan struct{}, 1)}
+	state := &session.SessionState{
+		Name:             "back",
+		CurrentWorkspace: 1,
+		Windows: []session.WindowState{
+			{ID: "aaaaaaaa-1", PTYID: "pty-aaaaaaaa", Workspace: 1, Width: 100, Height: 10},
+			{ID: "bbbbbbbb-2", Workspace: 1, Width: 100, Height: 10, Cwd: "/src"},
+		},
+	}
+	if err := m.RestoreFromState(state); err != nil {
+		t.Fatalf("RestoreFromState returned error: %v", err)
+	}
+	t.Cleanup(func() {
+		for _, w := range m.Windows {
+			w.Close()
+		}
+	})
+	if len(m.Windows) != 2 {
+		t.Fatalf("restored %d windows, want the placeholder kept alongside the live one", len(m.Windows))
+	}
+	if pos := m.Windows[1].Terminal.CursorPosition(); pos.Y == 0 {
+		t.Error("placeholder window has no notice written into it")
+	}
+	if pos := m.Windows[0].Terminal.CursorPosition(); pos.Y != 0 {
+		t.Error("live window got the placeholder notice")
+	}
+}
+
+// TestRestoreFromStateMode checks the mode a session was left in only comes
+// back when restore_mode_on_attach is set.
+func TestRestoreFromStateMode(t *testing.T) {
+	for _, restore := range []bool{false, true} {
+		src := &OS{Mode: WindowManagementMode, CurrentWorkspace: 1}
+		state := src.BuildSessionState()
+		state.Windows = []session.WindowState{
+			{ID: "aaaaaaaa-1", PTYID: "pty-aaaaaaaa", Workspace: 1, Width: 20, Height: 6},
+		}
+		state.FocusedWindowID = "aaaaaaaa-1"
+
+		cfg := config.DefaultConfig()
+		cfg.Startup.RestoreModeOnAttach = restore
+		m := &OS{PTYDataChan: make(chan struct{}, 1), UserConfig: cfg}
+		if err := m.RestoreFromState(state); err != nil {
+			t.Fatalf("RestoreFromState returned error: %v", err)
+		}
+		for _, w := range m.Windows {
+			w.Close()
+		}
+		want := TerminalMode
+		if restore {
+			want = WindowManagementMode
+		}
+		if m.Mode != want {
+			t.Errorf("restore_mode_on_attach = %v: Mode = %v, want %v", restore, m.Mode, want)
+		}
+	}
+}
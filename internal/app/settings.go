@@ -3,6 +3,7 @@ package app
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/theme"
@@ -182,6 +183,18 @@ var (
 	positionOptions    = []string{"bottom", "top", "hidden"}
 	whichKeyPosOptions = []string{"bottom-right", "bottom-left", "top-right", "top-left", "center"}
 	fpsOptions         = []string{"30", "60", "90", "120", "144", "unlimited"}
+	openAnimOptions    = []string{config.OpenAnimationNone, config.OpenAnimationCenter, config.OpenAnimationCursor}
+	closeAnimOptions   = []string{config.CloseAnimationNone, config.CloseAnimationDock}
+	maximizeOptions    = []string{config.MaximizeFullscreenToggle, config.MaximizeZoom, config.MaximizeWorkspaceFullscreen}
+	tilingSchemeOpts   = []string{config.TilingSchemeSpiral, config.TilingSchemeLongestSide, config.TilingSchemeAlternate, config.TilingSchemeSmartSplit}
+	enterActionOptions = []string{config.EnterActionInsert, config.EnterActionNone, config.EnterActionNew}
+	focusModeOptions   = []string{config.FocusModeClick, config.FocusModeHover}
+	emptyClickOptions  = []string{config.EmptyClickNone, config.EmptyClickSpawn, config.EmptyClickClearFocus}
+	titleBarOptions    = []string{config.TitleBarsAlways, config.TitleBarsMultiple, config.TitleBarsNever}
+	dockWsOptions      = []string{config.DockWorkspacesOff, config.DockWorkspacesAll, config.DockWorkspacesOccupied}
+	newFloatZOptions   = []string{config.NewFloatingZTop, config.NewFloatingZBottom, config.NewFloatingZRespectCursor}
+	peekSideOptions    = []string{config.PeekSideAuto, config.PeekSideLeft, config.PeekSideRight}
+	notifPosOptions    = []string{config.NotificationTopRight, config.NotificationTopLeft, config.NotificationTopCenter, config.NotificationBottomRight, config.NotificationBottomLeft, config.NotificationBottomCenter}
 )
 
 // boolPtr returns a pointer to b, for the *bool config fields.
@@ -243,6 +256,15 @@ func stringItem(label, desc, placeholder string, get func(m *OS) string, set fun
 	}
 }
 
+// newWindowSizeOrDefault is the new-window size to use for a value typed into
+// settings: the value itself when it parses, the default otherwise.
+func newWindowSizeOrDefault(v string) string {
+	if _, _, ok := config.ParseWindowSize(v); ok {
+		return strings.TrimSpace(v)
+	}
+	return config.DefaultNewWindowSize
+}
+
 // appearanceString reads a string field off the held appearance config, or ""
 // when no config is present (e.g. in unit tests that build a bare OS).
 func (m *OS) appearanceString(get func(a *config.AppearanceConfig) string) string {
@@ -291,6 +313,19 @@ func (m *OS) settingsCategories() []settingsCategory {
 					m.setAppearance(func(a *config.AppearanceConfig) { a.WindowTitlePosition = v })
 					m.applyAppearanceLive(true)
 				}),
+			enumItem("Title bars", "When windows draw their title bar row (multiple: only beside other windows)", titleBarOptions,
+				func() string { return config.ShowTitleBars },
+				func(m *OS, v string) {
+					config.ShowTitleBars = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.ShowTitleBars = v })
+					m.SyncTitleBars()
+				}),
+			boolItem("Auto-rename windows", "Name windows after their foreground command until renamed by hand",
+				func() bool { return config.AutoRenameFromCommand },
+				func(m *OS, v bool) {
+					config.AutoRenameFromCommand = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.AutoRenameFromCommand = v })
+				}),
 			boolItem("Shared borders", "Merge borders between tiled panes",
 				func() bool { return config.SharedBorders },
 				func(m *OS, v bool) {
@@ -298,6 +333,13 @@ func (m *OS) settingsCategories() []settingsCategory {
 					m.setAppearance(func(a *config.AppearanceConfig) { a.SharedBorders = boolPtr(v) })
 					m.applyAppearanceLive(true)
 				}),
+			intItem("Tiling gap", "Blank cells between tiled windows (ignored with shared borders)", 0, config.MaxTilingGap, 1,
+				func() int { return config.TilingGap },
+				func(m *OS, v int) {
+					config.TilingGap = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.TilingGap = v })
+					m.applyAppearanceLive(true)
+				}),
 			boolItem("Window buttons", "Show minimize/maximize/close buttons",
 				func() bool { return !config.HideWindowButtons },
 				func(m *OS, v bool) {
@@ -305,6 +347,19 @@ func (m *OS) settingsCategories() []settingsCategory {
 					m.setAppearance(func(a *config.AppearanceConfig) { a.HideWindowButtons = !v })
 					m.applyAppearanceLive(false)
 				}),
+			intItem("Content padding", "Blank cells between a window's border and its terminal", 0, config.MaxWindowContentPadding, 1,
+				func() int { return config.WindowContentPadding },
+				func(m *OS, v int) {
+					config.WindowContentPadding = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.WindowContentPadding = v })
+					m.applyContentPadding()
+				}),
+			enumItem("Maximize button", "What the floating-window maximize button does", maximizeOptions,
+				func() string { return config.MaximizeButtonAction },
+				func(m *OS, v string) {
+					config.MaximizeButtonAction = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.MaximizeButtonAction = v })
+				}),
 			boolItem("Scrollbar", "Show the scrollbar thumb on the border",
 				func() bool { return !config.HideScrollbar },
 				func(m *OS, v bool) {
@@ -312,6 +367,13 @@ func (m *OS) settingsCategories() []settingsCategory {
 					m.setAppearance(func(a *config.AppearanceConfig) { a.HideScrollbar = !v })
 					m.applyAppearanceLive(false)
 				}),
+			boolItem("Window shadows", "Draw a drop shadow under floating windows",
+				func() bool { return config.WindowShadows },
+				func(m *OS, v bool) {
+					config.WindowShadows = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.WindowShadows = v })
+					m.MarkAllDirty()
+				}),
 			stringItem("Focused border color", "Hex color for the focused pane border (empty = theme)", "#89b4fa",
 				func(m *OS) string {
 					return m.appearanceString(func(a *config.AppearanceConfig) string { return a.BorderFocusedColor })
@@ -328,6 +390,20 @@ func (m *OS) settingsCategories() []settingsCategory {
 					m.setAppearance(func(a *config.AppearanceConfig) { a.BorderUnfocusedColor = v })
 					m.applyBorderColors()
 				}),
+			stringItem("Selection color", "Hex background for selected text (empty = built-in)", "#45475a",
+				func(m *OS) string { return config.SelectionColor },
+				func(m *OS, v string) {
+					config.SelectionColor = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.SelectionColor = v })
+					m.MarkAllDirty()
+				}),
+			stringItem("Selection cursor color", "Hex background for the copy-mode cursor (empty = built-in)", "#f5c2e7",
+				func(m *OS) string { return config.SelectionCursorColor },
+				func(m *OS, v string) {
+					config.SelectionCursorColor = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.SelectionCursorColor = v })
+					m.MarkAllDirty()
+				}),
 			stringItem("Window title format", "Template: {title}, {index}, {cwd} (empty = raw title)", "{index}: {title}",
 				func(m *OS) string { return config.WindowTitleFormat },
 				func(m *OS, v string) {
@@ -348,6 +424,26 @@ func (m *OS) settingsCategories() []settingsCategory {
 					m.setAppearance(func(a *config.AppearanceConfig) { a.DockbarPosition = v })
 					m.applyAppearanceLive(true)
 				}),
+			enumItem("Workspace list", "Workspace numbers in the dock (occupied: only those with windows)", dockWsOptions,
+				func() string { return config.DockWorkspaces },
+				func(m *OS, v string) {
+					config.DockWorkspaces = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.DockWorkspaces = v })
+				}),
+			boolItem("Auto-hide dock", "Hide the dock while nothing is minimized",
+				func() bool { return config.DockAutoHide },
+				func(m *OS, v bool) {
+					config.DockAutoHide = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.DockAutoHide = v })
+					m.syncDockAutoHide()
+				}),
+			intItem("Dock margin", "Blank rows between windows and the dock", 0, config.MaxDockMargin, 1,
+				func() int { return config.DockMargin },
+				func(m *OS, v int) {
+					config.DockMargin = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.DockMargin = v })
+					m.applyAppearanceLive(true)
+				}),
 			boolItem("Clock", "Show the clock overlay",
 				func() bool { return config.ShowClock },
 				func(m *OS, v bool) {
@@ -369,6 +465,19 @@ func (m *OS) settingsCategories() []settingsCategory {
 					m.setAppearance(func(a *config.AppearanceConfig) { a.ShowRAM = v })
 					m.applyAppearanceLive(false)
 				}),
+			stringItem("Status command", "Shell command whose first output line is shown in the dock (empty = off)", "date +%H:%M",
+				func(m *OS) string { return config.StatusCommand },
+				func(m *OS, v string) {
+					config.StatusCommand = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.StatusCommand = v })
+					m.rerunStatusCommand()
+				}),
+			intItem("Status interval", "Seconds between status command runs", 1, 300, 1,
+				func() int { return int(config.StatusInterval / time.Second) },
+				func(m *OS, v int) {
+					config.StatusInterval = time.Duration(v) * time.Second
+					m.setAppearance(func(a *config.AppearanceConfig) { a.StatusInterval = v })
+				}),
 		},
 	}
 
@@ -382,24 +491,176 @@ func (m *OS) settingsCategories() []settingsCategory {
 					m.setAppearance(func(a *config.AppearanceConfig) { a.AnimationsEnabled = boolPtr(v) })
 					m.applyAppearanceLive(false)
 				}),
+			enumItem("Open animation", "How new windows appear (needs animations)", openAnimOptions,
+				func() string { return config.WindowOpenAnimation },
+				func(m *OS, v string) {
+					config.WindowOpenAnimation = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.WindowOpenAnimation = v })
+				}),
+			enumItem("Close animation", "How closed windows disappear (needs animations)", closeAnimOptions,
+				func() string { return config.WindowCloseAnimation },
+				func(m *OS, v string) {
+					config.WindowCloseAnimation = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.WindowCloseAnimation = v })
+				}),
+			stringItem("New window width", "Floating window width: cells (100) or percent (40%)", config.DefaultNewWindowSize,
+				func(m *OS) string { return config.NewWindowWidth },
+				func(m *OS, v string) {
+					config.NewWindowWidth = newWindowSizeOrDefault(v)
+					m.setAppearance(func(a *config.AppearanceConfig) { a.NewWindowWidth = v })
+				}),
+			stringItem("New window height", "Floating window height: cells (30) or percent (40%)", config.DefaultNewWindowSize,
+				func(m *OS) string { return config.NewWindowHeight },
+				func(m *OS, v string) {
+					config.NewWindowHeight = newWindowSizeOrDefault(v)
+					m.setAppearance(func(a *config.AppearanceConfig) { a.NewWindowHeight = v })
+				}),
+			enumItem("New window stacking", "Where a new floating window goes: top, bottom, or under the window at the mouse", newFloatZOptions,
+				func() string { return config.NewFloatingZOrder },
+				func(m *OS, v string) {
+					config.NewFloatingZOrder = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.NewFloatingZOrder = v })
+				}),
+			boolItem("Split on second window", "Snap two floating windows side by side when the second opens",
+				func() bool { return config.AutoTileFromSecondWindow },
+				func(m *OS, v bool) {
+					config.AutoTileFromSecondWindow = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.AutoTileFromSecondWindow = v })
+				}),
+			enumItem("Tiling scheme", "How a new tiled window picks its split axis", tilingSchemeOpts,
+				func() string { return config.TilingScheme },
+				func(m *OS, v string) {
+					config.TilingScheme = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.TilingScheme = v })
+					m.applyTilingScheme()
+				}),
+			enumItem("Enter key", "What Enter does in window mode: insert, none or new window", enterActionOptions,
+				func() string { return config.EnterAction },
+				func(m *OS, v string) {
+					config.EnterAction = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.EnterAction = v })
+				}),
+			enumItem("Focus mode", "Focus windows by clicking them or by resting the pointer on them", focusModeOptions,
+				func() string { return config.FocusMode },
+				func(m *OS, v string) {
+					config.FocusMode = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.FocusMode = v })
+				}),
+			enumItem("Empty click", "What a left click on the desktop does: nothing, new window or unfocus", emptyClickOptions,
+				func() string { return config.EmptyClickAction },
+				func(m *OS, v string) {
+					config.EmptyClickAction = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.EmptyClickAction = v })
+				}),
+			intItem("Hover focus delay", "Milliseconds the pointer rests on a window before hover focus moves", 50, int(config.MaxHoverFocusDelay/time.Millisecond), 50,
+				func() int { return int(config.HoverFocusDelay / time.Millisecond) },
+				func(m *OS, v int) {
+					config.HoverFocusDelay = time.Duration(v) * time.Millisecond
+					m.setAppearance(func(a *config.AppearanceConfig) { a.HoverFocusDelayMs = v })
+				}),
+			boolItem("Dynamic workspaces", "Only populated workspaces plus one empty one exist",
+				func() bool { return config.DynamicWorkspaces },
+				func(m *OS, v bool) {
+					config.DynamicWorkspaces = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.DynamicWorkspaces = v })
+					m.pruneEmptyWorkspaces()
+					m.MarkAllDirty()
+				}),
+			enumItem("Peek side", "Half of the screen a peeked workspace covers (auto: away from the focused window)", peekSideOptions,
+				func() string { return config.PeekSide },
+				func(m *OS, v string) {
+					config.PeekSide = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.PeekSide = v })
+				}),
 			boolItem("Confirm quit", "Always confirm before quitting",
 				func() bool { return config.AlwaysConfirmQuit },
 				func(m *OS, v bool) {
 					config.AlwaysConfirmQuit = v
 					m.setAppearance(func(a *config.AppearanceConfig) { a.ConfirmQuit = boolPtr(v) })
 				}),
+			boolItem("Confirm quit if busy", "Confirm quitting while a window runs an editor, ssh or another job",
+				func() bool { return config.ConfirmQuitIfBusy },
+				func(m *OS, v bool) {
+					config.ConfirmQuitIfBusy = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.ConfirmQuitIfBusy = boolPtr(v) })
+				}),
+			boolItem("Strip pasted newline", "Drop the trailing newline so a paste is not run",
+				func() bool { return config.PasteStripTrailingNewline },
+				func(m *OS, v bool) {
+					config.PasteStripTrailingNewline = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.PasteStripTrailingNewline = v })
+				}),
 			boolItem("Which-key", "Show the leader-key hint popup",
 				func() bool { return config.WhichKeyEnabled },
 				func(m *OS, v bool) {
 					config.WhichKeyEnabled = v
 					m.setAppearance(func(a *config.AppearanceConfig) { a.WhichKeyEnabled = boolPtr(v) })
 				}),
+			boolItem("Tooltips", "Label title-bar buttons and dock items on hover",
+				func() bool { return config.ShowTooltips },
+				func(m *OS, v bool) {
+					config.ShowTooltips = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.ShowTooltips = boolPtr(v) })
+				}),
+			boolItem("Wrap window cycling", "Next/previous window goes round from the last to the first",
+				func() bool { return config.WindowCycleWrap },
+				func(m *OS, v bool) {
+					config.WindowCycleWrap = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.WindowCycleWrap = boolPtr(v) })
+				}),
+			boolItem("Promote keeps focus slot", "After promoting to master, focus stays where it was",
+				func() bool { return config.PromoteKeepsFocusSlot },
+				func(m *OS, v bool) {
+					config.PromoteKeepsFocusSlot = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.PromoteKeepsFocusSlot = v })
+				}),
 			enumItem("Which-key position", "Corner for the leader-key popup", whichKeyPosOptions,
 				func() string { return config.WhichKeyPosition },
 				func(m *OS, v string) {
 					config.WhichKeyPosition = v
 					m.setAppearance(func(a *config.AppearanceConfig) { a.WhichKeyPosition = v })
 				}),
+			enumItem("Notification position", "Corner or edge notifications stack from", notifPosOptions,
+				func() string { return config.NotificationPosition },
+				func(m *OS, v string) {
+					config.NotificationPosition = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.NotificationPosition = v })
+				}),
+			intItem("Max notifications", "Notifications on screen at once (a new one drops the oldest)", 1, config.MaxNotificationsLimit, 1,
+				func() int { return config.MaxNotifications },
+				func(m *OS, v int) {
+					config.MaxNotifications = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.MaxNotifications = v })
+				}),
+			intItem("Notification time", "Milliseconds a notification stays up", config.MinNotificationDurationMs, config.MaxNotificationDurationMs, 500,
+				func() int { return int(config.NotificationDuration / time.Millisecond) },
+				func(m *OS, v int) {
+					config.NotificationDuration = time.Duration(v) * time.Millisecond
+					m.setAppearance(func(a *config.AppearanceConfig) { a.NotificationDurationMs = v })
+				}),
+			boolItem("Copy mode acceleration", "Held h/j/k/l move faster in copy mode",
+				func() bool { return config.CopyModeKeyAccel },
+				func(m *OS, v bool) {
+					config.CopyModeKeyAccel = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.CopyModeKeyAccel = v })
+				}),
+			boolItem("Search smart case", "Copy-mode search matches case only when the query has a capital",
+				func() bool { return config.CopyModeSmartCase },
+				func(m *OS, v bool) {
+					config.CopyModeSmartCase = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.CopyModeSmartCase = v })
+				}),
+			intItem("Search history", "Copy-mode searches recalled with up/down (0 = off)", 0, config.MaxCopyModeSearchHistory, 10,
+				func() int { return config.CopyModeSearchHistory },
+				func(m *OS, v int) {
+					config.CopyModeSearchHistory = v
+					// 0 in the config file means the default, so off is saved as -1.
+					saved := v
+					if v == 0 {
+						saved = -1
+					}
+					m.setAppearance(func(a *config.AppearanceConfig) { a.CopyModeSearchHistory = saved })
+				}),
 			boolItem("Reverse scroll", "Reverse scroll in the scrolling layout",
 				func() bool { return config.NiriReverseScroll },
 				func(m *OS, v bool) {
@@ -451,6 +712,26 @@ func (m *OS) settingsCategories() []settingsCategory {
 				func(m *OS, v bool) {
 					m.setStartup(func(s *config.StartupConfig) { s.StartInTerminalMode = v })
 				}),
+			boolItem("Restore mode on attach", "Reattach in the mode the session was left in (next attach)",
+				func() bool { return m.UserConfig != nil && m.UserConfig.Startup.RestoreModeOnAttach },
+				func(m *OS, v bool) {
+					m.setStartup(func(s *config.StartupConfig) { s.RestoreModeOnAttach = v })
+				}),
+			enumItem("Empty session attach", "Attaching to a session with no windows left (next attach)", config.EmptyAttachModes,
+				func() string {
+					if m.UserConfig == nil || m.UserConfig.Startup.OnEmptySessionAttach == "" {
+						return config.EmptyAttachStay
+					}
+					return m.UserConfig.Startup.OnEmptySessionAttach
+				},
+				func(m *OS, v string) {
+					m.setStartup(func(s *config.StartupConfig) { s.OnEmptySessionAttach = v })
+				}),
+			boolItem("Attach on launch", "Plain tuios attaches to the latest session if any (next launch)",
+				func() bool { return m.UserConfig != nil && m.UserConfig.Startup.AutoAttach },
+				func(m *OS, v bool) {
+					m.setStartup(func(s *config.StartupConfig) { s.AutoAttach = v })
+				}),
 		},
 	}
 
@@ -463,6 +744,35 @@ func (m *OS) settingsCategories() []settingsCategory {
 					config.ScrollbackLines = v
 					m.setAppearance(func(a *config.AppearanceConfig) { a.ScrollbackLines = v })
 				}),
+			intItem("Scrollback budget (MB)", "Memory cap for all windows' scrollback together, 0 is off", 0, 4096, 64,
+				func() int { return config.TotalScrollbackBudgetMB },
+				func(m *OS, v int) {
+					config.TotalScrollbackBudgetMB = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.TotalScrollbackBudgetMB = v })
+				}),
+			intItem("Background update divisor", "Redraw unfocused windows every Nth frame (3 is ~20Hz at 60 FPS)", 1, config.MaxBackgroundUpdateDivisor, 1,
+				func() int { return config.BackgroundUpdateDivisor },
+				func(m *OS, v int) {
+					config.BackgroundUpdateDivisor = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.BackgroundUpdateDivisor = v })
+				}),
+			intItem("PTY read buffer (KB)", "Bytes read from a program at a time (applies to new windows)", config.MinPtyReadBufferSize/1024, config.MaxPtyReadBufferSize/1024, 4,
+				func() int { return config.PtyReadBufferSize / 1024 },
+				func(m *OS, v int) {
+					config.PtyReadBufferSize = v * 1024
+					m.setAppearance(func(a *config.AppearanceConfig) { a.PtyReadBufferSize = v * 1024 })
+				}),
+			intItem("Resize debounce (ms)", "Wait for the terminal size to settle before relaying out (0 = off)", 0, int(config.MaxResizeDebounce/time.Millisecond), 10,
+				func() int { return int(config.ResizeDebounce / time.Millisecond) },
+				func(m *OS, v int) {
+					config.ResizeDebounce = time.Duration(v) * time.Millisecond
+					// 0 in the config file means the default, so off is saved as -1.
+					saved := v
+					if v == 0 {
+						saved = -1
+					}
+					m.setAppearance(func(a *config.AppearanceConfig) { a.ResizeDebounceMs = saved })
+				}),
 			intItem("Scroll lines", "Lines scrolled per mouse wheel notch", 1, 50, 1,
 				func() int { return config.ScrollLines },
 				func(m *OS, v int) {
@@ -527,6 +837,18 @@ func (m *OS) settingsCategories() []settingsCategory {
 					m.setTape(func(t *config.TapeConfig) { t.AutoReview = !cur })
 				},
 			},
+			boolItem("Hold finished tape", "Keep a played tape's final state up until a key is pressed",
+				func() bool { return config.TapeFinishHold },
+				func(m *OS, v bool) {
+					config.TapeFinishHold = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.TapeFinishHold = v })
+				}),
+			boolItem("Loop tape", "Play a tape again from the top when it finishes",
+				func() bool { return config.TapeLoop },
+				func(m *OS, v bool) {
+					config.TapeLoop = v
+					m.setAppearance(func(a *config.AppearanceConfig) { a.TapeLoop = v })
+				}),
 		},
 	}
 
@@ -19,11 +19,46 @@ import (
 // then shows that a single captured keypress makes it ineligible so the frame
 // falls through to GetCanvas -> renderOverlays and the keycast is drawn.
 func TestFullscreenFastPathYieldsToShowkeys(t *testing.T) {
+	m := fastPathOS(t)
+
+	// The overlay is enabled and a real key is captured, exactly as HandleKeyPress
+	// does at the top of the input path.
+	m.ShowKeys = true
+	m.KeyHistoryMaxSize = 10
+	m.CaptureKeyEvent(tea.KeyPressMsg{Code: 'Q', Text: "Q"})
+	if len(m.RecentKeys) == 0 {
+		t.Fatalf("setup: CaptureKeyEvent did not record the keypress")
+	}
+
+	// With a key to show, the fast path must yield so the compositor draws the
+	// keycast. This is the whole fix.
+	if _, ok := m.fullscreenFastWindow(); ok {
+		t.Fatalf("fullscreen fast path stayed eligible while the showkeys overlay had keys; the keycast would not be drawn")
+	}
+}
+
+// TestFullscreenFastPathYieldsToTooltip checks the fast path steps aside while
+// a tooltip is showing: the tooltip is a compositor overlay too, so a lone
+// fullscreen window would otherwise never draw it.
+func TestFullscreenFastPathYieldsToTooltip(t *testing.T) {
+	m := fastPathOS(t)
+
+	m.TooltipLabel = "Close"
+	m.TooltipVisible = true
+	if _, ok := m.fullscreenFastWindow(); ok {
+		t.Fatal("fullscreen fast path stayed eligible while a tooltip was visible")
+	}
+}
+
+// fastPathOS returns an OS whose single window exactly fills the content area,
+// which is what fullscreenFastWindow accepts, and checks it is eligible.
+func fastPathOS(t *testing.T) *OS {
+	t.Helper()
 	// Fix the render-affecting globals so the eligibility check is deterministic
 	// regardless of what an earlier test or config load left behind.
-	defer withConfig(&config.DockbarPosition, "bottom")()
-	defer withConfig(&config.ShowClock, false)()
-	defer withConfig(&config.SharedBorders, false)()
+	t.Cleanup(withConfig(&config.DockbarPosition, "bottom"))
+	t.Cleanup(withConfig(&config.ShowClock, false))
+	t.Cleanup(withConfig(&config.SharedBorders, false))
 
 	win := newTestWindow(t, "showkeys-fast-0001", 80, 23)
 	m := newTestOS(win)
@@ -36,26 +71,12 @@ func TestFullscreenFastPathYieldsToShowkeys(t *testing.T) {
 	win.Width = m.GetRenderWidth()
 	win.Height = m.GetUsableHeight()
 
-	// Baseline: with no keys to draw the fast path stays eligible. If this fails
+	// Baseline: with nothing overlaid the fast path stays eligible. If this fails
 	// the geometry setup is wrong and the rest of the test would be meaningless.
 	if _, ok := m.fullscreenFastWindow(); !ok {
-		t.Fatalf("setup: fullscreen window should be fast-path eligible with no overlay keys")
-	}
-
-	// The overlay is enabled and a real key is captured, exactly as HandleKeyPress
-	// does at the top of the input path.
-	m.ShowKeys = true
-	m.KeyHistoryMaxSize = 10
-	m.CaptureKeyEvent(tea.KeyPressMsg{Code: 'Q', Text: "Q"})
-	if len(m.RecentKeys) == 0 {
-		t.Fatalf("setup: CaptureKeyEvent did not record the keypress")
-	}
-
-	// With a key to show, the fast path must yield so the compositor draws the
-	// keycast. This is the whole fix.
-	if _, ok := m.fullscreenFastWindow(); ok {
-		t.Fatalf("fullscreen fast path stayed eligible while the showkeys overlay had keys; the keycast would not be drawn")
+		t.Fatalf("setup: fullscreen window should be fast-path eligible with nothing overlaid")
 	}
+	return m
 }
 
 // withConfig sets a package-level config global for the duration of a test and
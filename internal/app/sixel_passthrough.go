@@ -255,7 +255,7 @@ func (sp *SixelPassthrough) RefreshAllPlacements(getWindowInfo func(windowID str
 		}
 
 		// Calculate viewport boundaries using content height (exclude borders)
-		contentHeight := info.Height - 2*info.ContentOffsetY
+		contentHeight := info.ContentHeight
 		if contentHeight <= 0 {
 			contentHeight = info.Height
 		}
@@ -306,7 +306,7 @@ func (sp *SixelPassthrough) RefreshAllPlacements(getWindowInfo func(windowID str
 			hostY := info.WindowY + info.ContentOffsetY + relativeY
 
 			// Window content area bounds (in host coordinates)
-			windowContentBottom := info.WindowY + info.Height - info.ContentOffsetY
+			windowContentBottom := info.WindowY + info.ContentOffsetY + info.ContentHeight
 
 			// Hide if image extends past window content bottom
 			// (sixel can't be pixel-cropped without palette re-quantization)
@@ -54,6 +54,7 @@ func TestSixelVisibleWhenScrollbackExceedsHeight(t *testing.T) {
 			WindowX: 0, WindowY: 0,
 			Width: 80, Height: contentHeight,
 			ContentOffsetX: 0, ContentOffsetY: 0,
+			ContentHeight: contentHeight,
 			Visible:       true,
 			ScrollbackLen: scrollbackLen,
 			ScrollOffset:  0,
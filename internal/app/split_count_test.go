@@ -0,0 +1,63 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestSplitCountSetsShareAndRestoresFocus checks that a two-digit count typed
+// before a split becomes the new pane's share, and that focus goes back to the
+// window the count started on even though the digits selected other windows.
+func TestSplitCountSetsShareAndRestoresFocus(t *testing.T) {
+	m := &OS{CurrentWorkspace: 1, WorkspaceFocus: map[int]int{}}
+	for _, id := range []string{"window-a", "window-b", "window-c"} {
+		m.Windows = append(m.Windows, &terminal.Window{ID: id, Workspace: 1, Width: 20, Height: 10})
+	}
+	m.FocusedWindow = 0
+
+	m.NoteSplitCountDigit("3")
+	m.FocusWindow(2) // what "3" does to focus in tiling mode
+	m.NoteSplitCountDigit("0")
+
+	if got := m.takeSplitCount(); got != 0.3 {
+		t.Errorf("share = %v, want 0.3", got)
+	}
+	if focused := m.GetFocusedWindow(); focused == nil || focused.ID != "window-a" {
+		t.Errorf("split would land on %v, want window-a", focused)
+	}
+	if m.SplitCount != "" {
+		t.Error("count was not consumed")
+	}
+}
+
+// TestSplitCountFallsBackToConfiguredRatio checks the cases that are not a
+// count: a single digit (which only selected a window), a stale count, and a
+// count past its digits being clamped rather than squeezing a pane to nothing.
+func TestSplitCountFallsBackToConfiguredRatio(t *testing.T) {
+	prev := config.DefaultSplitRatio
+	config.DefaultSplitRatio = 0.4
+	defer func() { config.DefaultSplitRatio = prev }()
+
+	m := &OS{CurrentWorkspace: 1}
+
+	m.NoteSplitCountDigit("3")
+	if got := m.takeSplitCount(); got != 0.4 {
+		t.Errorf("single digit: share = %v, want the configured 0.4", got)
+	}
+
+	m.NoteSplitCountDigit("3")
+	m.NoteSplitCountDigit("0")
+	m.SplitCountAt = time.Now().Add(-2 * config.SplitCountWindow)
+	if got := m.takeSplitCount(); got != 0.4 {
+		t.Errorf("stale count: share = %v, want the configured 0.4", got)
+	}
+
+	m.NoteSplitCountDigit("0")
+	m.NoteSplitCountDigit("5")
+	if got := m.takeSplitCount(); got != config.MinSplitRatio {
+		t.Errorf("count 05: share = %v, want it clamped to %v", got, config.MinSplitRatio)
+	}
+}
@@ -181,3 +181,32 @@ func TestStartupPreferences_WiredToFirstResize(t *testing.T) {
 		t.Fatalf("second WindowSizeMsg must not re-run startup; want 1 window, got %d", len(m.Windows))
 	}
 }
+
+// TestStartupPreferences_EmptySessionAttach covers on_empty_session_attach:
+// "spawn-shell" opens a window in an existing session that has none, while the
+// default leaves it empty and a fresh session is left to open_default_window.
+func TestStartupPreferences_EmptySessionAttach(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		mode     string
+		attached bool
+		want     int
+	}{
+		{"spawn-shell on an emptied session", config.EmptyAttachSpawnShell, true, 1},
+		{"stay on an emptied session", config.EmptyAttachStay, true, 0},
+		{"spawn-shell on a new session", config.EmptyAttachSpawnShell, false, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m := newStartupOS(t, false, false)
+			defer closeWindows(m)
+			m.UserConfig.Startup.OnEmptySessionAttach = tc.mode
+			m.AttachedToEmpty = tc.attached
+
+			m.applyStartupPreferences()
+
+			if len(m.Windows) != tc.want {
+				t.Fatalf("windows after attach = %d, want %d", len(m.Windows), tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// StatusCommandMsg carries the result of one config.StatusCommand run back to
+// the Update loop.
+type StatusCommandMsg struct {
+	Command string
+	Output  string
+	Err     error
+}
+
+// pollStatusCommand starts config.StatusCommand when its interval has passed
+// and no run is still in flight. It is called from the maintenance tick; the
+// command itself runs in the returned tea.Cmd, never on the render path.
+func (m *OS) pollStatusCommand() tea.Cmd {
+	if config.StatusCommand == "" {
+		m.StatusText = ""
+		return nil
+	}
+	if m.statusRunning || time.Since(m.statusLastRun) < config.StatusInterval {
+		return nil
+	}
+	m.statusRunning = true
+	m.statusLastRun = time.Now()
+	command := config.StatusCommand
+	return func() tea.Msg {
+		out, err := runShellCommand(command, config.StatusCommandTimeout, false)
+		return StatusCommandMsg{Command: command, Output: out, Err: err}
+	}
+}
+
+// applyStatusCommand stores a finished run's output for the dock and reports
+// whether the shown text changed. Output from a command that has since been
+// replaced in the config is dropped.
+func (m *OS) applyStatusCommand(msg StatusCommandMsg) bool {
+	m.statusRunning = false
+	if msg.Command != config.StatusCommand {
+		return false
+	}
+	text := msg.Output
+	if msg.Err != nil {
+		m.LogWarn("status_command failed: %v", msg.Err)
+		if text == "" {
+			text = "status: " + msg.Err.Error()
+		}
+	}
+	text = formatStatusText(text)
+	if text == m.StatusText {
+		return false
+	}
+	m.StatusText = text
+	return true
+}
+
+// rerunStatusCommand drops the cached output so the next tick runs the
+// (possibly changed) command straight away.
+func (m *OS) rerunStatusCommand() {
+	m.StatusText = ""
+	m.statusLastRun = time.Time{}
+}
+
+// runShellCommand runs command with sh -c, killing it after timeout, and
+// returns its stdout, or its stdout and stderr together when combined is set.
+// It runs the user's status, startup and shutdown commands.
+func runShellCommand(command string, timeout time.Duration, combined bool) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "sh", "-c", command) //nolint:gosec // the user's own configured command
+	// A child the shell started can outlive it and hold stdout open; stop
+	// waiting for it shortly after the shell is killed.
+	cmd.WaitDelay = 100 * time.Millisecond
+	var out []byte
+	var err error
+	if combined {
+		out, err = cmd.CombinedOutput()
+	} else {
+		out, err = cmd.Output()
+	}
+	if ctx.Err() != nil {
+		err = ctx.Err()
+	}
+	return string(out), err
+}
+
+// formatStatusText keeps the first line of a command's output, without
+// escape sequences or surrounding space, cut to config.MaxStatusWidth.
+func formatStatusText(out string) string {
+	line, _, _ := strings.Cut(out, "\n")
+	line = strings.TrimSpace(ansi.Strip(line))
+	return ansi.Truncate(line, config.MaxStatusWidth, "…")
+}
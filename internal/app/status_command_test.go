@@ -0,0 +1,51 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// TestStatusCommand checks that the status command runs at most once per
+// interval and never twice at once, that only the first line of its output
+// reaches the dock, cut to MaxStatusWidth, and that a hung command is killed.
+func TestStatusCommand(t *testing.T) {
+	prevCmd, prevInterval := config.StatusCommand, config.StatusInterval
+	defer func() { config.StatusCommand, config.StatusInterval = prevCmd, prevInterval }()
+	config.StatusCommand = "printf 'up 3 days\\nsecond line'"
+	config.StatusInterval = time.Hour
+
+	m := &OS{}
+	cmd := m.pollStatusCommand()
+	if cmd == nil {
+		t.Fatal("status command not started on the first tick")
+	}
+	if m.pollStatusCommand() != nil {
+		t.Fatal("status command started again while a run was in flight")
+	}
+
+	msg, ok := cmd().(StatusCommandMsg)
+	if !ok {
+		t.Fatalf("command produced %T, want StatusCommandMsg", cmd())
+	}
+	if !m.applyStatusCommand(msg) || m.StatusText != "up 3 days" {
+		t.Errorf("StatusText = %q, want the first line %q", m.StatusText, "up 3 days")
+	}
+	if m.pollStatusCommand() != nil {
+		t.Error("status command rerun before its interval passed")
+	}
+
+	if got := formatStatusText(strings.Repeat("x", 100)); len([]rune(got)) != config.MaxStatusWidth {
+		t.Errorf("long output cut to %d columns, want %d", len([]rune(got)), config.MaxStatusWidth)
+	}
+
+	start := time.Now()
+	if _, err := runShellCommand("sleep 5", 50*time.Millisecond, false); err == nil {
+		t.Error("hung command reported no error")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("hung command ran for %v despite the timeout", elapsed)
+	}
+}
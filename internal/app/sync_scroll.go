@@ -0,0 +1,131 @@
+package app
+
+import (
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// ToggleSyncScroll turns synchronized scrolling (review mode) on or off. While
+// it is on, every scroll of the focused window, by wheel or by copy-mode
+// motion, moves every other visible window on the workspace back or forward
+// by the same number of lines, so parallel logs can be compared in lockstep.
+// Turning it off returns the other windows to their live output.
+func (m *OS) ToggleSyncScroll() {
+	m.SyncScroll = !m.SyncScroll
+	if m.SyncScroll {
+		m.ShowNotification("Synchronized scroll: on", "info", config.NotificationDuration)
+		return
+	}
+	for i, w := range m.Windows {
+		if i != m.FocusedWindow && w.ScrollbackMode {
+			w.ExitScrollbackMode()
+		}
+	}
+	m.ShowNotification("Synchronized scroll: off", "info", config.NotificationDuration)
+}
+
+// LinkScroll links the scrollback of two windows, so scrolling either one
+// scrolls the other by the same number of lines. It is synchronized scroll
+// for just that pair. A window has at most one partner: linking it again
+// replaces the old link. It reports false when the IDs are the same or either
+// window does not exist.
+func (m *OS) LinkScroll(idA, idB string) bool {
+	if idA == idB || m.windowWithID(idA) == nil || m.windowWithID(idB) == nil {
+		return false
+	}
+	m.UnlinkScroll(idA)
+	m.UnlinkScroll(idB)
+	if m.ScrollLinks == nil {
+		m.ScrollLinks = make(map[string]string)
+	}
+	m.ScrollLinks[idA] = idB
+	m.ScrollLinks[idB] = idA
+	return true
+}
+
+// UnlinkScroll removes the scroll link of window id, returning its partner to
+// live output. It reports false when the window was not linked.
+func (m *OS) UnlinkScroll(id string) bool {
+	partner, ok := m.ScrollLinks[id]
+	if !ok {
+		return false
+	}
+	delete(m.ScrollLinks, id)
+	delete(m.ScrollLinks, partner)
+	if len(m.ScrollLinks) == 0 {
+		m.ScrollLinks = nil
+	}
+	if w := m.windowWithID(partner); w != nil && w.ScrollbackMode {
+		w.ExitScrollbackMode()
+	}
+	return true
+}
+
+// LinkScrollWithLastWindow links the focused window's scrollback with the
+// window focused before it, the one last_window returns to: focus one log,
+// then the other, then link them.
+func (m *OS) LinkScrollWithLastWindow() {
+	focused := m.GetFocusedWindow()
+	lastID, ok := m.WorkspaceLastFocus[m.CurrentWorkspace]
+	if focused == nil || !ok || !m.LinkScroll(focused.ID, lastID) {
+		m.ShowNotification("Focus two windows in turn to link their scroll", "info", config.NotificationDuration)
+		return
+	}
+	m.ShowNotification("Scroll linked with "+m.windowWithID(lastID).Title(), "info", config.NotificationDuration)
+}
+
+// UnlinkFocusedScroll removes the focused window's scroll link.
+func (m *OS) UnlinkFocusedScroll() {
+	focused := m.GetFocusedWindow()
+	if focused == nil || !m.UnlinkScroll(focused.ID) {
+		m.ShowNotification("This window's scroll is not linked", "info", config.NotificationDuration)
+		return
+	}
+	m.ShowNotification("Scroll unlinked", "info", config.NotificationDuration)
+}
+
+// SyncScrollFrom applies a scroll of delta lines on src (positive is back
+// into history) to the windows that follow it: in review mode every other
+// visible window on the current workspace, otherwise src's linked partner if
+// it has one.
+func (m *OS) SyncScrollFrom(src *terminal.Window, delta int) {
+	if delta == 0 {
+		return
+	}
+	if !m.SyncScroll {
+		if partner := m.windowWithID(m.ScrollLinks[src.ID]); partner != nil && !partner.Closing {
+			scrollAlong(partner, delta)
+		}
+		return
+	}
+	for _, w := range m.Windows {
+		if w == src || w.Workspace != m.CurrentWorkspace || w.Minimized || w.Minimizing || w.Closing {
+			continue
+		}
+		scrollAlong(w, delta)
+	}
+}
+
+// scrollAlong scrolls w by delta lines to follow another window. A window in
+// copy mode or on the alternate screen drives its own view and is left alone.
+// The wheel moves ScrollbackOffset without entering scrollback mode, so the
+// new offset is worked out from the current one rather than through
+// ScrollUp and ScrollDown, which would start over from the bottom or do
+// nothing.
+func scrollAlong(w *terminal.Window, delta int) {
+	if w.Terminal == nil || w.IsAltScreen() || (w.CopyMode != nil && w.CopyMode.Active) {
+		return
+	}
+	offset := max(0, min(w.ScrollbackOffset+delta, w.ScrollbackLen()))
+	if offset == 0 {
+		if w.ScrollbackMode || w.ScrollbackOffset != 0 {
+			w.ExitScrollbackMode()
+		}
+		return
+	}
+	if !w.ScrollbackMode {
+		w.EnterScrollbackMode()
+	}
+	w.ScrollbackOffset = offset
+	w.InvalidateCache()
+}
@@ -0,0 +1,74 @@
+package app
+
+import (
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/tape"
+)
+
+// tapeRunStart is what the screen looked like when a played tape started, so
+// every further run of a looping tape (--loop, tape_loop) starts from the same
+// place instead of piling its windows on top of the previous run's.
+type tapeRunStart struct {
+	run         int             // Run the player was on when last seen
+	windows     map[string]bool // Windows that existed before the tape ran
+	workspace   int
+	autoTiling  bool
+	masterRatio float64
+}
+
+// trackTapeRun records the starting state the first time it sees player and,
+// when the player has since moved on to another run, puts that state back
+// before the new run's first command plays.
+func (m *OS) trackTapeRun(player *tape.Player) {
+	start := m.tapeRunStart
+	if start == nil {
+		start = &tapeRunStart{
+			run:         player.Run(),
+			windows:     make(map[string]bool, len(m.Windows)),
+			workspace:   m.CurrentWorkspace,
+			autoTiling:  m.AutoTiling,
+			masterRatio: m.MasterRatio,
+		}
+		for _, w := range m.Windows {
+			start.windows[w.ID] = true
+		}
+		m.tapeRunStart = start
+		return
+	}
+	if player.Run() == start.run {
+		return
+	}
+	start.run = player.Run()
+	m.restoreTapeRunStart()
+}
+
+// restoreTapeRunStart closes the windows the tape opened and goes back to the
+// workspace and tiling it started with.
+func (m *OS) restoreTapeRunStart() {
+	start := m.tapeRunStart
+	for i := len(m.Windows) - 1; i >= 0; i-- {
+		if !start.windows[m.Windows[i].ID] {
+			m.DeleteWindow(i)
+		}
+	}
+	if m.CurrentWorkspace != start.workspace {
+		m.SwitchToWorkspace(start.workspace)
+	}
+	m.MasterRatio = start.masterRatio
+	m.AutoTiling = start.autoTiling
+	if m.AutoTiling {
+		m.TileAllWindows()
+	}
+	m.MarkAllDirty()
+}
+
+// loopingTapePlayer returns the local tape player when config.TapeLoop should
+// start it again once it finishes. A player given its own run count with
+// --loop keeps to it: tape_loop only loops a tape played once.
+func (m *OS) loopingTapePlayer() (*tape.Player, bool) {
+	player, ok := m.ScriptPlayer.(*tape.Player)
+	if !ok || !config.TapeLoop || player.Runs() != 1 {
+		return nil, false
+	}
+	return player, true
+}
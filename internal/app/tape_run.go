@@ -178,6 +178,7 @@ func (m *OS) startTapePlayback(commands []tape.Command, workspace int) {
 	m.ScriptMode = true
 	m.ScriptPaused = false
 	m.ScriptFinishedTime = time.Time{}
+	m.tapeRunStart = nil
 	m.ScriptExecutor = tape.NewCommandExecutor(m)
 }
 
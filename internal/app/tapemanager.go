@@ -386,6 +386,7 @@ func (m *OS) TapeManagerPlaySelected() {
 	m.ScriptMode = true
 	m.ScriptPaused = false
 	m.ScriptFinishedTime = time.Time{}
+	m.tapeRunStart = nil
 
 	// Create executor
 	m.ScriptExecutor = tape.NewCommandExecutor(m)
@@ -162,9 +162,9 @@ func (m *OS) RefreshTextSizing() {
 		if w.Terminal != nil {
 			scrollbackLen = w.Terminal.ScrollbackLen()
 		}
-		borderOff := w.BorderOffset()
-		contentHeight := w.Height - 2*borderOff
-		contentWidth := w.Width - 2*borderOff
+		offX, offY := w.ContentOffsetX(), w.ContentOffsetY()
+		contentHeight := w.ContentHeight()
+		contentWidth := w.ContentWidth()
 
 		viewportTop := scrollbackLen - w.ScrollbackOffset
 		viewportBottom := viewportTop + contentHeight
@@ -195,16 +195,16 @@ func (m *OS) RefreshTextSizing() {
 
 		for _, p := range kept {
 			visible := p.AbsLine >= viewportTop && p.AbsLine < viewportBottom
-			hostX := w.X + borderOff + p.GuestX
-			hostY := w.Y + borderOff + (p.AbsLine - viewportTop)
+			hostX := w.X + offX + p.GuestX
+			hostY := w.Y + offY + (p.AbsLine - viewportTop)
 			scaledWidth := p.TextLen * p.Scale
 			eraseCols := min(scaledWidth, 120)
 
 			// Clip: must fit entirely within window content area AND screen
 			if visible {
-				if hostY < w.Y+borderOff || hostY+p.Scale > w.Y+w.Height-borderOff {
+				if hostY < w.Y+offY || hostY+p.Scale > w.Y+offY+contentHeight {
 					visible = false
-				} else if hostX+scaledWidth > w.X+borderOff+contentWidth {
+				} else if hostX+scaledWidth > w.X+offX+contentWidth {
 					visible = false
 				} else if hostY < 0 || hostX < 0 || hostY+p.Scale > screenHeight || hostX+scaledWidth > screenWidth {
 					visible = false
@@ -224,7 +224,7 @@ func (m *OS) RefreshTextSizing() {
 				eraseAt(&m.TextSizingState.pendingOutput, p.PlacedAtX, p.PlacedAtY, eraseCols, p.Scale)
 			}
 
-			contentEndX := w.X + borderOff + contentWidth
+			contentEndX := w.X + offX + contentWidth
 			emitOSC66(&m.TextSizingState.pendingOutput, hostX, hostY, p.Scale, scaledWidth, contentEndX, p.RawOSC)
 			p.PlacedAtX = hostX
 			p.PlacedAtY = hostY
@@ -0,0 +1,91 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+
+	"charm.land/lipgloss/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/theme"
+)
+
+// currentThemeName returns the active theme id, or the "none" sentinel when
+// theming is off.
+func currentThemeName() string {
+	if id := theme.CurrentThemeID(); id != "" {
+		return id
+	}
+	return themeNone
+}
+
+// CycleTheme previews the next (delta > 0) or previous (delta < 0) theme,
+// wrapping around the bundled list. The first step remembers the theme that
+// was active so CancelThemeCycle can put it back; nothing is written to the
+// config until ConfirmThemeCycle.
+func (m *OS) CycleTheme(delta int) {
+	theme.EnsureRegistry()
+	current := currentThemeName()
+	if !m.ThemeCycleActive {
+		m.ThemeCycleActive = true
+		m.ThemeCycleOriginal = current
+	}
+
+	items := append([]string{themeNone}, theme.AvailableThemes()...)
+	idx := max(slices.Index(items, current), 0)
+	idx = ((idx+delta)%len(items) + len(items)) % len(items)
+	m.applyTheme(items[idx])
+}
+
+// ConfirmThemeCycle keeps the previewed theme and persists it when it differs
+// from the one active before cycling started.
+func (m *OS) ConfirmThemeCycle() {
+	if !m.ThemeCycleActive {
+		return
+	}
+	if current := currentThemeName(); current != m.ThemeCycleOriginal {
+		m.persistThemeSelection(current)
+	}
+	m.ThemeCycleActive = false
+	m.ThemeCycleOriginal = ""
+	m.MarkAllDirty()
+}
+
+// CancelThemeCycle restores the theme that was active before cycling started.
+func (m *OS) CancelThemeCycle() {
+	if !m.ThemeCycleActive {
+		return
+	}
+	m.applyTheme(m.ThemeCycleOriginal)
+	m.ThemeCycleActive = false
+	m.ThemeCycleOriginal = ""
+}
+
+// renderThemeCycle draws the banner naming the previewed theme, centered above
+// the dock, with its position in the list and the keys that drive cycling.
+func (m *OS) renderThemeCycle() *lipgloss.Layer {
+	if !m.ThemeCycleActive {
+		return nil
+	}
+
+	items := append([]string{themeNone}, theme.AvailableThemes()...)
+	current := currentThemeName()
+	pos := slices.Index(items, current) + 1
+
+	ui := theme.UI()
+	name := lipgloss.NewStyle().Foreground(ui.AccentBright).Background(ui.Card).Bold(true).Render(current)
+	hint := lipgloss.NewStyle().Foreground(ui.FgDim).Background(ui.Card).
+		Render("←/→ cycle · enter keep · esc revert")
+	banner := lipgloss.NewStyle().
+		Foreground(ui.Fg).
+		Background(ui.Card).
+		Padding(0, 2).
+		Render(fmt.Sprintf("Theme %d/%d  %s\n%s", pos, len(items), name, hint))
+
+	x := (m.GetRenderWidth() - lipgloss.Width(banner)) / 2
+	y := m.GetRenderHeight() - config.DockHeight - lipgloss.Height(banner) - 1
+	return lipgloss.NewLayer(banner).
+		X(max(x, 0)).
+		Y(max(y, 0)).
+		Z(config.ZIndexThemeCycle).
+		ID("theme-cycle")
+}
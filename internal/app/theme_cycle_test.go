@@ -0,0 +1,49 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/theme"
+)
+
+// TestThemeCycleWrapsAndReverts checks that cycling steps through the theme
+// list in both directions, wrapping at the ends, and that cancelling puts back
+// the theme that was active before the first step.
+func TestThemeCycleWrapsAndReverts(t *testing.T) {
+	m := &OS{}
+	m.applyTheme(themeNone)
+	defer m.applyTheme(themeNone)
+
+	themes := theme.AvailableThemes()
+	if len(themes) == 0 {
+		t.Skip("no bundled themes")
+	}
+
+	m.CycleTheme(1)
+	if !m.ThemeCycleActive || m.ThemeCycleOriginal != themeNone {
+		t.Fatalf("cycling not started (active=%v, original=%q)", m.ThemeCycleActive, m.ThemeCycleOriginal)
+	}
+	if got := currentThemeName(); got != themes[0] {
+		t.Errorf("next after none = %q, want %q", got, themes[0])
+	}
+
+	m.CycleTheme(-1)
+	m.CycleTheme(-1)
+	if got := currentThemeName(); got != themes[len(themes)-1] {
+		t.Errorf("previous before none = %q, want the last theme %q", got, themes[len(themes)-1])
+	}
+	if m.ThemeCycleOriginal != themeNone {
+		t.Errorf("original theme overwritten mid-cycle: %q", m.ThemeCycleOriginal)
+	}
+	if m.renderThemeCycle() == nil {
+		t.Error("no banner while cycling")
+	}
+
+	m.CancelThemeCycle()
+	if m.ThemeCycleActive || currentThemeName() != themeNone {
+		t.Errorf("cancel left active=%v theme=%q, want inactive and %q", m.ThemeCycleActive, currentThemeName(), themeNone)
+	}
+	if m.renderThemeCycle() != nil {
+		t.Error("banner still shown after cancel")
+	}
+}
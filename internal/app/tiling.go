@@ -8,7 +8,8 @@ import (
 
 // Tiling constants
 const (
-	// edgeTolerance is the pixel tolerance for detecting window edges at screen boundaries
+	// edgeTolerance is the pixel tolerance for detecting window edges at screen
+	// boundaries, on top of the tiling gap a tiled window keeps from them
 	edgeTolerance = 2
 	// swapTolerance is the pixel tolerance for detecting adjacent windows during swap operations
 	swapTolerance = 5
@@ -31,7 +32,7 @@ type tileLayout struct {
 
 // calculateTilingLayout is a wrapper around layout.CalculateTilingLayout for internal use
 func (m *OS) calculateTilingLayout(n int) []tileLayout {
-	layouts := layout.CalculateTilingLayout(n, m.GetRenderWidth(), m.GetUsableHeight(), m.GetTopMargin(), m.MasterRatio)
+	layouts := layout.CalculateTilingLayout(n, m.GetRenderWidth(), m.GetUsableHeight(), m.GetTopMargin(), m.MasterRatio, tilingGap())
 	result := make([]tileLayout, len(layouts))
 	for i, l := range layouts {
 		result[i] = tileLayout{
@@ -71,7 +72,13 @@ func (m *OS) TileAllWindows() {
 
 	// Use master-stack layout if BSP is disabled
 	if !m.UseBSPLayout {
-		layouts := layout.CalculateTilingLayout(len(visibleWindows), m.GetRenderWidth(), m.GetUsableHeight(), m.GetTopMargin(), m.MasterRatio)
+		layouts := layout.CalculateTilingLayout(len(visibleWindows), m.GetRenderWidth(), m.GetUsableHeight(), m.GetTopMargin(), m.MasterRatio, tilingGap())
+		if m.MonocleActive() {
+			bounds := m.GetBSPBounds()
+			for i := range layouts {
+				layouts[i] = layout.TileLayout{X: bounds.X, Y: bounds.Y, Width: bounds.W, Height: bounds.H}
+			}
+		}
 		for i, l := range layouts {
 			if i < len(visibleWindows) {
 				visibleWindows[i].X = l.X
@@ -130,7 +137,7 @@ func (m *OS) TileAllWindows() {
 
 		for i, win := range visibleWindows {
 			windowIntID := m.getWindowIntID(win.ID)
-			tree.InsertWindow(windowIntID, lastInsertedID, layout.SplitNone, 0.5, bounds)
+			tree.InsertWindow(windowIntID, lastInsertedID, layout.SplitNone, 1-config.DefaultSplitRatio, bounds)
 			lastInsertedID = windowIntID
 			m.LogInfo("BSP: Added window %d (int ID %d) with target %d", i+1, windowIntID, lastInsertedID)
 		}
@@ -167,13 +174,116 @@ func (m *OS) TileAllWindows() {
 			}
 
 			bounds := m.GetBSPBounds()
-			tree.InsertWindow(windowIntID, targetIntID, layout.SplitNone, 0.5, bounds)
+			tree.InsertWindow(windowIntID, targetIntID, layout.SplitNone, 1-config.DefaultSplitRatio, bounds)
 			m.LogInfo("BSP: Added missing window (int ID %d) with target %d", windowIntID, targetIntID)
 		}
 	}
 	m.ApplyBSPLayout()
 }
 
+// RetileNow throws away the current workspace's layout state and tiles its
+// windows again from scratch, in the order they were opened. It is the manual
+// escape hatch for a layout that has drifted out of step with the windows:
+// custom split ratios and preselections are lost, which is the point. It
+// reports false when tiling is off, since there is no layout to rebuild.
+func (m *OS) RetileNow() bool {
+	if !m.AutoTiling {
+		return false
+	}
+
+	if m.UseScrollingLayout {
+		delete(m.WorkspaceScrollingLayouts, m.CurrentWorkspace)
+	} else {
+		delete(m.WorkspaceTrees, m.CurrentWorkspace)
+	}
+	m.PreselectionDir = layout.PreselectionNone
+	// A resize since the last retile marked the workspace custom, which stops
+	// workspace switches from retiling it; the rebuilt layout is not custom.
+	delete(m.WorkspaceLayouts, m.CurrentWorkspace)
+	if m.WorkspaceHasCustom != nil {
+		m.WorkspaceHasCustom[m.CurrentWorkspace] = false
+	}
+
+	m.TileAllWindows()
+	for _, w := range m.Windows {
+		if w.Workspace == m.CurrentWorkspace {
+			w.InvalidateCache()
+		}
+	}
+	m.MarkAllDirty()
+	m.FireLayoutChanged()
+	return true
+}
+
+// ApplyLayoutPreset arranges the current workspace's visible windows by one
+// of the layout presets (layout.PresetColumns, PresetRows or PresetGrid), in
+// the order they were opened. With BSP tiling on, the workspace tree is
+// rebuilt to match so the arrangement survives later retiles and its splits
+// can be dragged like any other; otherwise the windows are simply moved. It
+// reports false for an unknown preset, a workspace with nothing to arrange,
+// or the scrolling layout, whose columns have no use for it.
+func (m *OS) ApplyLayoutPreset(preset string) bool {
+	if m.AutoTiling && m.UseScrollingLayout {
+		return false
+	}
+
+	var visibleWindows []*terminal.Window
+	for _, w := range m.Windows {
+		if w.Workspace != m.CurrentWorkspace || w.Minimized || w.Minimizing {
+			continue
+		}
+		// Floating windows stay where they are while tiling owns the rest.
+		if m.AutoTiling && w.IsFloating {
+			continue
+		}
+		visibleWindows = append(visibleWindows, w)
+	}
+	if len(visibleWindows) == 0 {
+		return false
+	}
+
+	if m.AutoTiling && m.UseBSPLayout {
+		ids := make([]int, len(visibleWindows))
+		for i, w := range visibleWindows {
+			ids[i] = m.getWindowIntID(w.ID)
+		}
+		if !m.GetOrCreateBSPTree().ArrangePreset(preset, ids) {
+			return false
+		}
+		m.PreselectionDir = layout.PreselectionNone
+		delete(m.WorkspaceLayouts, m.CurrentWorkspace)
+		if m.WorkspaceHasCustom != nil {
+			m.WorkspaceHasCustom[m.CurrentWorkspace] = false
+		}
+		m.ApplyBSPLayout()
+	} else {
+		layouts := layout.CalculatePresetLayout(preset, len(visibleWindows), m.GetRenderWidth(), m.GetUsableHeight(), m.GetTopMargin())
+		if layouts == nil {
+			return false
+		}
+		for i, l := range layouts {
+			w := visibleWindows[i]
+			w.X = l.X
+			w.Y = l.Y
+			w.Resize(l.Width, l.Height)
+		}
+	}
+
+	for _, w := range visibleWindows {
+		w.InvalidateCache()
+	}
+	m.MarkAllDirty()
+	m.FireLayoutChanged()
+	return true
+}
+
+// ResetToGrid arranges the current workspace's visible windows in equal
+// cells, as square as their count allows. It is the grid preset under the
+// name people reach for when they want to see everything at once.
+func (m *OS) ResetToGrid() bool {
+	return m.ApplyLayoutPreset(layout.PresetGrid)
+}
+
 // ToggleAutoTiling toggles automatic tiling mode
 func (m *OS) ToggleAutoTiling() {
 	m.AutoTiling = !m.AutoTiling
@@ -220,7 +330,7 @@ func (m *OS) ToggleAutoTiling() {
 
 		for i, win := range visibleWindows {
 			windowIntID := m.getWindowIntID(win.ID)
-			tree.InsertWindow(windowIntID, lastInsertedID, layout.SplitNone, 0.5, bounds)
+			tree.InsertWindow(windowIntID, lastInsertedID, layout.SplitNone, 1-config.DefaultSplitRatio, bounds)
 			lastInsertedID = windowIntID
 			m.LogInfo("BSP: Added window %d (int ID %d) with target %d, split count now: %d",
 				i+1, windowIntID, lastInsertedID, tree.WindowCount())
@@ -233,6 +343,7 @@ func (m *OS) ToggleAutoTiling() {
 		m.LogInfo("BSP: Tiling enabled with %d windows", len(visibleWindows))
 	} else {
 		m.LogInfo("BSP: Disabling tiling mode")
+		m.WorkspaceMonocle = nil
 		// Clear preselection when disabling tiling
 		m.PreselectionDir = layout.PreselectionNone
 		// Reset Tiled flag and resize PTY to account for borders reappearing
@@ -307,7 +418,7 @@ func (m *OS) RestoreWorkspaceLayout(workspace int) {
 	if ratio, exists := m.WorkspaceMasterRatio[workspace]; exists {
 		m.MasterRatio = ratio
 	} else {
-		m.MasterRatio = 0.5 // Default
+		m.MasterRatio = config.ClampMasterRatio(0.5) // Default, within the configured range
 	}
 
 	// Check if we have a saved layout for this workspace
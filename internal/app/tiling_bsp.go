@@ -1,6 +1,10 @@
 package app
 
 import (
+	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/layout"
 	"github.com/Gaurav-Gosain/tuios/internal/terminal"
@@ -20,11 +24,10 @@ func (m *OS) GetOrCreateBSPTree() *layout.BSPTree {
 	tree, exists := m.WorkspaceTrees[m.CurrentWorkspace]
 	if !exists || tree == nil {
 		tree = layout.NewBSPTree()
-		// Use SchemeSpiral as default if TilingScheme not set
 		if m.TilingScheme == layout.SchemeLongestSide {
-			// SchemeLongestSide is the zero value, which means it wasn't explicitly set
-			// Default to SchemeSpiral for balanced alternating splits
-			tree.AutoScheme = layout.SchemeSpiral
+			// SchemeLongestSide is the zero value, which means it wasn't explicitly
+			// set, so the configured scheme (spiral unless changed) applies.
+			tree.AutoScheme = layout.ParseAutoScheme(config.TilingScheme)
 		} else {
 			tree.AutoScheme = m.TilingScheme
 		}
@@ -35,14 +38,58 @@ func (m *OS) GetOrCreateBSPTree() *layout.BSPTree {
 	return tree
 }
 
-// GetBSPBounds returns the bounds for BSP layout calculation
+// applyTilingScheme switches every existing workspace tree to the configured
+// scheme, so a change in settings affects the next window everywhere rather
+// than only on workspaces that have not tiled yet.
+func (m *OS) applyTilingScheme() {
+	scheme := layout.ParseAutoScheme(config.TilingScheme)
+	for _, tree := range m.WorkspaceTrees {
+		if tree != nil {
+			tree.AutoScheme = scheme
+		}
+	}
+}
+
+// GetBSPBounds returns the bounds for BSP layout calculation. The tiling gap
+// is kept clear around the edges too, unless that would leave no room.
 func (m *OS) GetBSPBounds() layout.Rect {
-	return layout.Rect{
+	bounds := layout.Rect{
 		X: 0,
 		Y: m.GetTopMargin(),
 		W: m.GetRenderWidth(),
 		H: m.GetUsableHeight(),
 	}
+	if gap := tilingGap(); gap > 0 && bounds.W > 2*gap && bounds.H > 2*gap {
+		bounds.X += gap
+		bounds.Y += gap
+		bounds.W -= 2 * gap
+		bounds.H -= 2 * gap
+	}
+	return bounds
+}
+
+// tilingGap is the gap tiled windows keep between and around them. Shared
+// borders draw a one-cell separator of their own and ignore the setting.
+func tilingGap() int {
+	if config.SharedBorders {
+		return 0
+	}
+	return config.TilingGap
+}
+
+// AdjustTilingGap changes the gap between tiled windows by delta for this
+// session, retiles and shows the new gap. It reports false when the gap is
+// already at its limit in that direction.
+func (m *OS) AdjustTilingGap(delta int) bool {
+	gap := max(0, min(config.TilingGap+delta, config.MaxTilingGap))
+	if gap == config.TilingGap {
+		m.ShowNotification(fmt.Sprintf("Tiling gap is already %d", gap), "info", config.NotificationDuration)
+		return false
+	}
+	config.TilingGap = gap
+	m.applyAppearanceLive(true)
+	m.ShowNotification(fmt.Sprintf("Tiling gap: %d", gap), "info", config.NotificationDuration)
+	return true
 }
 
 // getWindowIntID returns a stable integer ID for a window string ID.
@@ -119,6 +166,13 @@ func (m *OS) ApplyBSPLayout() {
 
 	bounds := m.GetBSPBounds()
 	layouts := tree.ApplyLayout(bounds)
+	if m.MonocleActive() {
+		// Every window takes the whole area; the tree keeps its splits for
+		// when monocle is turned off.
+		for id := range layouts {
+			layouts[id] = bounds
+		}
+	}
 
 	for windowIntID, rect := range layouts {
 		win := m.getWindowByIntID(windowIntID)
@@ -241,13 +295,19 @@ func (m *OS) AddWindowToBSPTree(window *terminal.Window) {
 
 	bounds := m.GetBSPBounds()
 
+	// The tree's ratio is the share the split window keeps.
+	share := m.SplitShare
+	if share <= 0 {
+		share = config.DefaultSplitRatio
+	}
+
 	// Check for preselection
 	if m.PreselectionDir != layout.PreselectionNone {
 		m.LogInfo("BSP: Inserting with preselection %d", m.PreselectionDir)
-		tree.InsertWindowWithPreselection(windowIntID, targetIntID, m.PreselectionDir, bounds)
+		tree.InsertWindowWithPreselection(windowIntID, targetIntID, m.PreselectionDir, 1-share, bounds)
 		m.PreselectionDir = layout.PreselectionNone // Clear preselection
 	} else {
-		tree.InsertWindow(windowIntID, targetIntID, layout.SplitNone, 0.5, bounds)
+		tree.InsertWindow(windowIntID, targetIntID, layout.SplitNone, 1-share, bounds)
 	}
 
 	m.LogInfo("BSP: Tree now has %d windows", tree.WindowCount())
@@ -345,6 +405,7 @@ func (m *OS) SplitFocusedHorizontal() {
 		return
 	}
 
+	share := m.takeSplitCount()
 	focusedWin := m.GetFocusedWindow()
 	if focusedWin == nil {
 		return
@@ -357,10 +418,12 @@ func (m *OS) SplitFocusedHorizontal() {
 	m.PreselectionDir = layout.PreselectionDown
 
 	// Create a new window - it will be added with the preselection
+	m.SplitShare = share
 	m.AddWindow("")
 
 	// Clear the split target
 	m.SplitTargetWindowID = ""
+	m.SplitShare = 0
 }
 
 // SplitFocusedVertical splits the focused window vertically (left/right) and creates a new terminal
@@ -369,6 +432,7 @@ func (m *OS) SplitFocusedVertical() {
 		return
 	}
 
+	share := m.takeSplitCount()
 	focusedWin := m.GetFocusedWindow()
 	if focusedWin == nil {
 		return
@@ -381,10 +445,58 @@ func (m *OS) SplitFocusedVertical() {
 	m.PreselectionDir = layout.PreselectionRight
 
 	// Create a new window - it will be added with the preselection
+	m.SplitShare = share
 	m.AddWindow("")
 
 	// Clear the split target
 	m.SplitTargetWindowID = ""
+	m.SplitShare = 0
+}
+
+// NoteSplitCountDigit records a digit typed in window mode as part of a
+// possible split count, so "30" followed by a split key gives the new pane 30%.
+// The first digit of a count remembers which window was focused, because the
+// digits go on to select windows as usual.
+func (m *OS) NoteSplitCountDigit(digit string) {
+	if m.SplitCount == "" || time.Since(m.SplitCountAt) > config.SplitCountWindow {
+		m.ClearSplitCount()
+		if focused := m.GetFocusedWindow(); focused != nil {
+			m.SplitCountOrigin = focused.ID
+		}
+	}
+	if len(m.SplitCount) < 3 {
+		m.SplitCount += digit
+	}
+	m.SplitCountAt = time.Now()
+}
+
+// ClearSplitCount drops any pending split count.
+func (m *OS) ClearSplitCount() {
+	m.SplitCount = ""
+	m.SplitCountOrigin = ""
+}
+
+// takeSplitCount consumes the pending split count and returns the share of the
+// split pane the new window gets. Only a two-digit percentage typed within
+// config.SplitCountWindow counts; it is clamped like the configured ratio, and
+// focus returns to the window that was focused before the digits selected
+// other windows, so that is the window split. Without a count the configured
+// config.DefaultSplitRatio applies.
+func (m *OS) takeSplitCount() float64 {
+	count, origin, at := m.SplitCount, m.SplitCountOrigin, m.SplitCountAt
+	m.ClearSplitCount()
+
+	percent, err := strconv.Atoi(count)
+	if err != nil || len(count) != 2 || time.Since(at) > config.SplitCountWindow {
+		return config.DefaultSplitRatio
+	}
+	for i, w := range m.Windows {
+		if w.ID == origin && w.Workspace == m.CurrentWorkspace {
+			m.FocusWindow(i)
+			break
+		}
+	}
+	return min(max(float64(percent)/100, config.MinSplitRatio), config.MaxSplitRatio)
 }
 
 // SmartSplitFocused splits the focused window using the smart split algorithm:
@@ -394,6 +506,7 @@ func (m *OS) SmartSplitFocused() {
 		return
 	}
 
+	share := m.takeSplitCount()
 	focusedWin := m.GetFocusedWindow()
 	if focusedWin == nil {
 		return
@@ -406,10 +519,12 @@ func (m *OS) SmartSplitFocused() {
 	m.PreselectionDir = layout.PreselectionNone
 
 	// Create a new window  - AddWindowToBSPTree will use SplitNone which triggers auto split
+	m.SplitShare = share
 	m.AddWindow("")
 
 	// Clear the split target
 	m.SplitTargetWindowID = ""
+	m.SplitShare = 0
 }
 
 // SetPreselection sets the preselection direction for the next window insertion
@@ -500,6 +615,53 @@ func (m *OS) EqualizeSplits() {
 	m.ApplyBSPLayout()
 }
 
+// EqualizeFocusedSiblings resets the split ratios only within the focused
+// window's parent split, so the focused window and its siblings share their
+// space evenly while the rest of the layout keeps its proportions. It returns
+// false when there is nothing to equalize.
+func (m *OS) EqualizeFocusedSiblings() bool {
+	if !m.AutoTiling {
+		return false
+	}
+	tree := m.WorkspaceTrees[m.CurrentWorkspace]
+	focused := m.GetFocusedWindow()
+	if tree == nil || focused == nil {
+		return false
+	}
+	if !tree.EqualizeSiblings(m.getWindowIntID(focused.ID)) {
+		return false
+	}
+	m.ApplyBSPLayout()
+	return true
+}
+
+// FlipLayoutHorizontal mirrors the current workspace's BSP layout left to
+// right, so a sidebar on the left moves to the right with its size intact.
+// Focus stays on the same window. It reports false when BSP tiling is not in
+// use or there is nothing to flip.
+func (m *OS) FlipLayoutHorizontal() bool {
+	return m.flipLayout((*layout.BSPTree).FlipHorizontal)
+}
+
+// FlipLayoutVertical mirrors the current workspace's BSP layout top to bottom.
+func (m *OS) FlipLayoutVertical() bool {
+	return m.flipLayout((*layout.BSPTree).FlipVertical)
+}
+
+func (m *OS) flipLayout(flip func(*layout.BSPTree)) bool {
+	if !m.AutoTiling || !m.UseBSPLayout || m.UseScrollingLayout {
+		return false
+	}
+	tree := m.WorkspaceTrees[m.CurrentWorkspace]
+	if tree == nil || tree.WindowCount() < 2 {
+		return false
+	}
+	flip(tree)
+	m.ApplyBSPLayout()
+	m.MarkAllDirty()
+	return true
+}
+
 // SwapWindowsInBSPTree swaps two windows in the BSP tree
 func (m *OS) SwapWindowsInBSPTree(window1, window2 *terminal.Window) {
 	tree := m.WorkspaceTrees[m.CurrentWorkspace]
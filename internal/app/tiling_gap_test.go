@@ -0,0 +1,114 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestTilingGap checks the gap is kept around and between BSP-tiled windows,
+// that changing it live retiles, and that it stops at its limits.
+func TestTilingGap(t *testing.T) {
+	prevAnim, prevGap, prevShared := config.AnimationsEnabled, config.TilingGap, config.SharedBorders
+	config.AnimationsEnabled, config.TilingGap, config.SharedBorders = false, 1, false
+	defer func() {
+		config.AnimationsEnabled, config.TilingGap, config.SharedBorders = prevAnim, prevGap, prevShared
+	}()
+
+	m := &OS{
+		CurrentWorkspace: 1,
+		WorkspaceFocus:   map[int]int{},
+		Width:            120,
+		Height:           40,
+		AutoTiling:       true,
+		UseBSPLayout:     true,
+	}
+	for _, id := range []string{"window-a", "window-b"} {
+		w := newTestWindow(t, id, 20, 10)
+		w.Workspace = 1
+		m.Windows = append(m.Windows, w)
+	}
+	m.TileAllWindows()
+	left, right := m.Windows[0], m.Windows[1]
+
+	check := func(gap int) {
+		t.Helper()
+		if left.X != gap || left.Y != m.GetTopMargin()+gap {
+			t.Errorf("gap %d: left window at %d,%d, want %d cells in from the corner", gap, left.X, left.Y, gap)
+		}
+		if got := right.X - (left.X + left.Width); got != gap {
+			t.Errorf("gap %d: %d cells between the windows", gap, got)
+		}
+		if got := m.GetRenderWidth() - (right.X + right.Width); got != gap {
+			t.Errorf("gap %d: %d cells right of the last window", gap, got)
+		}
+	}
+	check(1)
+
+	if !m.AdjustTilingGap(2) {
+		t.Fatal("AdjustTilingGap reported no change")
+	}
+	check(3)
+
+	config.TilingGap = config.MaxTilingGap
+	if m.AdjustTilingGap(1) {
+		t.Error("AdjustTilingGap went past the maximum")
+	}
+	config.TilingGap = 1
+	if !m.AdjustTilingGap(-1) || m.AdjustTilingGap(-1) {
+		t.Error("AdjustTilingGap did not stop at zero")
+	}
+	check(0)
+}
+
+// TestTilingGapMasterStackResize checks that resizing a master-stack window
+// moves the windows across the gap with it, so the gap survives the resize.
+func TestTilingGapMasterStackResize(t *testing.T) {
+	prevAnim, prevGap, prevShared := config.AnimationsEnabled, config.TilingGap, config.SharedBorders
+	config.AnimationsEnabled, config.TilingGap, config.SharedBorders = false, 2, false
+	defer func() {
+		config.AnimationsEnabled, config.TilingGap, config.SharedBorders = prevAnim, prevGap, prevShared
+	}()
+
+	m := &OS{
+		CurrentWorkspace:     1,
+		WorkspaceFocus:       map[int]int{},
+		WorkspaceLayouts:     map[int][]WindowLayout{},
+		WorkspaceHasCustom:   map[int]bool{},
+		WorkspaceMasterRatio: map[int]float64{},
+		Width:                120,
+		Height:               40,
+		AutoTiling:           true,
+		MasterRatio:          0.5,
+	}
+	for _, id := range []string{"window-a", "window-b", "window-c"} {
+		w := newTestWindow(t, id, 20, 10)
+		w.Workspace = 1
+		m.Windows = append(m.Windows, w)
+	}
+	m.TileAllWindows()
+	master, top, bottom := m.Windows[0], m.Windows[1], m.Windows[2]
+	stackRight := top.X + top.Width
+	stackBottom := bottom.Y + bottom.Height
+
+	// Grow the master into the stack.
+	m.AdjustTilingNeighbors(master, master.X, master.Y, master.Width+6, master.Height)
+	for _, w := range []*terminal.Window{top, bottom} {
+		if got := w.X - (master.X + master.Width); got != 2 {
+			t.Errorf("%s: %d cells from the master after the resize, want 2", w.ID, got)
+		}
+		if w.X+w.Width != stackRight {
+			t.Errorf("%s: right edge moved to %d, want %d", w.ID, w.X+w.Width, stackRight)
+		}
+	}
+
+	// Shrink the lower stack window from the top.
+	m.AdjustTilingNeighbors(bottom, bottom.X, bottom.Y+3, bottom.Width, bottom.Height-3)
+	if got := bottom.Y - (top.Y + top.Height); got != 2 {
+		t.Errorf("%d cells between the stacked windows after the resize, want 2", got)
+	}
+	if bottom.Y+bottom.Height != stackBottom {
+		t.Errorf("lower stack window bottom moved to %d, want %d", bottom.Y+bottom.Height, stackBottom)
+	}
+}
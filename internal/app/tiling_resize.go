@@ -15,17 +15,42 @@ func (m *OS) ResizeMasterWidth(delta float64) {
 	// Adjust ratio
 	m.MasterRatio += delta
 
-	// Clamp between 0.3 and 0.7 (30% to 70%)
-	if m.MasterRatio < 0.3 {
-		m.MasterRatio = 0.3
-	} else if m.MasterRatio > 0.7 {
-		m.MasterRatio = 0.7
-	}
+	// Clamp to the configured range (0.3 to 0.7 by default)
+	m.MasterRatio = config.ClampMasterRatio(m.MasterRatio)
 
 	// Retile all windows with new ratio
 	m.TileAllWindows()
 }
 
+// CycleMasterRatio moves the master-stack layout to the next of
+// config.MasterRatioPresets and retiles, returning the new ratio. When the
+// ratio is not the preset last chosen (a workspace switch brought its own), it
+// goes to the first preset above it instead. It reports false outside
+// master-stack tiling or when no presets are configured.
+func (m *OS) CycleMasterRatio() (float64, bool) {
+	presets := config.MasterRatioPresets
+	if !m.AutoTiling || m.UseBSPLayout || m.UseScrollingLayout || len(presets) == 0 {
+		return 0, false
+	}
+
+	next := 0
+	if i := m.MasterRatioPreset; i < len(presets) && presets[i] == m.MasterRatio {
+		next = (i + 1) % len(presets)
+	} else {
+		for i, r := range presets {
+			if r > m.MasterRatio {
+				next = i
+				break
+			}
+		}
+	}
+
+	m.MasterRatioPreset = next
+	m.MasterRatio = presets[next]
+	m.TileAllWindows()
+	return m.MasterRatio, true
+}
+
 // ResizeFocusedWindowHeight resizes the focused window's height by moving the BOTTOM edge
 // delta is in pixels (positive = grow, negative = shrink)
 func (m *OS) ResizeFocusedWindowHeight(deltaPixels int) {
@@ -40,7 +65,7 @@ func (m *OS) ResizeFocusedWindowHeight(deltaPixels int) {
 
 	// Block resizing if bottom edge is at screen boundary
 	maxY := m.GetUsableHeight()
-	atBottomEdge := (focusedWindow.Y + focusedWindow.Height) >= (maxY - edgeTolerance)
+	atBottomEdge := (focusedWindow.Y + focusedWindow.Height) >= (maxY - edgeTolerance - tilingGap())
 	if atBottomEdge {
 		return // Can't resize bottom edge when it's at the screen edge
 	}
@@ -74,7 +99,7 @@ func (m *OS) ResizeFocusedWindowWidth(deltaPixels int) {
 	}
 
 	// Block resizing if right edge is at screen boundary
-	atRightEdge := (focusedWindow.X + focusedWindow.Width) >= (m.GetRenderWidth() - edgeTolerance)
+	atRightEdge := (focusedWindow.X + focusedWindow.Width) >= (m.GetRenderWidth() - edgeTolerance - tilingGap())
 	if atRightEdge {
 		return
 	}
@@ -108,7 +133,7 @@ func (m *OS) ResizeFocusedWindowWidthLeft(deltaPixels int) {
 	}
 
 	// Block resizing if left edge is at screen boundary
-	atLeftEdge := focusedWindow.X <= edgeTolerance
+	atLeftEdge := focusedWindow.X <= edgeTolerance+tilingGap()
 	if atLeftEdge {
 		return
 	}
@@ -136,7 +161,7 @@ func (m *OS) ResizeFocusedWindowHeightTop(deltaPixels int) {
 	}
 
 	// Block resizing if top edge is at screen boundary
-	atTopEdge := focusedWindow.Y <= edgeTolerance
+	atTopEdge := focusedWindow.Y <= edgeTolerance+tilingGap()
 	if atTopEdge {
 		return // Can't resize top edge when it's at the screen edge
 	}
@@ -169,6 +194,10 @@ func resizeVisual(m *OS, win *terminal.Window, width, height int) {
 // adjustTilingNeighborsGeneric is the core tiling resize algorithm.
 // It adjusts ALL windows on affected split lines with constraint-based positioning.
 // The resize parameter controls whether to use immediate or visual-only resize.
+//
+// A split is tracked by the far edge of the windows on its left (or top) side;
+// the windows on the other side start tilingGap() cells further on, so a
+// resize keeps the gap between them.
 func (m *OS) adjustTilingNeighborsGeneric(resized *terminal.Window, newX, newY, newWidth, newHeight int, resize resizeOp) (finalX, finalY, finalRight, finalBottom int) {
 	oldX := resized.X
 	oldY := resized.Y
@@ -182,98 +211,89 @@ func (m *OS) adjustTilingNeighborsGeneric(resized *terminal.Window, newX, newY,
 	minY := m.GetTopMargin()
 	maxY := minY + m.GetUsableHeight()
 	renderWidth := m.GetRenderWidth()
+	gap := tilingGap()
 
 	// Handle right edge movement (vertical split line)
 	if newRight != oldRight {
-		leftWindows, rightWindows := findWindowsOnVerticalSplitAll(m, oldRight)
+		leftWindows, rightWindows := findWindowsOnVerticalSplitAll(m, oldRight, gap)
 		leftWindows = removeWindowFromList(leftWindows, resized)
 		rightWindows = removeWindowFromList(rightWindows, resized)
 
-		constrainedRight := m.constrainVerticalSplit(newRight, leftWindows, rightWindows, minWidth, renderWidth)
-
-		for _, win := range leftWindows {
-			resize(m, win, constrainedRight-win.X, win.Height)
-			win.MarkPositionDirty()
-		}
-		for _, win := range rightWindows {
-			oldWinRight := win.X + win.Width
-			win.X = constrainedRight
-			resize(m, win, oldWinRight-constrainedRight, win.Height)
-			win.MarkPositionDirty()
-		}
+		constrainedRight := m.constrainVerticalSplit(newRight, leftWindows, rightWindows, minWidth, renderWidth, gap)
+		m.moveVerticalSplit(constrainedRight, gap, leftWindows, rightWindows, resize)
 
 		newRight = constrainedRight
 	}
 
 	// Handle left edge movement (vertical split line)
 	if newX != oldX {
-		leftWindows, rightWindows := findWindowsOnVerticalSplitAll(m, oldX)
+		leftWindows, rightWindows := findWindowsOnVerticalSplitAll(m, oldX-gap, gap)
 		leftWindows = removeWindowFromList(leftWindows, resized)
 		rightWindows = removeWindowFromList(rightWindows, resized)
 
-		constrainedX := m.constrainVerticalSplit(newX, leftWindows, rightWindows, minWidth, renderWidth)
-
-		for _, win := range leftWindows {
-			resize(m, win, constrainedX-win.X, win.Height)
-			win.MarkPositionDirty()
-		}
-		for _, win := range rightWindows {
-			oldWinRight := win.X + win.Width
-			win.X = constrainedX
-			resize(m, win, oldWinRight-constrainedX, win.Height)
-			win.MarkPositionDirty()
-		}
+		constrainedSplit := m.constrainVerticalSplit(newX-gap, leftWindows, rightWindows, minWidth, renderWidth, gap)
+		m.moveVerticalSplit(constrainedSplit, gap, leftWindows, rightWindows, resize)
 
-		newX = constrainedX
+		newX = constrainedSplit + gap
 	}
 
 	// Handle bottom edge movement (horizontal split line)
 	if newBottom != oldBottom {
-		topWindows, bottomWindows := findWindowsOnHorizontalSplitAll(m, oldBottom)
+		topWindows, bottomWindows := findWindowsOnHorizontalSplitAll(m, oldBottom, gap)
 		topWindows = removeWindowFromList(topWindows, resized)
 		bottomWindows = removeWindowFromList(bottomWindows, resized)
 
-		constrainedBottom := m.constrainHorizontalSplit(newBottom, topWindows, bottomWindows, minHeight, minY, maxY)
-
-		for _, win := range topWindows {
-			resize(m, win, win.Width, constrainedBottom-win.Y)
-			win.MarkPositionDirty()
-		}
-		for _, win := range bottomWindows {
-			oldWinBottom := win.Y + win.Height
-			win.Y = constrainedBottom
-			resize(m, win, win.Width, oldWinBottom-constrainedBottom)
-			win.MarkPositionDirty()
-		}
+		constrainedBottom := m.constrainHorizontalSplit(newBottom, topWindows, bottomWindows, minHeight, minY, maxY, gap)
+		m.moveHorizontalSplit(constrainedBottom, gap, topWindows, bottomWindows, resize)
 
 		newBottom = constrainedBottom
 	}
 
 	// Handle top edge movement (horizontal split line)
 	if newY != oldY {
-		topWindows, bottomWindows := findWindowsOnHorizontalSplitAll(m, oldY)
+		topWindows, bottomWindows := findWindowsOnHorizontalSplitAll(m, oldY-gap, gap)
 		topWindows = removeWindowFromList(topWindows, resized)
 		bottomWindows = removeWindowFromList(bottomWindows, resized)
 
-		constrainedY := m.constrainHorizontalSplit(newY, topWindows, bottomWindows, minHeight, minY, maxY)
-
-		for _, win := range topWindows {
-			resize(m, win, win.Width, constrainedY-win.Y)
-			win.MarkPositionDirty()
-		}
-		for _, win := range bottomWindows {
-			oldWinBottom := win.Y + win.Height
-			win.Y = constrainedY
-			resize(m, win, win.Width, oldWinBottom-constrainedY)
-			win.MarkPositionDirty()
-		}
+		constrainedSplit := m.constrainHorizontalSplit(newY-gap, topWindows, bottomWindows, minHeight, minY, maxY, gap)
+		m.moveHorizontalSplit(constrainedSplit, gap, topWindows, bottomWindows, resize)
 
-		newY = constrainedY
+		newY = constrainedSplit + gap
 	}
 
 	return newX, newY, newRight, newBottom
 }
 
+// moveVerticalSplit ends the left windows at splitX and starts the right
+// windows gap cells after it, keeping their right edges where they were.
+func (m *OS) moveVerticalSplit(splitX, gap int, leftWindows, rightWindows []*terminal.Window, resize resizeOp) {
+	for _, win := range leftWindows {
+		resize(m, win, splitX-win.X, win.Height)
+		win.MarkPositionDirty()
+	}
+	for _, win := range rightWindows {
+		oldWinRight := win.X + win.Width
+		win.X = splitX + gap
+		resize(m, win, oldWinRight-win.X, win.Height)
+		win.MarkPositionDirty()
+	}
+}
+
+// moveHorizontalSplit ends the top windows at splitY and starts the bottom
+// windows gap cells after it, keeping their bottom edges where they were.
+func (m *OS) moveHorizontalSplit(splitY, gap int, topWindows, bottomWindows []*terminal.Window, resize resizeOp) {
+	for _, win := range topWindows {
+		resize(m, win, win.Width, splitY-win.Y)
+		win.MarkPositionDirty()
+	}
+	for _, win := range bottomWindows {
+		oldWinBottom := win.Y + win.Height
+		win.Y = splitY + gap
+		resize(m, win, win.Width, oldWinBottom-win.Y)
+		win.MarkPositionDirty()
+	}
+}
+
 // applyBSPResize moves the dividers that own the edges the caller changed and
 // rebuilds every pane's geometry from the tree. It reports false when the
 // workspace is not on a BSP tree that holds this window, in which case the
@@ -353,8 +373,9 @@ func (m *OS) applyBSPResize(resized *terminal.Window, newX, newY, newWidth, newH
 	return true
 }
 
-// constrainVerticalSplit calculates the valid position for a vertical split line
-func (m *OS) constrainVerticalSplit(requested int, leftWindows, rightWindows []*terminal.Window, minWidth, maxX int) int {
+// constrainVerticalSplit calculates the valid position for a vertical split line,
+// given as the right edge of the left windows with the right windows gap cells on
+func (m *OS) constrainVerticalSplit(requested int, leftWindows, rightWindows []*terminal.Window, minWidth, maxX, gap int) int {
 	minValidX := 0
 	for _, win := range leftWindows {
 		minRequired := win.X + minWidth
@@ -365,7 +386,7 @@ func (m *OS) constrainVerticalSplit(requested int, leftWindows, rightWindows []*
 
 	maxValidX := maxX
 	for _, win := range rightWindows {
-		maxAllowed := win.X + win.Width - minWidth
+		maxAllowed := win.X + win.Width - minWidth - gap
 		if maxAllowed < maxValidX {
 			maxValidX = maxAllowed
 		}
@@ -374,8 +395,9 @@ func (m *OS) constrainVerticalSplit(requested int, leftWindows, rightWindows []*
 	return max(minValidX, min(requested, maxValidX))
 }
 
-// constrainHorizontalSplit calculates the valid position for a horizontal split line
-func (m *OS) constrainHorizontalSplit(requested int, topWindows, bottomWindows []*terminal.Window, minHeight, minY, maxY int) int {
+// constrainHorizontalSplit calculates the valid position for a horizontal split line,
+// given as the bottom edge of the top windows with the bottom windows gap cells below
+func (m *OS) constrainHorizontalSplit(requested int, topWindows, bottomWindows []*terminal.Window, minHeight, minY, maxY, gap int) int {
 	minValidY := minY
 	for _, win := range topWindows {
 		minRequired := win.Y + minHeight
@@ -386,7 +408,7 @@ func (m *OS) constrainHorizontalSplit(requested int, topWindows, bottomWindows [
 
 	maxValidY := maxY
 	for _, win := range bottomWindows {
-		maxAllowed := win.Y + win.Height - minHeight
+		maxAllowed := win.Y + win.Height - minHeight - gap
 		if maxAllowed < maxValidY {
 			maxValidY = maxAllowed
 		}
@@ -460,8 +482,9 @@ func (m *OS) AdjustTilingNeighborsVisual(resized *terminal.Window, newX, newY, n
 	return false
 }
 
-// findWindowsOnVerticalSplitAll finds all windows on a vertical split line (not excluding any window)
-func findWindowsOnVerticalSplitAll(m *OS, splitX int) (leftWindows, rightWindows []*terminal.Window) {
+// findWindowsOnVerticalSplitAll finds all windows on a vertical split line (not excluding any window).
+// Left windows end at splitX; right windows start gap cells after it.
+func findWindowsOnVerticalSplitAll(m *OS, splitX, gap int) (leftWindows, rightWindows []*terminal.Window) {
 	const tolerance = 1
 
 	for _, win := range m.Windows {
@@ -472,7 +495,7 @@ func findWindowsOnVerticalSplitAll(m *OS, splitX int) (leftWindows, rightWindows
 		winRight := win.X + win.Width
 		if abs(winRight-splitX) <= tolerance {
 			leftWindows = append(leftWindows, win)
-		} else if abs(win.X-splitX) <= tolerance {
+		} else if abs(win.X-(splitX+gap)) <= tolerance {
 			rightWindows = append(rightWindows, win)
 		}
 	}
@@ -480,8 +503,9 @@ func findWindowsOnVerticalSplitAll(m *OS, splitX int) (leftWindows, rightWindows
 	return leftWindows, rightWindows
 }
 
-// findWindowsOnHorizontalSplitAll finds all windows on a horizontal split line (not excluding any window)
-func findWindowsOnHorizontalSplitAll(m *OS, splitY int) (topWindows, bottomWindows []*terminal.Window) {
+// findWindowsOnHorizontalSplitAll finds all windows on a horizontal split line (not excluding any window).
+// Top windows end at splitY; bottom windows start gap cells below it.
+func findWindowsOnHorizontalSplitAll(m *OS, splitY, gap int) (topWindows, bottomWindows []*terminal.Window) {
 	const tolerance = 1
 
 	for _, win := range m.Windows {
@@ -492,7 +516,7 @@ func findWindowsOnHorizontalSplitAll(m *OS, splitY int) (topWindows, bottomWindo
 		winBottom := win.Y + win.Height
 		if abs(winBottom-splitY) <= tolerance {
 			topWindows = append(topWindows, win)
-		} else if abs(win.Y-splitY) <= tolerance {
+		} else if abs(win.Y-(splitY+gap)) <= tolerance {
 			bottomWindows = append(bottomWindows, win)
 		}
 	}
@@ -129,7 +129,7 @@ func (m *OS) TileRemainingWindows(excludeIndex int) {
 	}
 
 	// Calculate tiling layout based on number of remaining windows
-	layouts := layout.CalculateTilingLayout(len(visibleWindows), m.GetRenderWidth(), m.GetUsableHeight(), m.GetTopMargin(), m.MasterRatio)
+	layouts := layout.CalculateTilingLayout(len(visibleWindows), m.GetRenderWidth(), m.GetUsableHeight(), m.GetTopMargin(), m.MasterRatio, tilingGap())
 
 	// Apply layout with animations
 	for i, idx := range visibleIndices {
@@ -177,6 +177,47 @@ func (m *OS) SwapWindow(dir Direction) {
 	}
 }
 
+// PromoteToMaster swaps the focused window into the master slot of the
+// master-stack layout, the first tiled window on the workspace. Promoting the
+// master swaps it with the top of the stack instead, as dwm's zoom does.
+// Focus follows the promoted window, or with config.PromoteKeepsFocusSlot
+// stays on the slot it was in. It reports false when there is nothing to
+// promote: no master-stack layout, a floating window, or a lone tiled window.
+func (m *OS) PromoteToMaster() bool {
+	if !m.AutoTiling || m.UseBSPLayout || m.UseScrollingLayout {
+		return false
+	}
+	if m.FocusedWindow < 0 || m.FocusedWindow >= len(m.Windows) || m.HasActiveAnimations() {
+		return false
+	}
+	if m.Windows[m.FocusedWindow].IsFloating {
+		return false
+	}
+
+	var tiled []int
+	for i, w := range m.Windows {
+		if w.Workspace == m.CurrentWorkspace && !w.Minimized && !w.Minimizing && !w.IsFloating {
+			tiled = append(tiled, i)
+		}
+	}
+	if len(tiled) < 2 {
+		return false
+	}
+
+	slot := m.FocusedWindow
+	target := tiled[0]
+	if slot == target {
+		target = tiled[1]
+	}
+	// The swap exchanges the slice positions too, which is what the
+	// master-stack layout orders by, and moves focus with the window.
+	m.SwapWindowsInstant(slot, target)
+	if config.PromoteKeepsFocusSlot {
+		m.FocusWindow(slot)
+	}
+	return true
+}
+
 // findAdjacentWindow finds the closest window in the given direction
 func (m *OS) findAdjacentWindow(focused *terminal.Window, dir Direction) int {
 	targetIndex := -1
@@ -0,0 +1,29 @@
+package app
+
+import "github.com/Gaurav-Gosain/tuios/internal/config"
+
+// SyncTitleBars shows or hides each window's title bar row according to
+// config.ShowTitleBars. With "multiple" a window loses its title bar while it
+// is the only visible window on its workspace; minimized windows and windows
+// on their way out do not count. Windows whose state changes are resized so
+// their terminal gains or gives back the row.
+func (m *OS) SyncTitleBars() {
+	visible := make(map[int]int)
+	if config.ShowTitleBars == config.TitleBarsMultiple {
+		for _, w := range m.Windows {
+			if !w.Minimized && !w.Minimizing && !w.Closing {
+				visible[w.Workspace]++
+			}
+		}
+	}
+	for _, w := range m.Windows {
+		hide := false
+		switch config.ShowTitleBars {
+		case config.TitleBarsNever:
+			hide = true
+		case config.TitleBarsMultiple:
+			hide = visible[w.Workspace] <= 1
+		}
+		w.SetTitleBarHidden(hide)
+	}
+}
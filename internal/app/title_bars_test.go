@@ -0,0 +1,49 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// TestSyncTitleBarsMultiple checks a lone window gives its title bar row to
+// the terminal and gets it back once a second window shares the workspace,
+// with mouse coordinates following the content row.
+func TestSyncTitleBarsMultiple(t *testing.T) {
+	original := config.ShowTitleBars
+	defer func() { config.ShowTitleBars = original }()
+	config.ShowTitleBars = config.TitleBarsMultiple
+
+	a := newTestWindow(t, "window-a", 40, 12)
+	a.Workspace = 1
+	m := newTestOS(a)
+	m.CurrentWorkspace = 1
+
+	m.SyncTitleBars()
+	if !a.TitleBarHidden {
+		t.Fatal("lone window kept its title bar")
+	}
+	if got := a.ContentHeight(); got != 11 {
+		t.Errorf("content height %d, want 11", got)
+	}
+	if _, y, ok := a.ScreenToTerminal(a.X+1, a.Y); !ok || y != 0 {
+		t.Errorf("top window row maps to terminal row %d (inside %v), want 0", y, ok)
+	}
+
+	b := newTestWindow(t, "window-b", 40, 12)
+	b.Workspace = 1
+	m.Windows = append(m.Windows, b)
+	m.SyncTitleBars()
+	if a.TitleBarHidden || b.TitleBarHidden {
+		t.Fatal("title bars hidden with two windows on the workspace")
+	}
+	if got := a.ContentHeight(); got != 10 {
+		t.Errorf("content height %d, want 10", got)
+	}
+
+	b.Minimized = true
+	m.SyncTitleBars()
+	if !a.TitleBarHidden {
+		t.Error("minimized window counted as visible")
+	}
+}
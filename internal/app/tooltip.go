@@ -0,0 +1,151 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+	"github.com/Gaurav-Gosain/tuios/internal/theme"
+)
+
+// TooltipMsg fires once the pointer has rested on a title-bar button or dock
+// item for config.TooltipDelay. Seq ties it to the hover that scheduled it.
+type TooltipMsg struct {
+	Seq int
+}
+
+// HoverTooltip is called with the pointer position as it moves. Landing on a
+// title-bar button or dock item schedules its tooltip after
+// config.TooltipDelay; moving onto another target, or off every target,
+// hides the tooltip and replaces or cancels the pending one. Moving within
+// the same target leaves it alone.
+func (m *OS) HoverTooltip(x, y int) tea.Cmd {
+	label := ""
+	if config.ShowTooltips {
+		label = m.tooltipAt(x, y)
+	}
+	if label != "" && label == m.TooltipLabel {
+		return nil // still on the same target
+	}
+	m.HideTooltip()
+	if label == "" {
+		return nil
+	}
+	m.TooltipLabel = label
+	m.TooltipX, m.TooltipY = x, y
+	m.TooltipSeq++
+	msg := TooltipMsg{Seq: m.TooltipSeq}
+	return tea.Tick(config.TooltipDelay, func(time.Time) tea.Msg { return msg })
+}
+
+// HideTooltip drops the tooltip and any pending one.
+func (m *OS) HideTooltip() {
+	m.TooltipLabel = ""
+	m.TooltipVisible = false
+}
+
+// applyTooltip shows the tooltip a TooltipMsg was scheduled for if the
+// pointer is still on its target, and reports whether it appeared.
+func (m *OS) applyTooltip(msg TooltipMsg) bool {
+	if msg.Seq != m.TooltipSeq || m.TooltipLabel == "" || m.TooltipVisible {
+		return false
+	}
+	m.TooltipVisible = true
+	return true
+}
+
+// tooltipAt returns the label for the title-bar button or dock item at the
+// given cell, or "" when there is none. The button hit regions match the
+// ones the mouse click handler uses.
+func (m *OS) tooltipAt(x, y int) string {
+	if m.DockPosition() != "hidden" && y == m.GetDockbarContentYPosition() {
+		for _, item := range m.CalculateDockLayout().ItemPositions {
+			if x >= item.StartX && x < item.EndX && item.WindowIndex < len(m.Windows) {
+				return tooltipWindowName(m.Windows[item.WindowIndex])
+			}
+		}
+		return ""
+	}
+
+	if config.HideWindowButtons {
+		return ""
+	}
+
+	// Topmost window under the pointer
+	topIdx, topZ := -1, -1
+	for i, win := range m.Windows {
+		if win.Workspace != m.CurrentWorkspace || win.Minimized {
+			continue
+		}
+		if x >= win.X && x < win.X+win.Width && y >= win.Y && y < win.Y+win.Height && win.Z > topZ {
+			topIdx, topZ = i, win.Z
+		}
+	}
+	if topIdx == -1 {
+		return ""
+	}
+	win := m.Windows[topIdx]
+	if y != win.Y || win.TitleBarHidden {
+		return ""
+	}
+
+	leftMost := win.X + win.Width
+	switch {
+	case x >= leftMost-4 && x <= leftMost-1:
+		return "Close"
+	case m.AutoTiling && x >= leftMost-7 && x <= leftMost-5:
+		return "Minimize"
+	case m.AutoTiling:
+		return ""
+	case x >= leftMost-7 && x <= leftMost-5:
+		if win.Maximized {
+			return "Restore"
+		}
+		return "Maximize"
+	case x >= leftMost-10 && x <= leftMost-8:
+		return "Minimize"
+	}
+	return ""
+}
+
+// tooltipWindowName is the name a dock item's tooltip shows: the custom name,
+// else the terminal title, else a short form of the window ID.
+func tooltipWindowName(w *terminal.Window) string {
+	if w.CustomName != "" {
+		return w.CustomName
+	}
+	if title := w.Title(); title != "" {
+		return title
+	}
+	return fmt.Sprintf("Window %s", w.ID[:min(len(w.ID), 8)])
+}
+
+// renderTooltip draws the visible tooltip just below and right of where the
+// pointer came to rest, kept on screen. Over the bottom dock it goes above.
+func (m *OS) renderTooltip() *lipgloss.Layer {
+	if !m.TooltipVisible || m.TooltipLabel == "" {
+		return nil
+	}
+
+	ui := theme.UI()
+	label := lipgloss.NewStyle().
+		Foreground(ui.Fg).
+		Background(ui.Card).
+		Padding(0, 1).
+		MaxWidth(max(m.GetRenderWidth()-2, 1)).
+		Render(m.TooltipLabel)
+
+	x := min(m.TooltipX+1, m.GetRenderWidth()-lipgloss.Width(label))
+	y := m.TooltipY + 1
+	if y >= m.Height {
+		y = m.TooltipY - 1
+	}
+	return lipgloss.NewLayer(label).
+		X(max(x, 0)).
+		Y(max(y, 0)).
+		Z(config.ZIndexTooltip).
+		ID("tooltip")
+}
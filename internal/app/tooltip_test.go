@@ -0,0 +1,67 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestHoverTooltip checks that resting on a title-bar button shows its label
+// only after the delay, that moving off drops a pending tooltip, and that
+// show_tooltips = false turns the feature off.
+func TestHoverTooltip(t *testing.T) {
+	prevShow, prevHide, prevDock := config.ShowTooltips, config.HideWindowButtons, config.DockbarPosition
+	defer func() {
+		config.ShowTooltips, config.HideWindowButtons, config.DockbarPosition = prevShow, prevHide, prevDock
+	}()
+	config.ShowTooltips = true
+	config.HideWindowButtons = false
+	config.DockbarPosition = "hidden"
+
+	m := &OS{CurrentWorkspace: 1, Width: 80, Height: 24, WorkspaceFocus: map[int]int{}}
+	m.Windows = []*terminal.Window{{ID: "window-a", Workspace: 1, X: 0, Y: 0, Width: 40, Height: 10}}
+
+	for _, tc := range []struct {
+		x    int
+		want string
+	}{
+		{38, "Close"},
+		{34, "Maximize"},
+		{31, "Minimize"},
+		{10, ""},
+	} {
+		if got := m.tooltipAt(tc.x, 0); got != tc.want {
+			t.Errorf("tooltipAt(%d, 0) = %q, want %q", tc.x, got, tc.want)
+		}
+	}
+	if got := m.tooltipAt(38, 1); got != "" {
+		t.Errorf("tooltip below the title bar: %q", got)
+	}
+
+	if cmd := m.HoverTooltip(38, 0); cmd == nil {
+		t.Fatal("no tooltip scheduled over the close button")
+	}
+	if m.TooltipVisible {
+		t.Fatal("tooltip shown before the delay")
+	}
+	pending := TooltipMsg{Seq: m.TooltipSeq}
+	if cmd := m.HoverTooltip(37, 0); cmd != nil {
+		t.Error("moving within the same button restarted the delay")
+	}
+	if !m.applyTooltip(pending) || m.TooltipLabel != "Close" || m.renderTooltip() == nil {
+		t.Fatalf("tooltip not shown after resting on the button (label %q)", m.TooltipLabel)
+	}
+
+	m.HoverTooltip(31, 0)
+	passedOver := TooltipMsg{Seq: m.TooltipSeq}
+	m.HoverTooltip(10, 5)
+	if m.applyTooltip(passedOver) || m.renderTooltip() != nil {
+		t.Error("tooltip shown after the pointer left the button")
+	}
+
+	config.ShowTooltips = false
+	if cmd := m.HoverTooltip(38, 0); cmd != nil {
+		t.Error("tooltip scheduled with show_tooltips off")
+	}
+}
@@ -228,6 +228,10 @@ func (m *OS) Init() tea.Cmd {
 		ListenForCwdChange(m.ensureCwdChangeChan()),
 	}
 
+	if cmd := m.startupCommand(); cmd != nil {
+		cmds = append(cmds, cmd)
+	}
+
 	// Listen for state sync from other clients (daemon/SSH/web mode)
 	if m.StateSyncChan != nil {
 		cmds = append(cmds, ListenForStateSync(m.StateSyncChan))
@@ -390,6 +394,9 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 			cmd = nil
 		}
 	}()
+	// Title bars follow the number of visible windows, which any message
+	// may have changed.
+	defer m.SyncTitleBars()
 
 	// Any non-tick message invalidates the render cache
 	if _, isTick := msg.(TickerMsg); !isTick {
@@ -405,6 +412,18 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 		m.renderSkipped = false
 		return m, ListenForPTYData(m.PTYDataChan)
 
+	case HoverFocusMsg:
+		if m.applyHoverFocus(msg) {
+			m.MarkAllDirty()
+		}
+		return m, nil
+
+	case TooltipMsg:
+		if m.applyTooltip(msg) {
+			m.MarkAllDirty()
+		}
+		return m, nil
+
 	case AutoScrollTickMsg:
 		if !m.AutoScrollActive || m.AutoScrollDir == 0 {
 			return m, nil
@@ -439,7 +458,7 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 					} else if cm.ScrollOffset > 0 {
 						cm.ScrollOffset--
 						w.ScrollbackOffset = cm.ScrollOffset
-					} else if cm.CursorY < w.Height-3 {
+					} else if cm.CursorY < w.ContentHeight()-1 {
 						cm.CursorY++
 					}
 				}
@@ -473,6 +492,15 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 		// Update animations
 		m.UpdateAnimations()
 
+		// Tuck the auto-hidden dock away or bring it back as windows are
+		// minimized and restored.
+		m.syncDockAutoHide()
+
+		m.updateThrottleIndicators()
+
+		// Follow foreground commands in window names (config.AutoRenameFromCommand).
+		m.updateAutoNames()
+
 		// Update system info (only when explicitly enabled)
 		if config.ShowCPU {
 			m.UpdateCPUHistory()
@@ -491,6 +519,10 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 		if m.ScriptMode && !m.ScriptPaused && m.ScriptPlayer != nil {
 			player, ok := m.ScriptPlayer.(*tape.Player)
 			if ok && !player.IsFinished() {
+				// A new run of a looping tape starts from the state the first
+				// one did, not from wherever the last one left things.
+				m.trackTapeRun(player)
+
 				// Wait for animations to complete before executing next command
 				// This ensures visual consistency during script playback
 				if m.HasActiveAnimations() {
@@ -504,6 +536,11 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 					return m, TickCmd()
 				}
 
+				// Likewise for WaitFor, which also ends playback on timeout.
+				if m.ScriptWaitText != "" && !m.checkScriptWaitFor() {
+					return m, TickCmd()
+				}
+
 				// Check if we're waiting for a sleep to finish
 				if !m.ScriptSleepUntil.IsZero() && time.Now().Before(m.ScriptSleepUntil) {
 					// Still waiting, don't advance yet
@@ -518,7 +555,7 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 					// Sleep and its Wait alias both just delay playback.
 					case (nextCmd.Type == tape.CommandTypeSleep || nextCmd.Type == tape.CommandTypeWait) && nextCmd.Delay > 0:
 						// Set the sleep deadline
-						m.ScriptSleepUntil = time.Now().Add(nextCmd.Delay)
+						m.ScriptSleepUntil = time.Now().Add(player.ScaleDelay(nextCmd.Delay))
 						// Advance to next command but don't execute anything yet
 						player.Advance()
 					case nextCmd.Type == tape.CommandTypeWaitUntilRegex:
@@ -526,6 +563,9 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 						// Don't dispatch it to the executor.
 						m.startScriptWaitRegex(nextCmd)
 						player.Advance()
+					case nextCmd.Type == tape.CommandTypeWaitFor:
+						m.startScriptWaitFor(nextCmd)
+						player.Advance()
 					default:
 						// Queue the command as a message instead of executing directly
 						cmds = append(cmds, func() tea.Msg {
@@ -553,10 +593,19 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 			}
 		}
 
+		// Start the dock's status command when it is due. Done after script
+		// playback, which can return early, so a started run is never dropped.
+		if cmd := m.pollStatusCommand(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+		m.enforceScrollbackBudget()
+
 		// Tick handles animations, interactions, whichkey, dock stats, and scripts.
 		// PTY content changes are handled by PTYDataMsg (event-driven).
 		hasAnimations := m.HasActiveAnimations()
 		needsDockTick := config.NeedsDockTick()
+		paneNumbers := m.PaneNumbersVisible()
+		paneNumbersExpired := m.expirePaneNumbers()
 
 		// Determine next tick rate
 		var nextTick tea.Cmd
@@ -567,7 +616,7 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 			// cost smoothness without limiting the motion flood, since motion
 			// events drove their own renders regardless of the tick rate.
 			nextTick = TickCmd()
-		} else if hasAnimations || m.PrefixActive || m.ScriptMode || needsDockTick {
+		} else if hasAnimations || m.PrefixActive || m.ScriptMode || needsDockTick || paneNumbers {
 			nextTick = TickCmd() // Normal FPS when things need periodic updates
 		} else {
 			nextTick = IdleTickCmd() // Slow idle tick (process cleanup, etc.)
@@ -580,7 +629,7 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 		hasBackgroundChanges := m.MarkTerminalsWithNewContent()
 
 		// Render on tick if something periodic needs visual updates OR background windows changed
-		needsRender := hasAnimations || m.InteractionMode || m.PrefixActive || needsDockTick || hasBackgroundChanges
+		needsRender := hasAnimations || m.InteractionMode || m.PrefixActive || needsDockTick || hasBackgroundChanges || paneNumbersExpired
 		if !needsRender {
 			m.renderSkipped = true
 			if len(cmds) > 1 {
@@ -609,6 +658,16 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 			ListenForClipboardSet(m.PendingClipboardSet),
 		)
 
+	case StatusCommandMsg:
+		if m.applyStatusCommand(msg) {
+			m.renderSkipped = false
+		}
+		return m, nil
+
+	case StartupCommandMsg:
+		m.logStartupCommand(msg)
+		return m, nil
+
 	case NotificationMsg:
 		// Guest desktop notification or bell delivered off the PTY goroutine;
 		// apply it here on the Bubble Tea goroutine where notification state is owned.
@@ -733,18 +792,17 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 			m.applyStartupPreferences()
 		}
 
-		// Notify daemon of our terminal size for multi-client size calculation
-		// This allows the daemon to compute effective size = min(all clients)
-		if m.IsDaemonSession && m.DaemonClient != nil {
-			_ = m.DaemonClient.NotifyTerminalSize(msg.Width, msg.Height)
-		}
-
 		// When restored from state, we need to retile if tiling is enabled
 		// to properly fit windows to the new terminal size.
 		// The BSP tree structure is preserved, only positions/sizes are recalculated.
 		// However, if the size is the same (e.g., web reload), skip retiling to preserve layout.
 		if m.RestoredFromState {
 			m.RestoredFromState = false
+			// Notify daemon of our terminal size for multi-client size calculation
+			// This allows the daemon to compute effective size = min(all clients)
+			if m.IsDaemonSession && m.DaemonClient != nil {
+				_ = m.DaemonClient.NotifyTerminalSize(msg.Width, msg.Height)
+			}
 			sizeChanged := oldWidth != msg.Width || oldHeight != msg.Height
 			if sizeChanged {
 				// In daemon mode, the previous implementation waited for
@@ -797,24 +855,19 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 			return m, nil
 		}
 
-		// Retile windows if in tiling mode
-		if m.AutoTiling {
-			m.TileAllWindows()
-		} else if msg.Width < oldWidth || msg.Height < oldHeight {
-			// Terminal got smaller in floating mode - clamp windows back into view
-			m.ClampWindowsToView()
-		}
-
-		// Flush PTY buffers after resize to ensure TUI apps (btop, vim, etc.)
-		// redraw properly. The PTY resize sends SIGWINCH, but we also need to
-		// mark all content dirty and invalidate caches for the new output.
-		m.FlushPTYBuffersAfterResize()
-
+		// Retiling, PTY resizes and the daemon notification wait until the
+		// size settles, so a burst of resizes pays for them once.
+		// See resize_settle.go.
+		//
 		// NOTE: Don't HideAllPlacements on kitty here  - the delete+re-place cycle
 		// can lose image data on some terminals. RefreshAllPlacements runs every
 		// render and will reposition in place via `a=p` (the image data persists
 		// across `d=i` deletes per the kitty protocol).
 
+		return m, m.scheduleResizeSettle(oldWidth, oldHeight)
+
+	case resizeSettleMsg:
+		m.handleResizeSettle(msg.gen)
 		return m, nil
 
 	case tea.MouseMsg:
@@ -855,6 +908,7 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 				// to start in terminal mode, enter it now that there is a focused
 				// window to type into.
 				m.maybeEnterPendingTerminalMode()
+				m.startPendingPager()
 
 				// Show notifications for significant changes
 				newWindowCount := len(m.Windows)
@@ -941,8 +995,7 @@ func (m *OS) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
 		// Apply appearance config parsed by the watcher goroutine here, on the
 		// Bubble Tea goroutine, so the render loop never reads the globals mid-write.
 		if msg.Config != nil {
-			config.ApplyAppearanceConfig(msg.Config)
-			m.MarkAllDirty()
+			m.applyReloadedConfig(msg.Config)
 		}
 		return m, nil
 
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestCloseAnimationDeletesWindowWhenDone checks that with the dock close
+// animation on, DeleteWindow leaves the window in place, moves focus off it and
+// ignores a second close, and that the window is removed once the animation
+// has run its course.
+func TestCloseAnimationDeletesWindowWhenDone(t *testing.T) {
+	prevClose, prevEnabled := config.WindowCloseAnimation, config.AnimationsEnabled
+	defer func() {
+		config.WindowCloseAnimation, config.AnimationsEnabled = prevClose, prevEnabled
+	}()
+	config.WindowCloseAnimation = config.CloseAnimationDock
+	config.AnimationsEnabled = true
+
+	m := &OS{CurrentWorkspace: 1, Width: 120, Height: 40, WorkspaceFocus: map[int]int{}}
+	for _, id := range []string{"window-a", "window-b"} {
+		m.Windows = append(m.Windows, &terminal.Window{
+			ID:        id,
+			Workspace: 1,
+			X:         10,
+			Y:         5,
+			Width:     40,
+			Height:    12,
+		})
+	}
+	m.FocusedWindow = 1
+	closing := m.Windows[1]
+
+	m.DeleteWindow(1)
+	if len(m.Windows) != 2 || !closing.Closing {
+		t.Fatalf("window removed before its close animation ran (windows=%d, closing=%v)", len(m.Windows), closing.Closing)
+	}
+	if m.FocusedWindow != 0 {
+		t.Errorf("focus stayed on the closing window (FocusedWindow=%d)", m.FocusedWindow)
+	}
+	m.DeleteWindow(1)
+	if len(m.Animations) != 1 {
+		t.Fatalf("got %d animations after a second close, want 1", len(m.Animations))
+	}
+
+	m.Animations[0].StartTime = time.Now().Add(-time.Second)
+	m.UpdateAnimations()
+	if len(m.Windows) != 1 || m.Windows[0].ID != "window-a" {
+		t.Fatalf("closing window not deleted when its animation finished (windows=%d)", len(m.Windows))
+	}
+	if len(m.Animations) != 0 {
+		t.Errorf("%d animations left after the close finished", len(m.Animations))
+	}
+}
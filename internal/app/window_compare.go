@@ -0,0 +1,300 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+	"github.com/Gaurav-Gosain/tuios/internal/theme"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// CompareOp says how a row of a window comparison differs between the two
+// screens.
+type CompareOp int
+
+const (
+	CompareSame    CompareOp = iota // the line is on both screens
+	CompareChanged                  // the screens have different lines here
+	CompareRemoved                  // the line is only on the first screen
+	CompareAdded                    // the line is only on the second screen
+)
+
+// CompareRow is one line of the side-by-side view. Left is empty for an added
+// line and Right for a removed one.
+type CompareRow struct {
+	Op    CompareOp
+	Left  string
+	Right string
+}
+
+// WindowCompare is an open comparison of two windows' screens (leader t d).
+// The screens are read once when it opens; it does not follow later output.
+type WindowCompare struct {
+	TitleA string
+	TitleB string
+	Rows   []CompareRow
+	Scroll int
+}
+
+// Differences counts the rows that are not the same on both screens.
+func (c *WindowCompare) Differences() int {
+	n := 0
+	for _, r := range c.Rows {
+		if r.Op != CompareSame {
+			n++
+		}
+	}
+	return n
+}
+
+// StartCompare begins picking two windows to compare: the pane numbers stay up
+// until two have been chosen by digit or the pick is cancelled.
+func (m *OS) StartCompare() bool {
+	if len(m.GetVisibleWindows()) < 2 {
+		return false
+	}
+	m.ComparePicking = true
+	m.CompareFirst = ""
+	m.MarkAllDirty()
+	return true
+}
+
+// CancelCompare abandons a pick in progress.
+func (m *OS) CancelCompare() {
+	m.ComparePicking = false
+	m.CompareFirst = ""
+	m.MarkAllDirty()
+}
+
+// ComparePick takes the window at a pane number (1-9, 0 for 10) as the next
+// of the two. The second pick opens the comparison. It reports false for a
+// number with no window, or the first window picked again.
+func (m *OS) ComparePick(num int) bool {
+	w := m.windowAtPaneNumber(num)
+	if w == nil || w.ID == m.CompareFirst {
+		return false
+	}
+	if m.CompareFirst == "" {
+		m.CompareFirst = w.ID
+		m.ShowNotification("Compare "+m.getWindowDisplayName(w)+" with...", "info", config.NotificationDuration)
+		return true
+	}
+	first := m.CompareFirst
+	m.CancelCompare()
+	return m.CompareWindows(first, w.ID)
+}
+
+// windowAtPaneNumber returns the current workspace's window at a pane number,
+// counted the way the leader-digit shortcuts and the pane-number badges count.
+func (m *OS) windowAtPaneNumber(num int) *terminal.Window {
+	for _, w := range m.Windows {
+		if w.Workspace != m.CurrentWorkspace {
+			continue
+		}
+		if label, ok := paneNumberLabel(m.workspacePosition(w)); ok && label == strconv.Itoa(num) {
+			return w
+		}
+	}
+	return nil
+}
+
+// CompareWindows opens a side-by-side diff of two windows' current screens.
+// It reports false when either ID names no window.
+func (m *OS) CompareWindows(idA, idB string) bool {
+	a, b := m.windowWithID(idA), m.windowWithID(idB)
+	if a == nil || b == nil {
+		return false
+	}
+	m.Compare = &WindowCompare{
+		TitleA: m.getWindowDisplayName(a),
+		TitleB: m.getWindowDisplayName(b),
+		Rows:   diffLines(screenLines(a), screenLines(b)),
+	}
+	m.MarkAllDirty()
+	return true
+}
+
+// windowWithID returns the window with the given ID, or nil.
+func (m *OS) windowWithID(id string) *terminal.Window {
+	for _, w := range m.Windows {
+		if w.ID == id {
+			return w
+		}
+	}
+	return nil
+}
+
+// CloseCompare closes the comparison overlay.
+func (m *OS) CloseCompare() {
+	m.Compare = nil
+	m.MarkAllDirty()
+}
+
+// ScrollCompare moves the comparison by delta rows, kept within its length.
+func (m *OS) ScrollCompare(delta int) {
+	if m.Compare == nil {
+		return
+	}
+	maxScroll := max(len(m.Compare.Rows)-m.compareRowsShown(), 0)
+	m.Compare.Scroll = max(0, min(m.Compare.Scroll+delta, maxScroll))
+}
+
+// screenLines returns the text of a window's live screen, one string per row,
+// without trailing blanks or the empty rows below the last line of output.
+func screenLines(w *terminal.Window) []string {
+	if w.Terminal == nil {
+		return nil
+	}
+	w.RLockIO()
+	defer w.RUnlockIO()
+
+	screen := w.Terminal
+	lines := make([]string, screen.Height())
+	var line strings.Builder
+	for y := range lines {
+		line.Reset()
+		for x := 0; x < screen.Width(); x++ {
+			cell := screen.CellAt(x, y)
+			switch {
+			case cell == nil:
+				line.WriteByte(' ')
+			case cell.Content != "":
+				line.WriteString(cell.Content)
+			case cell.Width == 0:
+				// The trailing half of a wide character.
+			default:
+				line.WriteByte(' ')
+			}
+		}
+		lines[y] = strings.TrimRight(line.String(), " ")
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines lines a up against b through their longest common subsequence.
+// Lines in neither run of the subsequence are paired off as changed rows, and
+// whichever side has more left over shows them as removed or added.
+func diffLines(a, b []string) []CompareRow {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:], b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var rows []CompareRow
+	var removed, added []string
+	flush := func() {
+		paired := min(len(removed), len(added))
+		for k := range paired {
+			rows = append(rows, CompareRow{Op: CompareChanged, Left: removed[k], Right: added[k]})
+		}
+		for _, l := range removed[paired:] {
+			rows = append(rows, CompareRow{Op: CompareRemoved, Left: l})
+		}
+		for _, r := range added[paired:] {
+			rows = append(rows, CompareRow{Op: CompareAdded, Right: r})
+		}
+		removed, added = removed[:0], added[:0]
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			flush()
+			rows = append(rows, CompareRow{Op: CompareSame, Left: a[i], Right: b[j]})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			removed = append(removed, a[i])
+			i++
+		default:
+			added = append(added, b[j])
+			j++
+		}
+	}
+	flush()
+	return rows
+}
+
+// compareRowsShown is how many diff rows fit in the overlay.
+func (m *OS) compareRowsShown() int {
+	return max(m.GetRenderHeight()-10, 4)
+}
+
+// renderCompare draws the open comparison centered on screen: the two window
+// names over their columns, then the rows with changed lines highlighted.
+func (m *OS) renderCompare() *lipgloss.Layer {
+	c := m.Compare
+	if c == nil {
+		return nil
+	}
+
+	ui := theme.UI()
+	base := lipgloss.NewStyle().Background(ui.Surface).Foreground(ui.Fg)
+	dim := base.Foreground(ui.FgDim)
+
+	inner := max(min(m.GetRenderWidth()-8, 160), 20)
+	col := (inner - 3) / 2
+	cell := func(s string, style lipgloss.Style) string {
+		s = ansi.Truncate(s, col, "…")
+		return style.Render(s + strings.Repeat(" ", col-ansi.StringWidth(s)))
+	}
+	sep := dim.Render(" │ ")
+
+	lines := []string{
+		cell(c.TitleA, base.Foreground(ui.AccentBright).Bold(true)) + sep +
+			cell(c.TitleB, base.Foreground(ui.AccentBright).Bold(true)),
+		dim.Render(strings.Repeat("─", inner)),
+	}
+
+	shown := m.compareRowsShown()
+	c.Scroll = max(0, min(c.Scroll, max(len(c.Rows)-shown, 0)))
+	for _, r := range c.Rows[c.Scroll:min(c.Scroll+shown, len(c.Rows))] {
+		left, right := dim, dim
+		switch r.Op {
+		case CompareChanged:
+			left, right = base.Foreground(ui.Warning), base.Foreground(ui.Warning)
+		case CompareRemoved:
+			left = base.Foreground(ui.Warn)
+		case CompareAdded:
+			right = base.Foreground(ui.Success)
+		}
+		lines = append(lines, cell(r.Left, left)+sep+cell(r.Right, right))
+	}
+	if len(c.Rows) == 0 {
+		lines = append(lines, dim.Italic(true).Render("Both screens are empty"))
+	}
+
+	summary := "identical"
+	if n := c.Differences(); n > 0 {
+		summary = fmt.Sprintf("%d differing lines", n)
+	}
+	lines = append(lines, "", dim.Render(fmt.Sprintf("%s · j/k scroll · q close", summary)))
+
+	box := lipgloss.NewStyle().
+		Border(getBorder()).
+		BorderForeground(theme.HelpBorder()).
+		Background(ui.Surface).
+		Padding(1, 2).
+		Width(inner + 6).
+		Render(strings.Join(lines, "\n"))
+
+	return m.centeredBoxLayer(box, config.ZIndexLogs, "window-compare")
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestDiffLines checks the row alignment the side-by-side view shows: common
+// lines line up, a differing line pairs off as changed, and lines one screen
+// has and the other lacks appear on their own side only.
+func TestDiffLines(t *testing.T) {
+	a := []string{"$ make", "build ok", "test fail", "done"}
+	b := []string{"$ make", "build ok", "test ok", "lint ok", "done"}
+
+	want := []CompareRow{
+		{Op: CompareSame, Left: "$ make", Right: "$ make"},
+		{Op: CompareSame, Left: "build ok", Right: "build ok"},
+		{Op: CompareChanged, Left: "test fail", Right: "test ok"},
+		{Op: CompareAdded, Right: "lint ok"},
+		{Op: CompareSame, Left: "done", Right: "done"},
+	}
+	if got := diffLines(a, b); !reflect.DeepEqual(got, want) {
+		t.Errorf("diffLines =\n%+v\nwant\n%+v", got, want)
+	}
+
+	removed := diffLines([]string{"x", "y"}, []string{"y"})
+	if len(removed) != 2 || removed[0].Op != CompareRemoved || removed[0].Left != "x" {
+		t.Errorf("a line only on the first screen: got %+v", removed)
+	}
+}
+
+// TestComparePickOpensTheComparison walks the leader t d flow: two pane
+// numbers pick the windows, and the comparison reads their screens.
+func TestComparePickOpensTheComparison(t *testing.T) {
+	a := newTestWindow(t, "cmp-a", 40, 6)
+	b := newTestWindow(t, "cmp-b", 40, 6)
+	a.Workspace, b.Workspace = 1, 1
+	a.WriteOutput([]byte("same\r\nleft only"))
+	b.WriteOutput([]byte("same\r\nright only"))
+	m := newTestOS(a)
+	m.Windows = append(m.Windows, b)
+	m.CurrentWorkspace = 1
+
+	if !m.StartCompare() || !m.PaneNumbersVisible() {
+		t.Fatal("compare pick did not start with the pane numbers up")
+	}
+	if !m.ComparePick(1) || m.ComparePick(1) {
+		t.Fatal("first pick failed, or the same window was accepted twice")
+	}
+	if !m.ComparePick(2) {
+		t.Fatal("second pick did not open the comparison")
+	}
+	if m.ComparePicking || m.Compare == nil {
+		t.Fatal("comparison is not open after two picks")
+	}
+	if got := m.Compare.Differences(); got != 1 {
+		t.Errorf("differences = %d, want 1 (rows %+v)", got, m.Compare.Rows)
+	}
+
+	m.Width, m.Height = 100, 30
+	if m.renderCompare() == nil {
+		t.Error("open comparison rendered no overlay")
+	}
+
+	m.CloseCompare()
+	if m.Compare != nil {
+		t.Error("comparison still open after close")
+	}
+}
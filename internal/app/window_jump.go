@@ -0,0 +1,165 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/theme"
+)
+
+// windowJumpShown is how many matches the jump prompt lists under the query.
+const windowJumpShown = 5
+
+// StartWindowJump opens the jump-to-window prompt (Ctrl+B, f) with an empty
+// query. Typing narrows the windows of every workspace by name.
+func (m *OS) StartWindowJump() {
+	if len(m.Windows) == 0 {
+		return
+	}
+	m.JumpingToWindow = true
+	m.JumpBuffer = ""
+	m.JumpSelected = 0
+}
+
+// CancelWindowJump closes the prompt without moving.
+func (m *OS) CancelWindowJump() {
+	m.JumpingToWindow = false
+	m.JumpBuffer = ""
+	m.JumpSelected = 0
+}
+
+// SetWindowJumpQuery replaces the query and selects the best match again.
+func (m *OS) SetWindowJumpQuery(query string) {
+	m.JumpBuffer = query
+	m.JumpSelected = 0
+}
+
+// WindowJumpMove moves the selection through the matches by delta, wrapping.
+func (m *OS) WindowJumpMove(delta int) {
+	n := min(len(m.WindowJumpMatches()), windowJumpShown)
+	if n == 0 {
+		return
+	}
+	m.JumpSelected = ((m.JumpSelected+delta)%n + n) % n
+}
+
+// WindowJumpMatches returns the indices of the windows matching the query,
+// best first. A window matches on its custom name or its terminal title;
+// windows that score the same keep their creation order.
+func (m *OS) WindowJumpMatches() []int {
+	type match struct{ index, score int }
+	var matches []match
+	for i, w := range m.Windows {
+		score := max(windowJumpScore(m.JumpBuffer, w.CustomName), windowJumpScore(m.JumpBuffer, w.Title()))
+		if score >= 0 {
+			matches = append(matches, match{i, score})
+		}
+	}
+	sort.SliceStable(matches, func(a, b int) bool { return matches[a].score > matches[b].score })
+
+	indices := make([]int, len(matches))
+	for i, mt := range matches {
+		indices[i] = mt.index
+	}
+	return indices
+}
+
+// windowJumpScore scores name against the query, or returns -1 when the
+// query's characters do not all appear in it in order. Matches that are a
+// plain substring, start early and have fewer gaps score higher.
+func windowJumpScore(query, name string) int {
+	if name == "" {
+		return -1
+	}
+	matched, indices := FuzzyMatch(query, name)
+	if !matched {
+		return -1
+	}
+	if len(indices) == 0 {
+		return 0
+	}
+	score := 1000
+	if strings.Contains(strings.ToLower(name), strings.ToLower(query)) {
+		score += 500
+	}
+	first, last := indices[0], indices[len(indices)-1]
+	score -= 2 * first
+	score -= last - first + 1 - len(indices)
+	return max(score, 1)
+}
+
+// ConfirmWindowJump goes to the selected match: its workspace becomes the
+// current one, a minimized window is restored, and the window is focused.
+// It reports whether there was a match to go to.
+func (m *OS) ConfirmWindowJump() bool {
+	matches := m.WindowJumpMatches()
+	selected := m.JumpSelected
+	m.CancelWindowJump()
+	if len(matches) == 0 {
+		return false
+	}
+	target := m.Windows[matches[min(selected, len(matches)-1)]]
+
+	if target.Workspace != m.CurrentWorkspace {
+		m.SwitchToWorkspace(target.Workspace)
+	}
+	for i, w := range m.Windows {
+		if w != target {
+			continue
+		}
+		if w.Minimized {
+			m.RestoreWindow(i)
+		}
+		m.FocusWindow(i)
+		break
+	}
+	return true
+}
+
+// renderWindowJump draws the prompt centered near the top of the screen: the
+// query, then the best matches with their workspace, the selected one
+// highlighted.
+func (m *OS) renderWindowJump() *lipgloss.Layer {
+	if !m.JumpingToWindow {
+		return nil
+	}
+
+	ui := theme.UI()
+	card := lipgloss.NewStyle().Foreground(ui.Fg).Background(ui.Card)
+	dim := card.Foreground(ui.FgDim)
+
+	lines := []string{
+		card.Foreground(ui.AccentBright).Bold(true).Render("Jump to window: ") + card.Render(m.JumpBuffer+"▏"),
+	}
+	matches := m.WindowJumpMatches()
+	if len(matches) == 0 {
+		lines = append(lines, dim.Render("no matching window"))
+	}
+	for i, idx := range matches[:min(len(matches), windowJumpShown)] {
+		w := m.Windows[idx]
+		name := truncateString(m.getWindowDisplayName(w), 40)
+		where := fmt.Sprintf("  workspace %d", w.Workspace)
+		if i == m.JumpSelected {
+			lines = append(lines, card.Foreground(ui.AccentBright).Background(ui.RowSel).Render("› "+name+where))
+		} else {
+			lines = append(lines, card.Render("  "+name)+dim.Render(where))
+		}
+	}
+	lines = append(lines, dim.Render("↑/↓ select · enter jump · esc cancel"))
+
+	box := lipgloss.NewStyle().
+		Background(ui.Card).
+		Padding(0, 2).
+		Render(strings.Join(lines, "\n"))
+
+	x := (m.GetRenderWidth() - lipgloss.Width(box)) / 2
+	y := m.GetTopMargin() + 1
+	return lipgloss.NewLayer(box).
+		X(max(x, 0)).
+		Y(max(y, 0)).
+		Z(config.ZIndexWindowJump).
+		ID("window-jump")
+}
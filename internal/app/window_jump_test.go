@@ -0,0 +1,47 @@
+package app
+
+import "testing"
+
+// TestWindowJump checks that the prompt ranks a contiguous match above a
+// scattered one, and that confirming switches to the match's workspace and
+// focuses it.
+func TestWindowJump(t *testing.T) {
+	onion := newTestWindow(t, "window-a", 40, 10)
+	onion.CustomName = "lazy-onion-git"
+	onion.Workspace = 1
+	server := newTestWindow(t, "window-b", 40, 10)
+	server.CustomName = "api logs"
+	server.Workspace = 3
+
+	m := newTestOS(onion)
+	m.Windows = append(m.Windows, server)
+	m.CurrentWorkspace = 1
+	m.Width, m.Height = 80, 24
+
+	m.StartWindowJump()
+	m.SetWindowJumpQuery("log")
+	matches := m.WindowJumpMatches()
+	if len(matches) != 2 || matches[0] != 1 {
+		t.Fatalf("matches for %q = %v, want the substring match (1) first", "log", matches)
+	}
+
+	m.SetWindowJumpQuery("xyz")
+	if matches := m.WindowJumpMatches(); len(matches) != 0 {
+		t.Fatalf("matches for %q = %v, want none", "xyz", matches)
+	}
+	if m.ConfirmWindowJump() {
+		t.Error("confirming with no match reported a jump")
+	}
+
+	m.StartWindowJump()
+	m.SetWindowJumpQuery("api")
+	if !m.ConfirmWindowJump() {
+		t.Fatal("no jump for a matching query")
+	}
+	if m.JumpingToWindow {
+		t.Error("prompt still open after jumping")
+	}
+	if m.CurrentWorkspace != 3 || m.FocusedWindow != 1 {
+		t.Errorf("after jump: workspace %d, focused %d; want workspace 3, window 1", m.CurrentWorkspace, m.FocusedWindow)
+	}
+}
@@ -0,0 +1,50 @@
+package app
+
+import (
+	"slices"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// applyWindowRule applies the first window rule matching command to a window
+// that has just been given its initial placement, and reports whether one
+// matched. A rule's size recentres the window at that size; tiling still owns
+// the geometry of a window the rule leaves tiled. It is safe to apply the same
+// rule to the same window more than once.
+func (m *OS) applyWindowRule(w *terminal.Window, command string) bool {
+	rule, ok := config.MatchWindowRule(command)
+	if !ok {
+		return false
+	}
+
+	if rule.Floating || rule.Sticky {
+		w.IsFloating = true
+		w.Tiled = false
+	}
+	if rule.Sticky {
+		w.IsPinned = true
+	}
+
+	if rule.Width != "" || rule.Height != "" {
+		screenWidth, screenHeight := m.GetRenderWidth(), m.GetUsableHeight()
+		if screenWidth > 0 && screenHeight > 0 {
+			width, height := w.Width, w.Height
+			if rule.Width != "" {
+				width = config.ResolveWindowSize(rule.Width, screenWidth, config.DefaultWindowWidth)
+			}
+			if rule.Height != "" {
+				height = config.ResolveWindowSize(rule.Height, screenHeight, config.DefaultWindowHeight)
+			}
+			w.X, w.Y = (screenWidth-width)/2, (screenHeight-height)/2
+			w.Resize(width, height)
+		}
+	}
+
+	if rule.Workspace > 0 && rule.Workspace != w.Workspace {
+		m.MoveWindowToWorkspace(slices.Index(m.Windows, w), rule.Workspace)
+	}
+
+	w.InvalidateCache()
+	return true
+}
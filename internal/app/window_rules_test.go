@@ -0,0 +1,110 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/session"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestWindowRulesOnPlacement checks that a window the daemon created with a
+// matching command picks up its rule when this client places it, and that a
+// sticky window follows the view across workspace switches.
+func TestWindowRulesOnPlacement(t *testing.T) {
+	prevRules, prevDock := config.WindowRules, config.DockbarPosition
+	defer func() { config.WindowRules, config.DockbarPosition = prevRules, prevDock }()
+	config.DockbarPosition = "hidden"
+	config.WindowRules = []config.WindowRule{
+		{Match: "*top", Sticky: true, Width: "40", Height: "50%"},
+		{Match: "make", Workspace: 3},
+	}
+
+	main := newTestWindow(t, "rules-main-0001", 20, 10)
+	monitor := newTestWindow(t, "rules-top-0001", 20, 10)
+	build := newTestWindow(t, "rules-make-0001", 20, 10)
+	for _, w := range []*terminal.Window{main, monitor, build} {
+		w.Workspace = 1
+	}
+	m := newTestOS(main)
+	m.Windows = append(m.Windows, monitor, build)
+	m.CurrentWorkspace = 1
+	m.Width, m.Height = 120, 40
+
+	state := &session.SessionState{Windows: []session.WindowState{
+		{ID: main.ID},
+		{ID: monitor.ID, Unplaced: true, Command: "/usr/bin/btop --utf-force"},
+		{ID: build.ID, Unplaced: true, Command: "make watch"},
+	}}
+	if !m.placeUnplacedWindows(state) {
+		t.Fatal("placeUnplacedWindows placed nothing")
+	}
+
+	if !monitor.IsFloating || !monitor.IsPinned {
+		t.Errorf("btop window floating=%v pinned=%v, want a sticky floating window", monitor.IsFloating, monitor.IsPinned)
+	}
+	if monitor.Width != 40 || monitor.Height != 20 {
+		t.Errorf("btop window is %dx%d, want 40x20", monitor.Width, monitor.Height)
+	}
+	if build.Workspace != 3 {
+		t.Errorf("make window is on workspace %d, want 3", build.Workspace)
+	}
+	if main.IsFloating || main.Workspace != 1 {
+		t.Errorf("window without a command changed: floating=%v workspace=%d", main.IsFloating, main.Workspace)
+	}
+
+	m.SwitchToWorkspace(2)
+	if monitor.Workspace != 2 {
+		t.Errorf("sticky window stayed on workspace %d after switching to 2", monitor.Workspace)
+	}
+	if main.Workspace != 1 {
+		t.Errorf("non-sticky window moved to workspace %d", main.Workspace)
+	}
+}
+
+// TestWindowRulesOnLocalSpawn checks that a local window started with a
+// matching command, as a layout template starts its windows, picks up its
+// rule: a floating rule keeps it out of the tiling layout and a workspace rule
+// opens it there without taking focus.
+func TestWindowRulesOnLocalSpawn(t *testing.T) {
+	prevRules := config.WindowRules
+	defer func() { config.WindowRules = prevRules }()
+	config.WindowRules = []config.WindowRule{
+		{Match: "*top", Floating: true, Width: "40", Height: "20"},
+		{Match: "make", Workspace: 3},
+	}
+
+	m := newStartupOS(t, false, false)
+	defer closeWindows(m)
+	m.AutoTiling = true
+
+	m.AddWindow("shell")
+	shell := m.Windows[0]
+
+	m.addWindow("monitor", "btop", []string{"--utf-force"})
+	monitor := m.Windows[1]
+	if monitor.SpawnCommandLine() != "btop --utf-force" {
+		t.Errorf("spawn command = %q, want it recorded", monitor.SpawnCommandLine())
+	}
+	if !monitor.IsFloating || monitor.Width != 40 || monitor.Height != 20 {
+		t.Errorf("btop window floating=%v size %dx%d, want a floating 40x20 window",
+			monitor.IsFloating, monitor.Width, monitor.Height)
+	}
+	if shell.Width != m.GetRenderWidth() {
+		t.Errorf("shell width %d, want the whole screen with the btop window floating", shell.Width)
+	}
+
+	m.addWindow("build", "make", []string{"watch"})
+	build := m.Windows[2]
+	if build.Workspace != 3 {
+		t.Errorf("make window is on workspace %d, want 3", build.Workspace)
+	}
+	if m.GetFocusedWindow() == build {
+		t.Error("a window sent to another workspace took focus")
+	}
+
+	m.AddWindow("plain")
+	if plain := m.Windows[3]; plain.IsFloating || plain.Workspace != m.CurrentWorkspace {
+		t.Errorf("window without a command changed: floating=%v workspace=%d", plain.IsFloating, plain.Workspace)
+	}
+}
@@ -3,6 +3,7 @@ package app
 import (
 	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/hooks"
+	"github.com/Gaurav-Gosain/tuios/internal/layout"
 	"github.com/Gaurav-Gosain/tuios/internal/tape"
 	"github.com/Gaurav-Gosain/tuios/internal/terminal"
 	"github.com/Gaurav-Gosain/tuios/internal/ui"
@@ -17,6 +18,10 @@ func (m *OS) SwitchToWorkspace(workspace int) {
 		return
 	}
 
+	// Dynamic workspaces only go as far as the empty one after the last
+	// populated workspace; asking for one past it lands there.
+	workspace = min(workspace, m.workspaceLimit())
+
 	if workspace == m.CurrentWorkspace {
 		return
 	}
@@ -26,6 +31,11 @@ func (m *OS) SwitchToWorkspace(workspace int) {
 		m.TapeRecorder.RecordWorkspaceSwitch(workspace)
 	}
 
+	// Peeking at the workspace being switched to has nothing left to show.
+	if m.PeekedWorkspace == workspace {
+		m.PeekedWorkspace = 0
+	}
+
 	oldWorkspace := m.CurrentWorkspace
 	windowsInNew := m.GetWorkspaceWindowCount(workspace)
 	m.LogInfo("Switching workspace: %d → %d (%d windows)", oldWorkspace, workspace, windowsInNew)
@@ -33,6 +43,16 @@ func (m *OS) SwitchToWorkspace(workspace int) {
 	// Clear all animations BEFORE switching to prevent windows from getting stuck mid-animation
 	// Then directly position windows to correct tiled layout WITHOUT creating new animations
 	if len(m.Animations) > 0 {
+		// Open and close animations are the exception: a window cancelled mid-open
+		// would stay a tiny box, and one cancelled mid-close would never go away.
+		m.finishCloseAnimations()
+		for _, anim := range m.Animations {
+			if anim.Type == ui.AnimationOpen {
+				anim.Window.X, anim.Window.Y = anim.EndX, anim.EndY
+				anim.Window.Resize(anim.EndWidth, anim.EndHeight)
+				anim.Window.MarkPositionDirty()
+			}
+		}
 		m.Animations = m.Animations[:0] // Cancel all animations without snapping to potentially wrong end positions
 		m.LogInfo("Cancelled animations during workspace switch")
 
@@ -70,6 +90,13 @@ func (m *OS) SwitchToWorkspace(workspace int) {
 	}
 	m.SaveCurrentLayout() // Save layout before switching
 
+	// Sticky windows come along to the new workspace.
+	for _, w := range m.Windows {
+		if w.IsPinned && w.Workspace == oldWorkspace {
+			w.Workspace = workspace
+		}
+	}
+
 	// Unsubscribe from old workspace PTYs and subscribe to new workspace PTYs
 	// This optimization reduces network traffic by only streaming output for visible windows
 	if m.IsDaemonSession && m.DaemonClient != nil {
@@ -144,6 +171,11 @@ func (m *OS) SwitchToWorkspace(workspace int) {
 		}
 	}
 
+	// Leaving an empty workspace removes it under dynamic workspaces, which
+	// can renumber the one we just switched to.
+	m.pruneEmptyWorkspaces()
+	workspace = m.CurrentWorkspace
+
 	// Sync state to daemon after workspace switch
 	m.SyncStateToDaemon()
 
@@ -172,6 +204,7 @@ func (m *OS) MoveWindowToWorkspace(windowIndex int, workspace int) {
 		m.LogWarn("Cannot move window: workspace %d out of range (1-%d)", workspace, m.NumWorkspaces)
 		return
 	}
+	workspace = min(workspace, m.workspaceLimit())
 
 	window := m.Windows[windowIndex]
 	oldWorkspace := window.Workspace
@@ -207,6 +240,8 @@ func (m *OS) MoveWindowToWorkspace(windowIndex int, workspace int) {
 		// Mark as non-custom so it can be retiled later if needed
 		m.WorkspaceHasCustom[m.CurrentWorkspace] = false
 	}
+
+	m.pruneEmptyWorkspaces()
 }
 
 // MoveWindowToWorkspaceAndFollow moves a window to the specified workspace and switches to that workspace.
@@ -217,6 +252,7 @@ func (m *OS) MoveWindowToWorkspaceAndFollow(windowIndex int, workspace int) {
 	if workspace < 1 || workspace > m.NumWorkspaces {
 		return
 	}
+	workspace = min(workspace, m.workspaceLimit())
 
 	window := m.Windows[windowIndex]
 	oldWorkspace := window.Workspace
@@ -258,12 +294,78 @@ func (m *OS) MoveWindowToWorkspaceAndFollow(windowIndex int, workspace int) {
 	}
 }
 
+// MergeWorkspace moves every window in src to dst, leaving src empty, and
+// returns how many windows moved. In BSP mode the moved windows are inserted
+// into dst's tree in the order they were opened, after dst's own windows, so
+// dst keeps its splits and the newcomers split off the end of it. When src is
+// the current workspace the view follows the windows to dst.
+func (m *OS) MergeWorkspace(src, dst int) int {
+	if src == dst || src < 1 || dst < 1 || src > m.NumWorkspaces || dst > m.NumWorkspaces {
+		return 0
+	}
+
+	var moved []*terminal.Window
+	for _, w := range m.Windows {
+		if w.Workspace == src {
+			moved = append(moved, w)
+		}
+	}
+	if len(moved) == 0 {
+		return 0
+	}
+	m.LogInfo("Merging workspace %d into %d (%d windows)", src, dst, len(moved))
+
+	if tree := m.WorkspaceTrees[dst]; tree != nil && !tree.IsEmpty() {
+		bounds := m.GetBSPBounds()
+		for _, w := range moved {
+			if w.Minimized || w.IsFloating {
+				continue
+			}
+			ids := tree.GetAllWindowIDs()
+			tree.InsertWindow(m.getWindowIntID(w.ID), ids[len(ids)-1], layout.SplitNone, 1-config.DefaultSplitRatio, bounds)
+		}
+	}
+
+	for _, w := range moved {
+		w.Workspace = dst
+		w.MarkPositionDirty()
+	}
+	delete(m.WorkspaceTrees, src)
+	delete(m.WorkspaceScrollingLayouts, src)
+	delete(m.WorkspaceLayouts, src)
+	delete(m.WorkspaceHasCustom, src)
+	delete(m.WorkspaceFocus, src)
+	delete(m.WorkspaceLastFocus, src)
+	// dst's saved layout does not know about the newcomers.
+	m.WorkspaceHasCustom[dst] = false
+
+	switch {
+	case src == m.CurrentWorkspace:
+		m.FocusedWindow = -1
+		m.SwitchToWorkspace(dst)
+	case dst == m.CurrentWorkspace:
+		if m.IsDaemonSession {
+			m.SubscribeWorkspaceWindows(dst)
+		}
+		if m.AutoTiling {
+			m.TileAllWindows()
+		}
+		m.MarkAllDirty()
+		m.pruneEmptyWorkspaces()
+		m.SyncStateToDaemon()
+	default:
+		m.pruneEmptyWorkspaces()
+		m.SyncStateToDaemon()
+	}
+	return len(moved)
+}
+
 // FocusNextVisibleWindowInWorkspace focuses the next visible window in the workspace.
 func (m *OS) FocusNextVisibleWindowInWorkspace() {
 	// Find the next non-minimized window in current workspace to focus
 	for i := range m.Windows {
 		w := m.Windows[i]
-		if w.Workspace == m.CurrentWorkspace && !w.Minimized && !w.Minimizing {
+		if w.Workspace == m.CurrentWorkspace && !w.Minimized && !w.Minimizing && !w.Closing {
 			m.FocusWindow(i)
 			return
 		}
@@ -280,7 +382,7 @@ func (m *OS) FocusNextVisibleWindowInWorkspace() {
 func (m *OS) GetVisibleWindows() []*terminal.Window {
 	visible := make([]*terminal.Window, 0)
 	for _, w := range m.Windows {
-		if w.Workspace == m.CurrentWorkspace && !w.Minimized && !w.Minimizing {
+		if w.Workspace == m.CurrentWorkspace && !w.Minimized && !w.Minimizing && !w.Closing {
 			visible = append(visible, w)
 		}
 	}
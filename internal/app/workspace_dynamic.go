@@ -0,0 +1,81 @@
+package app
+
+import "github.com/Gaurav-Gosain/tuios/internal/config"
+
+// Dynamic workspaces (config.DynamicWorkspaces) replace the fixed row of nine
+// with GNOME-style ones: a workspace exists while it holds windows, and there is
+// always one empty workspace after the last populated one to move windows to.
+// When a workspace empties out and is left, the ones after it shift down a
+// number so the populated workspaces stay contiguous from 1.
+
+// workspaceLimit returns the highest workspace that can be switched or moved
+// to. Statically that is NumWorkspaces; dynamically it is the empty workspace
+// just past the last populated one (or the current one, if that is further).
+func (m *OS) workspaceLimit() int {
+	if !config.DynamicWorkspaces {
+		return m.NumWorkspaces
+	}
+	highest := 0
+	for _, w := range m.Windows {
+		highest = max(highest, w.Workspace)
+	}
+	return min(max(highest+1, m.CurrentWorkspace), m.NumWorkspaces)
+}
+
+// pruneEmptyWorkspaces removes empty workspaces other than the current one by
+// renumbering the workspaces after them down, along with everything the OS
+// keeps per workspace. It does nothing unless dynamic workspaces are on.
+func (m *OS) pruneEmptyWorkspaces() {
+	if !config.DynamicWorkspaces {
+		return
+	}
+
+	keep := map[int]bool{m.CurrentWorkspace: true}
+	for _, w := range m.Windows {
+		keep[w.Workspace] = true
+	}
+	remap := make(map[int]int)
+	changed := false
+	next := 1
+	for ws := 1; ws <= m.NumWorkspaces; ws++ {
+		if !keep[ws] {
+			continue
+		}
+		remap[ws] = next
+		changed = changed || ws != next
+		next++
+	}
+	if !changed {
+		return
+	}
+
+	for _, w := range m.Windows {
+		if to, ok := remap[w.Workspace]; ok && to != w.Workspace {
+			w.Workspace = to
+			w.MarkPositionDirty()
+		}
+	}
+	m.CurrentWorkspace = remap[m.CurrentWorkspace]
+	m.WorkspaceFocus = remapWorkspaces(m.WorkspaceFocus, remap)
+	m.WorkspaceLastFocus = remapWorkspaces(m.WorkspaceLastFocus, remap)
+	m.WorkspaceLayouts = remapWorkspaces(m.WorkspaceLayouts, remap)
+	m.WorkspaceHasCustom = remapWorkspaces(m.WorkspaceHasCustom, remap)
+	m.WorkspaceMasterRatio = remapWorkspaces(m.WorkspaceMasterRatio, remap)
+	m.WorkspaceTrees = remapWorkspaces(m.WorkspaceTrees, remap)
+	m.WorkspaceScrollingLayouts = remapWorkspaces(m.WorkspaceScrollingLayouts, remap)
+	m.LogInfo("Dynamic workspaces: removed empty workspaces, now %d", next-1)
+}
+
+// remapWorkspaces rekeys a per-workspace map, dropping removed workspaces.
+func remapWorkspaces[V any](src map[int]V, remap map[int]int) map[int]V {
+	if src == nil {
+		return nil
+	}
+	out := make(map[int]V, len(src))
+	for ws, v := range src {
+		if to, ok := remap[ws]; ok {
+			out[to] = v
+		}
+	}
+	return out
+}
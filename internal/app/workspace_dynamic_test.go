@@ -0,0 +1,56 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestDynamicWorkspaces checks that only the populated workspaces plus one
+// empty one can be reached, and that a workspace emptied by moving its last
+// window away is removed once left, renumbering the workspace after it along
+// with what the OS remembers about it.
+func TestDynamicWorkspaces(t *testing.T) {
+	prev := config.DynamicWorkspaces
+	defer func() { config.DynamicWorkspaces = prev }()
+	config.DynamicWorkspaces = true
+
+	m := &OS{
+		NumWorkspaces:        config.MaxWorkspaces,
+		CurrentWorkspace:     2,
+		FocusedWindow:        1,
+		WorkspaceFocus:       map[int]int{},
+		WorkspaceLayouts:     map[int][]WindowLayout{},
+		WorkspaceMasterRatio: map[int]float64{3: 0.7},
+		WorkspaceHasCustom:   map[int]bool{},
+	}
+	m.Windows = []*terminal.Window{
+		{ID: "window-a", Workspace: 1},
+		{ID: "window-b", Workspace: 2},
+		{ID: "window-c", Workspace: 3},
+	}
+
+	if got := m.workspaceLimit(); got != 4 {
+		t.Fatalf("workspace limit = %d, want 4 (three populated plus one empty)", got)
+	}
+
+	m.MoveWindowToWorkspace(1, 1)
+	if m.CurrentWorkspace != 2 {
+		t.Fatalf("current workspace removed while still on it: now %d", m.CurrentWorkspace)
+	}
+
+	m.SwitchToWorkspace(3)
+	if m.CurrentWorkspace != 2 || m.Windows[2].Workspace != 2 {
+		t.Errorf("after leaving the empty workspace: current %d, window-c on %d; want both 2",
+			m.CurrentWorkspace, m.Windows[2].Workspace)
+	}
+	if m.WorkspaceMasterRatio[2] != 0.7 {
+		t.Errorf("per-workspace state not renumbered with the workspace: %v", m.WorkspaceMasterRatio)
+	}
+
+	m.SwitchToWorkspace(9)
+	if m.CurrentWorkspace != 3 {
+		t.Errorf("switching far past the last workspace landed on %d, want the empty 3", m.CurrentWorkspace)
+	}
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/layout"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestMergeWorkspace checks that merging moves every window on the source
+// workspace, appends them to the target's BSP tree in the order they were
+// opened without disturbing the target's own windows, forgets the source's
+// per-workspace state, and takes the view along when the source was current.
+func TestMergeWorkspace(t *testing.T) {
+	m := &OS{
+		NumWorkspaces:      9,
+		CurrentWorkspace:   1,
+		FocusedWindow:      0,
+		Width:              120,
+		Height:             40,
+		WorkspaceFocus:     map[int]int{1: 1, 3: 0},
+		WorkspaceLayouts:   map[int][]WindowLayout{1: {{WindowID: "window-a"}}},
+		WorkspaceHasCustom: map[int]bool{1: true, 3: true},
+		WorkspaceTrees:     map[int]*layout.BSPTree{},
+	}
+	m.Windows = []*terminal.Window{
+		{ID: "window-a", Workspace: 1},
+		{ID: "window-t", Workspace: 3},
+		{ID: "window-b", Workspace: 1},
+	}
+
+	dst := layout.NewBSPTree()
+	dst.InsertWindow(m.getWindowIntID("window-t"), 0, layout.SplitNone, 0.5, m.GetBSPBounds())
+	m.WorkspaceTrees[3] = dst
+	m.WorkspaceTrees[1] = layout.NewBSPTree()
+
+	if got := m.MergeWorkspace(1, 1); got != 0 {
+		t.Fatalf("merging a workspace into itself moved %d windows", got)
+	}
+	if got := m.MergeWorkspace(1, 3); got != 2 {
+		t.Fatalf("moved %d windows, want 2", got)
+	}
+
+	for _, w := range m.Windows {
+		if w.Workspace != 3 {
+			t.Errorf("%s left on workspace %d", w.ID, w.Workspace)
+		}
+	}
+	want := []int{m.getWindowIntID("window-t"), m.getWindowIntID("window-a"), m.getWindowIntID("window-b")}
+	if got := dst.GetAllWindowIDs(); !slices.Equal(got, want) {
+		t.Errorf("target tree holds %v, want %v (target's own window first, then in open order)", got, want)
+	}
+	if _, ok := m.WorkspaceTrees[1]; ok {
+		t.Error("source workspace kept its BSP tree")
+	}
+	if _, ok := m.WorkspaceLayouts[1]; ok {
+		t.Error("source workspace kept its saved layout")
+	}
+	if m.WorkspaceHasCustom[3] {
+		t.Error("target workspace kept a custom layout that does not include the merged windows")
+	}
+	if m.CurrentWorkspace != 3 {
+		t.Errorf("view stayed on workspace %d, want it to follow to 3", m.CurrentWorkspace)
+	}
+}
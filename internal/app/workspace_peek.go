@@ -0,0 +1,233 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+	"github.com/Gaurav-Gosain/tuios/internal/theme"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// PeekWorkspace shows workspace n in half of the screen beside the current
+// one (leader w p), until ClosePeek. Nothing is switched: keys still go to the
+// current workspace's focused window. It reports false for the current
+// workspace or one out of range.
+func (m *OS) PeekWorkspace(n int) bool {
+	if n < 1 || n > m.NumWorkspaces || n == m.CurrentWorkspace {
+		return false
+	}
+	m.PeekedWorkspace = n
+	m.MarkAllDirty()
+	return true
+}
+
+// ClosePeek hides the peeked workspace.
+func (m *OS) ClosePeek() {
+	if m.PeekedWorkspace == 0 {
+		return
+	}
+	m.PeekedWorkspace = 0
+	m.MarkAllDirty()
+}
+
+// peekOnLeft reports whether the peek panel covers the left half of the
+// screen. With config.PeekSide auto it takes the half the focused window is
+// mostly not in, so the window being typed into stays in view.
+func (m *OS) peekOnLeft() bool {
+	switch config.PeekSide {
+	case config.PeekSideLeft:
+		return true
+	case config.PeekSideRight:
+		return false
+	}
+	if fw := m.GetFocusedWindow(); fw != nil {
+		return fw.X+fw.Width/2 >= m.GetRenderWidth()/2
+	}
+	return false
+}
+
+// peekCell is one cell of the peek panel's miniature workspace.
+type peekCell struct {
+	r    string
+	kind int // peekContent, peekFrame or peekFocusFrame
+}
+
+const (
+	peekContent = iota
+	peekFrame
+	peekFocusFrame
+)
+
+// peekGrid is the miniature the peek panel draws, one cell per screen cell.
+type peekGrid struct {
+	w, h  int
+	cells [][]peekCell
+}
+
+func newPeekGrid(w, h int) *peekGrid {
+	g := &peekGrid{w: w, h: h, cells: make([][]peekCell, h)}
+	for y := range g.cells {
+		g.cells[y] = make([]peekCell, w)
+		for x := range g.cells[y] {
+			g.cells[y][x] = peekCell{r: " "}
+		}
+	}
+	return g
+}
+
+func (g *peekGrid) set(x, y int, r string, kind int) {
+	if x >= 0 && x < g.w && y >= 0 && y < g.h {
+		g.cells[y][x] = peekCell{r: r, kind: kind}
+	}
+}
+
+// window draws a miniature window: a frame with the title in its top edge and
+// as much of the end of its screen as fits inside, covering whatever it sits
+// over.
+func (g *peekGrid) window(x, y, w, h int, title string, lines []string, kind int) {
+	b := lipgloss.NormalBorder()
+	if config.UseASCIIOnly {
+		b = lipgloss.ASCIIBorder()
+	}
+	for yy := y; yy < y+h; yy++ {
+		for xx := x; xx < x+w; xx++ {
+			g.set(xx, yy, " ", peekContent)
+		}
+	}
+	for xx := x + 1; xx < x+w-1; xx++ {
+		g.set(xx, y, b.Top, kind)
+		g.set(xx, y+h-1, b.Bottom, kind)
+	}
+	for yy := y + 1; yy < y+h-1; yy++ {
+		g.set(x, yy, b.Left, kind)
+		g.set(x+w-1, yy, b.Right, kind)
+	}
+	g.set(x, y, b.TopLeft, kind)
+	g.set(x+w-1, y, b.TopRight, kind)
+	g.set(x, y+h-1, b.BottomLeft, kind)
+	g.set(x+w-1, y+h-1, b.BottomRight, kind)
+	g.text(x+1, y, ansi.Truncate(title, w-2, "…"), kind)
+
+	rows := h - 2
+	if len(lines) > rows {
+		lines = lines[len(lines)-rows:]
+	}
+	for i, line := range lines {
+		g.text(x+1, y+1+i, ansi.Truncate(line, w-2, ""), peekContent)
+	}
+}
+
+// text writes s from x, y, giving a wide character two cells.
+func (g *peekGrid) text(x, y int, s string, kind int) {
+	for _, r := range s {
+		c := string(r)
+		g.set(x, y, c, kind)
+		if ansi.StringWidth(c) == 2 {
+			x++
+			g.set(x, y, "", kind)
+		}
+		x++
+	}
+}
+
+// peekWindows returns the windows on workspace n that would be on screen
+// there, bottom of the stack first.
+func (m *OS) peekWindows(n int) []*terminal.Window {
+	var ws []*terminal.Window
+	for _, w := range m.Windows {
+		if w.Workspace == n && !w.Minimized && !w.Minimizing && !w.Closing {
+			ws = append(ws, w)
+		}
+	}
+	slices.SortStableFunc(ws, func(a, b *terminal.Window) int { return a.Z - b.Z })
+	return ws
+}
+
+// renderPeek draws the peeked workspace over half of the screen: its windows
+// scaled down to fit, each showing the end of its screen, redrawn every frame
+// so their output stays live.
+func (m *OS) renderPeek() *lipgloss.Layer {
+	n := m.PeekedWorkspace
+	if n == 0 || n == m.CurrentWorkspace {
+		return nil
+	}
+	screenW, screenH := m.GetRenderWidth(), m.GetUsableHeight()
+	panelW := screenW / 2
+	if panelW < 20 || screenH < 8 {
+		return nil
+	}
+	innerW, innerH := panelW-2, screenH-3
+	top := m.GetTopMargin()
+
+	var focusedID string
+	if i, ok := m.WorkspaceFocus[n]; ok && i >= 0 && i < len(m.Windows) {
+		focusedID = m.Windows[i].ID
+	}
+
+	grid := newPeekGrid(innerW, innerH)
+	windows := m.peekWindows(n)
+	for _, w := range windows {
+		x0 := w.X * innerW / screenW
+		y0 := (w.Y - top) * innerH / screenH
+		x1 := (w.X + w.Width) * innerW / screenW
+		y1 := (w.Y + w.Height - top) * innerH / screenH
+		kind := peekFrame
+		if w.ID == focusedID {
+			kind = peekFocusFrame
+		}
+		grid.window(x0, y0, max(x1-x0, 4), max(y1-y0, 3), m.getWindowDisplayName(w), screenLines(w), kind)
+	}
+
+	ui := theme.UI()
+	base := lipgloss.NewStyle().Background(ui.Surface).Foreground(ui.Fg)
+	styles := map[int]lipgloss.Style{
+		peekContent:    base,
+		peekFrame:      base.Foreground(ui.FgDim),
+		peekFocusFrame: base.Foreground(ui.AccentBright),
+	}
+
+	header := fmt.Sprintf("Workspace %d · peek · leader w p to close", n)
+	if len(windows) == 0 {
+		header = fmt.Sprintf("Workspace %d is empty · leader w p to close", n)
+	}
+	header = ansi.Truncate(header, innerW, "…")
+	lines := []string{base.Foreground(ui.AccentBright).Bold(true).Render(header + strings.Repeat(" ", innerW-ansi.StringWidth(header)))}
+	for _, row := range grid.cells {
+		lines = append(lines, renderPeekRow(row, styles))
+	}
+
+	box := lipgloss.NewStyle().
+		Border(getBorder()).
+		BorderForeground(theme.HelpBorder()).
+		Background(ui.Surface).
+		Render(strings.Join(lines, "\n"))
+
+	x := screenW - panelW
+	if m.peekOnLeft() {
+		x = 0
+	}
+	return lipgloss.NewLayer(box).X(x).Y(top).Z(config.ZIndexPeek).ID("workspace-peek")
+}
+
+// renderPeekRow styles a row of the miniature, one style per run of cells
+// of the same kind.
+func renderPeekRow(row []peekCell, styles map[int]lipgloss.Style) string {
+	var out, run strings.Builder
+	kind := -1
+	for _, c := range row {
+		if c.kind != kind && run.Len() > 0 {
+			out.WriteString(styles[kind].Render(run.String()))
+			run.Reset()
+		}
+		kind = c.kind
+		run.WriteString(c.r)
+	}
+	if run.Len() > 0 {
+		out.WriteString(styles[kind].Render(run.String()))
+	}
+	return out.String()
+}
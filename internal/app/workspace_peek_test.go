@@ -0,0 +1,54 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// TestPeekShowsTheOtherWorkspace renders a peek at workspace 2 and checks the
+// panel holds that workspace's window and its output, on the half away from
+// the focused window.
+func TestPeekShowsTheOtherWorkspace(t *testing.T) {
+	prev := config.PeekSide
+	config.PeekSide = config.PeekSideAuto
+	t.Cleanup(func() { config.PeekSide = prev })
+
+	here := newTestWindow(t, "peek-here", 50, 20)
+	there := newTestWindow(t, "peek-there", 60, 20)
+	here.Workspace, there.Workspace = 1, 2
+	there.CustomName = "build"
+	there.WriteOutput([]byte("compiling\r\nall done"))
+	m := newTestOS(here)
+	m.CurrentWorkspace = 1
+	m.Width, m.Height = 120, 40
+	m.Windows = append(m.Windows, there)
+
+	if m.PeekWorkspace(1) {
+		t.Error("peeking at the current workspace should be refused")
+	}
+	if !m.PeekWorkspace(2) {
+		t.Fatal("PeekWorkspace(2) refused")
+	}
+
+	layer := m.renderPeek()
+	if layer == nil {
+		t.Fatal("no peek panel rendered")
+	}
+	panel := ansi.Strip(layer.GetContent())
+	for _, want := range []string{"Workspace 2", "build", "all done"} {
+		if !strings.Contains(panel, want) {
+			t.Errorf("peek panel is missing %q:\n%s", want, panel)
+		}
+	}
+	if layer.GetX() == 0 {
+		t.Error("peek covers the left half, where the focused window is")
+	}
+
+	m.SwitchToWorkspace(2)
+	if m.PeekedWorkspace != 0 {
+		t.Error("switching to the peeked workspace should close the peek")
+	}
+}
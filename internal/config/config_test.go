@@ -3,6 +3,7 @@ package config_test
 import (
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/Gaurav-Gosain/tuios/internal/config"
 )
@@ -453,6 +454,54 @@ func TestApplyOverrides_DockbarPosition(t *testing.T) {
 	}
 }
 
+// TestApplyOverrides_EnvPrecedence covers the TUIOS_* environment overrides:
+// they beat the config file and lose to an explicit flag.
+func TestApplyOverrides_EnvPrecedence(t *testing.T) {
+	originalBorder := config.BorderStyle
+	originalDock := config.DockbarPosition
+	originalLines := config.ScrollbackLines
+	defer func() {
+		config.BorderStyle = originalBorder
+		config.DockbarPosition = originalDock
+		config.ScrollbackLines = originalLines
+	}()
+
+	t.Setenv(config.EnvBorderStyle, "double")
+	t.Setenv(config.EnvDockbarPosition, "top")
+	t.Setenv(config.EnvScrollbackLines, "5000")
+
+	userCfg := config.DefaultConfig()
+	userCfg.Appearance.BorderStyle = "thick"
+	userCfg.Appearance.DockbarPosition = "hidden"
+	userCfg.Appearance.ScrollbackLines = 2000
+
+	config.ApplyOverrides(config.Overrides{}, userCfg)
+	if config.BorderStyle != "double" {
+		t.Errorf("BorderStyle = %q, want env value 'double' over the config file", config.BorderStyle)
+	}
+	if config.DockbarPosition != "top" {
+		t.Errorf("DockbarPosition = %q, want env value 'top' over the config file", config.DockbarPosition)
+	}
+	if config.ScrollbackLines != 5000 {
+		t.Errorf("ScrollbackLines = %d, want env value 5000 over the config file", config.ScrollbackLines)
+	}
+
+	config.ApplyOverrides(config.Overrides{BorderStyle: "normal", ScrollbackLines: 300}, userCfg)
+	if config.BorderStyle != "normal" {
+		t.Errorf("BorderStyle = %q, want flag value 'normal' over the env", config.BorderStyle)
+	}
+	if config.ScrollbackLines != 300 {
+		t.Errorf("ScrollbackLines = %d, want flag value 300 over the env", config.ScrollbackLines)
+	}
+
+	// A malformed number is ignored rather than zeroing the buffer.
+	t.Setenv(config.EnvScrollbackLines, "lots")
+	config.ApplyOverrides(config.Overrides{}, userCfg)
+	if config.ScrollbackLines != 2000 {
+		t.Errorf("ScrollbackLines = %d after a malformed env value, want config value 2000", config.ScrollbackLines)
+	}
+}
+
 func TestApplyOverrides_HideWindowButtons(t *testing.T) {
 	// Save original value
 	originalHide := config.HideWindowButtons
@@ -678,3 +727,470 @@ func TestApplyAppearanceConfig_ScrollLines(t *testing.T) {
 		t.Errorf("ScrollLines = %d after an unset value, want it unchanged at 5", config.ScrollLines)
 	}
 }
+
+// TestApplyAppearanceConfig_PtyReadBufferSize covers the PTY read buffer
+// knob: set values are clamped to the supported range and an unset one
+// restores the default.
+func TestApplyAppearanceConfig_PtyReadBufferSize(t *testing.T) {
+	original := config.PtyReadBufferSize
+	defer func() { config.PtyReadBufferSize = original }()
+
+	for _, tc := range []struct {
+		set, want int
+	}{
+		{64 * 1024, 64 * 1024},
+		{100, config.MinPtyReadBufferSize},
+		{64 * 1024 * 1024, config.MaxPtyReadBufferSize},
+		{0, config.DefaultPtyReadBufferSize},
+	} {
+		userCfg := config.DefaultConfig()
+		userCfg.Appearance.PtyReadBufferSize = tc.set
+		config.ApplyAppearanceConfig(userCfg)
+		if config.PtyReadBufferSize != tc.want {
+			t.Errorf("pty_read_buffer_size = %d: PtyReadBufferSize = %d, want %d", tc.set, config.PtyReadBufferSize, tc.want)
+		}
+	}
+}
+
+// TestApplyAppearanceConfig_ResizeDebounce covers the unset default, the cap
+// and a negative value turning the debounce off.
+func TestApplyAppearanceConfig_ResizeDebounce(t *testing.T) {
+	original := config.ResizeDebounce
+	defer func() { config.ResizeDebounce = original }()
+
+	for _, tc := range []struct {
+		set  int
+		want time.Duration
+	}{
+		{100, 100 * time.Millisecond},
+		{0, config.DefaultResizeDebounce},
+		{5000, config.MaxResizeDebounce},
+		{-1, 0},
+	} {
+		userCfg := config.DefaultConfig()
+		userCfg.Appearance.ResizeDebounceMs = tc.set
+		config.ApplyAppearanceConfig(userCfg)
+		if config.ResizeDebounce != tc.want {
+			t.Errorf("resize_debounce_ms = %d: ResizeDebounce = %v, want %v", tc.set, config.ResizeDebounce, tc.want)
+		}
+	}
+}
+
+// TestApplyAppearanceConfig_CopyModeSearchHistory covers the unset default,
+// the cap and a negative value turning history off.
+func TestApplyAppearanceConfig_CopyModeSearchHistory(t *testing.T) {
+	original := config.CopyModeSearchHistory
+	defer func() { config.CopyModeSearchHistory = original }()
+
+	for _, tc := range []struct {
+		set, want int
+	}{
+		{10, 10},
+		{0, config.DefaultCopyModeSearchHistory},
+		{100000, config.MaxCopyModeSearchHistory},
+		{-1, 0},
+	} {
+		userCfg := config.DefaultConfig()
+		userCfg.Appearance.CopyModeSearchHistory = tc.set
+		config.ApplyAppearanceConfig(userCfg)
+		if config.CopyModeSearchHistory != tc.want {
+			t.Errorf("copy_mode_search_history = %d: CopyModeSearchHistory = %d, want %d", tc.set, config.CopyModeSearchHistory, tc.want)
+		}
+	}
+}
+
+// TestApplyAppearanceConfig_TilingGap covers the default, the cap, a negative
+// value and the --tiling-gap override.
+func TestApplyAppearanceConfig_TilingGap(t *testing.T) {
+	original := config.TilingGap
+	defer func() { config.TilingGap = original }()
+
+	for _, tc := range []struct {
+		set, want int
+	}{
+		{0, 0},
+		{2, 2},
+		{100, config.MaxTilingGap},
+		{-3, 0},
+	} {
+		userCfg := config.DefaultConfig()
+		userCfg.Appearance.TilingGap = tc.set
+		config.ApplyAppearanceConfig(userCfg)
+		if config.TilingGap != tc.want {
+			t.Errorf("tiling_gap = %d: TilingGap = %d, want %d", tc.set, config.TilingGap, tc.want)
+		}
+	}
+
+	userCfg := config.DefaultConfig()
+	userCfg.Appearance.TilingGap = 2
+	config.ApplyAppearanceConfig(userCfg)
+	config.ApplyOverrides(config.Overrides{TilingGap: 4}, userCfg)
+	if config.TilingGap != 4 {
+		t.Errorf("--tiling-gap 4 over tiling_gap = 2: TilingGap = %d, want 4", config.TilingGap)
+	}
+}
+
+// TestApplyAppearanceConfig_ConfirmQuitIfBusy checks confirm_quit_if_busy is
+// on when unset and can be turned off.
+func TestApplyAppearanceConfig_ConfirmQuitIfBusy(t *testing.T) {
+	original := config.ConfirmQuitIfBusy
+	defer func() { config.ConfirmQuitIfBusy = original }()
+
+	off := false
+	userCfg := config.DefaultConfig()
+	userCfg.Appearance.ConfirmQuitIfBusy = &off
+	config.ApplyAppearanceConfig(userCfg)
+	if config.ConfirmQuitIfBusy {
+		t.Error("confirm_quit_if_busy = false left the busy check on")
+	}
+
+	config.ApplyAppearanceConfig(config.DefaultConfig())
+	if !config.ConfirmQuitIfBusy {
+		t.Error("unset confirm_quit_if_busy should default to on")
+	}
+}
+
+// TestApplyAppearanceConfig_Notifications covers the defaults and clamping of
+// max_notifications and notification_duration_ms, and an unknown
+// notification_position falling back to top-right.
+func TestApplyAppearanceConfig_Notifications(t *testing.T) {
+	origMax, origDur, origPos := config.MaxNotifications, config.NotificationDuration, config.NotificationPosition
+	defer func() {
+		config.MaxNotifications, config.NotificationDuration, config.NotificationPosition = origMax, origDur, origPos
+	}()
+
+	userCfg := config.DefaultConfig()
+	userCfg.Appearance.MaxNotifications = 50
+	userCfg.Appearance.NotificationDurationMs = 100
+	userCfg.Appearance.NotificationPosition = config.NotificationBottomLeft
+	config.ApplyAppearanceConfig(userCfg)
+	if config.MaxNotifications != config.MaxNotificationsLimit {
+		t.Errorf("MaxNotifications = %d, want %d", config.MaxNotifications, config.MaxNotificationsLimit)
+	}
+	if config.NotificationDuration != config.MinNotificationDurationMs*time.Millisecond {
+		t.Errorf("NotificationDuration = %v, want the %dms floor", config.NotificationDuration, config.MinNotificationDurationMs)
+	}
+	if config.NotificationPosition != config.NotificationBottomLeft {
+		t.Errorf("NotificationPosition = %q, want bottom-left", config.NotificationPosition)
+	}
+
+	userCfg = config.DefaultConfig()
+	userCfg.Appearance.NotificationPosition = "middle"
+	config.ApplyAppearanceConfig(userCfg)
+	if config.MaxNotifications != config.DefaultMaxNotifications || config.NotificationDuration != config.DefaultNotificationDuration {
+		t.Errorf("unset values gave %d notifications for %v, want the defaults", config.MaxNotifications, config.NotificationDuration)
+	}
+	if config.NotificationPosition != config.NotificationTopRight {
+		t.Errorf("unknown position kept %q, want top-right", config.NotificationPosition)
+	}
+}
+
+// TestApplyAppearanceConfig_PaneNumbersDuration checks pane_numbers_duration
+// applies, and that a reload without it goes back to the default.
+func TestApplyAppearanceConfig_PaneNumbersDuration(t *testing.T) {
+	original := config.PaneNumbersDuration
+	defer func() { config.PaneNumbersDuration = original }()
+
+	userCfg := config.DefaultConfig()
+	userCfg.Appearance.PaneNumbersDuration = 2500
+	config.ApplyAppearanceConfig(userCfg)
+	if config.PaneNumbersDuration != 2500*time.Millisecond {
+		t.Errorf("PaneNumbersDuration = %v, want 2.5s", config.PaneNumbersDuration)
+	}
+
+	config.ApplyAppearanceConfig(config.DefaultConfig())
+	if config.PaneNumbersDuration != config.DefaultPaneNumbersDuration {
+		t.Errorf("reload without the option kept %v, want the default %v", config.PaneNumbersDuration, config.DefaultPaneNumbersDuration)
+	}
+}
+
+// TestApplyAppearanceConfig_WindowContentPadding covers the clamping of
+// window_content_padding.
+func TestApplyAppearanceConfig_WindowContentPadding(t *testing.T) {
+	original := config.WindowContentPadding
+	defer func() { config.WindowContentPadding = original }()
+
+	for _, tc := range []struct {
+		set, want int
+	}{
+		{1, 1},
+		{0, 0},
+		{100, config.MaxWindowContentPadding},
+		{-2, 0},
+	} {
+		userCfg := config.DefaultConfig()
+		userCfg.Appearance.WindowContentPadding = tc.set
+		config.ApplyAppearanceConfig(userCfg)
+		if config.WindowContentPadding != tc.want {
+			t.Errorf("window_content_padding = %d: WindowContentPadding = %d, want %d", tc.set, config.WindowContentPadding, tc.want)
+		}
+	}
+}
+
+// TestApplyAppearanceConfig_MaxLogMessages covers the unset default and the
+// clamping of [debug] max_log_messages.
+func TestApplyAppearanceConfig_MaxLogMessages(t *testing.T) {
+	original := config.MaxLogMessages
+	defer func() { config.MaxLogMessages = original }()
+
+	for _, tc := range []struct {
+		set, want int
+	}{
+		{500, 500},
+		{0, config.DefaultMaxLogMessages},
+		{-5, config.DefaultMaxLogMessages},
+		{3, config.MinLogMessages},
+		{1 << 30, config.MaxLogMessagesLimit},
+	} {
+		userCfg := config.DefaultConfig()
+		userCfg.Debug.MaxLogMessages = tc.set
+		config.ApplyAppearanceConfig(userCfg)
+		if config.MaxLogMessages != tc.want {
+			t.Errorf("max_log_messages = %d: MaxLogMessages = %d, want %d", tc.set, config.MaxLogMessages, tc.want)
+		}
+	}
+}
+
+// TestApplyAppearanceConfig_ShowTitleBars checks the accepted modes pass
+// through and anything else falls back to always.
+func TestApplyAppearanceConfig_ShowTitleBars(t *testing.T) {
+	original := config.ShowTitleBars
+	defer func() { config.ShowTitleBars = original }()
+
+	for set, want := range map[string]string{
+		"multiple":  config.TitleBarsMultiple,
+		"never":     config.TitleBarsNever,
+		"always":    config.TitleBarsAlways,
+		"":          config.TitleBarsAlways,
+		"sometimes": config.TitleBarsAlways,
+	} {
+		userCfg := config.DefaultConfig()
+		userCfg.Appearance.ShowTitleBars = set
+		config.ApplyAppearanceConfig(userCfg)
+		if config.ShowTitleBars != want {
+			t.Errorf("show_title_bars = %q: ShowTitleBars = %q, want %q", set, config.ShowTitleBars, want)
+		}
+	}
+}
+
+func TestApplyAppearanceConfig_DockWorkspaces(t *testing.T) {
+	original := config.DockWorkspaces
+	defer func() { config.DockWorkspaces = original }()
+
+	for set, want := range map[string]string{
+		"all":      config.DockWorkspacesAll,
+		"occupied": config.DockWorkspacesOccupied,
+		"off":      config.DockWorkspacesOff,
+		"":         config.DockWorkspacesOff,
+		"some":     config.DockWorkspacesOff,
+	} {
+		userCfg := config.DefaultConfig()
+		userCfg.Appearance.DockWorkspaces = set
+		config.ApplyAppearanceConfig(userCfg)
+		if config.DockWorkspaces != want {
+			t.Errorf("dock_workspaces = %q: DockWorkspaces = %q, want %q", set, config.DockWorkspaces, want)
+		}
+	}
+}
+
+func TestApplyAppearanceConfig_NewFloatingZOrder(t *testing.T) {
+	original := config.NewFloatingZOrder
+	defer func() { config.NewFloatingZOrder = original }()
+
+	for set, want := range map[string]string{
+		"bottom":         config.NewFloatingZBottom,
+		"respect-cursor": config.NewFloatingZRespectCursor,
+		"top":            config.NewFloatingZTop,
+		"":               config.NewFloatingZTop,
+		"middle":         config.NewFloatingZTop,
+	} {
+		userCfg := config.DefaultConfig()
+		userCfg.Appearance.NewFloatingZOrder = set
+		config.ApplyAppearanceConfig(userCfg)
+		if config.NewFloatingZOrder != want {
+			t.Errorf("new_floating_z_order = %q: NewFloatingZOrder = %q, want %q", set, config.NewFloatingZOrder, want)
+		}
+	}
+}
+
+func TestApplyAppearanceConfig_PeekSide(t *testing.T) {
+	original := config.PeekSide
+	defer func() { config.PeekSide = original }()
+
+	for set, want := range map[string]string{
+		"left":   config.PeekSideLeft,
+		"right":  config.PeekSideRight,
+		"auto":   config.PeekSideAuto,
+		"":       config.PeekSideAuto,
+		"middle": config.PeekSideAuto,
+	} {
+		userCfg := config.DefaultConfig()
+		userCfg.Appearance.PeekSide = set
+		config.ApplyAppearanceConfig(userCfg)
+		if config.PeekSide != want {
+			t.Errorf("peek_side = %q: PeekSide = %q, want %q", set, config.PeekSide, want)
+		}
+	}
+}
+
+// TestApplyAppearanceConfig_MasterRatioRange covers the master ratio bounds:
+// configured values are kept within the absolute limits, a max below the min
+// is raised to it, and an unset config restores the 0.3-0.7 default.
+func TestApplyAppearanceConfig_MasterRatioRange(t *testing.T) {
+	origMin, origMax := config.MasterRatioMin, config.MasterRatioMax
+	defer func() { config.MasterRatioMin, config.MasterRatioMax = origMin, origMax }()
+
+	tests := []struct {
+		name             string
+		min, max         float64
+		wantMin, wantMax float64
+	}{
+		{"unset", 0, 0, 0.3, 0.7},
+		{"ultrawide", 0.2, 0.8, 0.2, 0.8},
+		{"beyond absolute bounds", 0.01, 0.99, config.MasterRatioFloor, config.MasterRatioCeiling},
+		{"max below min", 0.6, 0.4, 0.6, 0.6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userCfg := config.DefaultConfig()
+			userCfg.Appearance.MasterRatioMin = tt.min
+			userCfg.Appearance.MasterRatioMax = tt.max
+			config.ApplyAppearanceConfig(userCfg)
+			if config.MasterRatioMin != tt.wantMin || config.MasterRatioMax != tt.wantMax {
+				t.Errorf("range = [%v, %v], want [%v, %v]",
+					config.MasterRatioMin, config.MasterRatioMax, tt.wantMin, tt.wantMax)
+			}
+			if got := config.ClampMasterRatio(1); got != tt.wantMax {
+				t.Errorf("ClampMasterRatio(1) = %v, want %v", got, tt.wantMax)
+			}
+		})
+	}
+}
+
+// TestApplyAppearanceConfig_MasterRatioPresets checks the presets are clamped
+// into the master ratio range, presets that clamp alike count once, and an
+// empty list restores the defaults.
+func TestApplyAppearanceConfig_MasterRatioPresets(t *testing.T) {
+	origMin, origMax, origPresets := config.MasterRatioMin, config.MasterRatioMax, config.MasterRatioPresets
+	defer func() {
+		config.MasterRatioMin, config.MasterRatioMax, config.MasterRatioPresets = origMin, origMax, origPresets
+	}()
+
+	userCfg := config.DefaultConfig()
+	userCfg.Appearance.MasterRatioPresets = []float64{0.1, 0.2, 0.5, 0.95}
+	config.ApplyAppearanceConfig(userCfg)
+	if want := []float64{0.3, 0.5, 0.7}; !slices.Equal(config.MasterRatioPresets, want) {
+		t.Errorf("presets = %v, want %v", config.MasterRatioPresets, want)
+	}
+
+	userCfg.Appearance.MasterRatioPresets = nil
+	config.ApplyAppearanceConfig(userCfg)
+	if want := config.DefaultMasterRatioPresets(); !slices.Equal(config.MasterRatioPresets, want) {
+		t.Errorf("unset presets = %v, want the defaults %v", config.MasterRatioPresets, want)
+	}
+}
+
+// TestApplyAppearanceConfig_MouseButtons covers button remapping: valid
+// entries override the defaults, unknown buttons and actions are dropped, and
+// an unset config restores the stock map.
+func TestApplyAppearanceConfig_MouseButtons(t *testing.T) {
+	original := config.MouseButtonMap
+	defer func() { config.MouseButtonMap = original }()
+
+	userCfg := config.DefaultConfig()
+	userCfg.Appearance.MouseButtons = map[string]string{
+		"Middle": "close",
+		"right":  "drag",
+		"left":   "explode",
+		"back":   "paste",
+	}
+	config.ApplyAppearanceConfig(userCfg)
+	want := map[string]string{"left": "drag", "middle": "close", "right": "drag"}
+	for button, action := range want {
+		if got := config.MouseButtonMap[button]; got != action {
+			t.Errorf("%s = %q, want %q", button, got, action)
+		}
+	}
+	if _, ok := config.MouseButtonMap["back"]; ok {
+		t.Error("unknown button should not be added to the map")
+	}
+
+	userCfg.Appearance.MouseButtons = nil
+	config.ApplyAppearanceConfig(userCfg)
+	if got := config.MouseButtonMap["middle"]; got != config.MouseActionNone {
+		t.Errorf("middle after reload = %q, want %q", got, config.MouseActionNone)
+	}
+}
+
+// TestResolveWindowSize covers new_window_width/new_window_height: cells and
+// percentages are both accepted, results stay within the screen and above the
+// minimum, and anything unreadable means half the screen.
+func TestResolveWindowSize(t *testing.T) {
+	tests := []struct {
+		spec string
+		want int
+	}{
+		{"50%", 60},
+		{"25%", 30},
+		{"100", 100},
+		{"500", 120},
+		{"3", 20},
+		{"1%", 20},
+		{"", 60},
+		{"wide", 60},
+		{"150%", 60},
+	}
+	for _, tt := range tests {
+		if got := config.ResolveWindowSize(tt.spec, 120, 20); got != tt.want {
+			t.Errorf("ResolveWindowSize(%q, 120, 20) = %d, want %d", tt.spec, got, tt.want)
+		}
+	}
+
+	originalW, originalH := config.NewWindowWidth, config.NewWindowHeight
+	defer func() { config.NewWindowWidth, config.NewWindowHeight = originalW, originalH }()
+	userCfg := config.DefaultConfig()
+	userCfg.Appearance.NewWindowWidth = " 80 "
+	userCfg.Appearance.NewWindowHeight = "huge"
+	config.ApplyAppearanceConfig(userCfg)
+	if config.NewWindowWidth != "80" || config.NewWindowHeight != config.DefaultNewWindowSize {
+		t.Errorf("applied sizes = %q x %q, want \"80\" x %q", config.NewWindowWidth, config.NewWindowHeight, config.DefaultNewWindowSize)
+	}
+}
+
+// TestMatchWindowRule covers matching [[window_rules]] against a spawn
+// command: the program name is matched without its directory or arguments,
+// the first matching rule wins, and an invalid rule is reported.
+func TestMatchWindowRule(t *testing.T) {
+	original := config.WindowRules
+	defer func() { config.WindowRules = original }()
+	userCfg := config.DefaultConfig()
+	userCfg.WindowRules = []config.WindowRule{
+		{Match: "htop", Floating: true},
+		{Match: "*top", Workspace: 4},
+	}
+	config.ApplyAppearanceConfig(userCfg)
+
+	tests := []struct {
+		command   string
+		matched   bool
+		floating  bool
+		workspace int
+	}{
+		{"htop", true, true, 0},
+		{"/usr/bin/htop -d 10", true, true, 0},
+		{"btop;exit", true, false, 4},
+		{"make watch", false, false, 0},
+		{"", false, false, 0},
+	}
+	for _, tt := range tests {
+		rule, ok := config.MatchWindowRule(tt.command)
+		if ok != tt.matched || rule.Floating != tt.floating || rule.Workspace != tt.workspace {
+			t.Errorf("MatchWindowRule(%q) = %+v, %v", tt.command, rule, ok)
+		}
+	}
+
+	userCfg.WindowRules = []config.WindowRule{{Match: "[", Workspace: 12, Width: "wide"}}
+	if got := len(config.ValidateConfig(userCfg).Warnings); got < 3 {
+		t.Errorf("an invalid rule produced %d warnings, want one each for match, workspace and width", got)
+	}
+}
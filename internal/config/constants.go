@@ -2,9 +2,12 @@
 package config
 
 import (
+	"fmt"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"charm.land/lipgloss/v2"
 	"github.com/lrstanley/go-nf/glyphs/fa"
@@ -43,8 +46,8 @@ const (
 	// NotificationFadeOutDuration is the fade out duration for notifications
 	NotificationFadeOutDuration = 500 * time.Millisecond
 
-	// NotificationDuration is the default duration notifications remain visible
-	NotificationDuration = 1500 * time.Millisecond
+	// DefaultNotificationDuration is NotificationDuration when unset.
+	DefaultNotificationDuration = 1500 * time.Millisecond
 )
 
 // =============================================================================
@@ -64,6 +67,10 @@ const (
 	// WhichKeyDelay is the delay before showing which-key style overlay
 	WhichKeyDelay = 500 * time.Millisecond
 
+	// TooltipDelay is how long the pointer rests on a title-bar button or
+	// dock item before its tooltip appears
+	TooltipDelay = 600 * time.Millisecond
+
 	// ProcessShutdownTimeout is the timeout for graceful process shutdown
 	ProcessShutdownTimeout = 500 * time.Millisecond
 )
@@ -306,10 +313,73 @@ var AnimationsSuppressed = false
 // Set via confirm_quit config option.
 var AlwaysConfirmQuit = false
 
+// ConfirmQuitIfBusy shows the quit confirmation dialog when a window is running
+// something besides its shell, an editor or ssh say, so quitting with only idle
+// shells exits at once while a running job gets a prompt. Turned off, quitting
+// never asks unless AlwaysConfirmQuit is set.
+// Set via appearance.confirm_quit_if_busy config
+var ConfirmQuitIfBusy = true
+
+// PasteStripTrailingNewline drops line endings from the end of pasted text, so
+// pasting a copied command line leaves it at the prompt instead of running it.
+// Off by default, which pastes the text exactly as copied.
+// Set via appearance.paste_strip_trailing_newline config
+var PasteStripTrailingNewline = false
+
+// KeyByteOverrides maps a key, in the form keybindings use ("ctrl+left",
+// "shift+f1", "alt+enter"), to the exact bytes sent to the terminal when that
+// key is pressed in terminal mode. It is consulted before the built-in
+// encoding, as an escape hatch for programs that expect something else from
+// a key. Empty by default.
+// Set via appearance.key_bytes config
+var KeyByteOverrides map[string][]byte
+
+// Mouse button actions. See MouseButtonMap.
+const (
+	MouseActionDrag   = "drag"
+	MouseActionResize = "resize"
+	MouseActionClose  = "close"
+	MouseActionPaste  = "paste"
+	MouseActionNone   = "none"
+)
+
+// DefaultMouseButtonMap returns the stock button actions: left drags, right
+// resizes and middle only focuses.
+func DefaultMouseButtonMap() map[string]string {
+	return map[string]string{
+		"left":   MouseActionDrag,
+		"middle": MouseActionNone,
+		"right":  MouseActionResize,
+	}
+}
+
+// MouseButtonMap is what a click on a window does for each of "left",
+// "middle" and "right". Title-bar buttons, Ctrl+Click and copy-mode
+// selection stay on the left button.
+// Set via appearance.mouse_buttons config
+var MouseButtonMap = DefaultMouseButtonMap()
+
 // WhichKeyEnabled controls whether the which-key popup is shown after pressing leader key
 // Set via appearance.whichkey_enabled config
 var WhichKeyEnabled = true
 
+// ShowTooltips controls whether resting the mouse on a title-bar button or a
+// dock item shows a small label describing it
+// Set via appearance.show_tooltips config
+var ShowTooltips = true
+
+// PromoteKeepsFocusSlot controls where focus goes when the focused window is
+// promoted to master: false follows the window into the master slot, true
+// keeps focus on the slot it was in, now holding the window swapped out.
+// Set via appearance.promote_keeps_focus_slot config
+var PromoteKeepsFocusSlot = false
+
+// WindowCycleWrap controls whether cycling to the next or previous window goes
+// round from the last window to the first and back. When false, cycling stops
+// at either end.
+// Set via appearance.window_cycle_wrap config
+var WindowCycleWrap = true
+
 // WhichKeyPosition controls where the which-key popup appears
 // Options: bottom-right, bottom-left, top-right, top-left, center
 // Set via appearance.whichkey_position config
@@ -339,6 +409,15 @@ func GetFastAnimationDuration() time.Duration {
 // Default: false (disabled, opt-in)
 var SharedBorders = false
 
+// TilingGap is how many blank cells tiling leaves between windows and
+// between the windows and the edges of the tiling area. Shared borders draw a
+// one-cell separator instead and ignore it.
+// Set via --tiling-gap flag or appearance.tiling_gap config
+var TilingGap = 0
+
+// MaxTilingGap caps TilingGap.
+const MaxTilingGap = 8
+
 // BorderStyle controls which border style to use for windows
 // Set via --border-style flag or appearance.border_style config
 var BorderStyle = "rounded"
@@ -347,6 +426,50 @@ var BorderStyle = "rounded"
 // Set via --dockbar-position flag or appearance.dockbar_position config
 var DockbarPosition = "bottom"
 
+// DockAutoHide tucks the dock away, giving its rows to windows, while there
+// are no minimized windows in the workspace. Moving the mouse to the dock's
+// screen edge brings it back until the pointer leaves the dock area.
+// Set via appearance.dock_auto_hide config
+var DockAutoHide = false
+
+// DockMargin is the number of blank rows kept between the windows and the
+// dock, on top of DockHeight, so window content never touches the dock. It
+// applies to tiling, snapping, zoom and drag bounds alike, and is dropped
+// while the dock is hidden. Clamped to [0, MaxDockMargin].
+// Set via appearance.dock_margin config
+var DockMargin = 0
+
+// DynamicWorkspaces makes workspaces come and go with their windows instead
+// of being a fixed set of MaxWorkspaces: only populated workspaces plus one
+// empty one after them exist, and an emptied workspace is removed once left,
+// renumbering the ones after it.
+// Set via appearance.dynamic_workspaces config
+var DynamicWorkspaces = false
+
+// Which half of the screen a peeked workspace is drawn in. See PeekSide.
+const (
+	PeekSideAuto  = "auto"
+	PeekSideLeft  = "left"
+	PeekSideRight = "right"
+)
+
+// PeekSide is the half of the screen the peeked workspace (leader w p) covers:
+// "left", "right", or "auto" for the half the focused window is mostly not in.
+// Set via appearance.peek_side config
+var PeekSide = PeekSideAuto
+
+// MaxDockMargin caps DockMargin so a typo cannot eat the screen.
+const MaxDockMargin = 5
+
+// WindowContentPadding is the number of blank cells kept between a window's
+// border and its terminal on every side; 0 draws the output against the
+// border. The terminal shrinks to make room, so programs see a smaller size.
+// Set via appearance.window_content_padding config
+var WindowContentPadding = 0
+
+// MaxWindowContentPadding caps WindowContentPadding.
+const MaxWindowContentPadding = 4
+
 // HideWindowButtons controls whether to hide window control buttons
 // Set via --hide-window-buttons flag or appearance.hide_window_buttons config
 var HideWindowButtons = false
@@ -362,6 +485,20 @@ var HideScrollbar = false
 // Set via --window-title-position flag or appearance.window_title_position config
 var WindowTitlePosition = "bottom"
 
+// When window title bars are drawn. See ShowTitleBars.
+const (
+	TitleBarsAlways   = "always"
+	TitleBarsMultiple = "multiple"
+	TitleBarsNever    = "never"
+)
+
+// ShowTitleBars controls whether windows draw their title bar, the top border
+// row that carries the buttons. "multiple" hides it while a window is alone
+// on its workspace; "never" hides it everywhere. A hidden title bar's row goes
+// to the terminal.
+// Set via appearance.show_title_bars config
+var ShowTitleBars = TitleBarsAlways
+
 // WindowTitleFormat is the template used to build a window's displayed title.
 // Empty (the default) means the title is shown as-is. See FormatWindowTitle for
 // the supported placeholders.
@@ -387,6 +524,63 @@ func FormatWindowTitle(title string, index int, cwd string) string {
 	).Replace(WindowTitleFormat)
 }
 
+// DefaultSessionNameFormat is the name template used for sessions created
+// without an explicit name when startup.session_name_format is unset.
+const DefaultSessionNameFormat = "session-{n}"
+
+// FormatSessionName picks a name for a new session from format, avoiding every
+// name in existing. The placeholders are {n} (the lowest counter, from 0, that
+// gives a free name), {date} (2006-01-02) and {time} (15-04-05), both taken
+// from now.
+//
+// A format without {n} is used as-is when free, and otherwise gets a -1, -2, ...
+// suffix, so two sessions started in the same second never collide. An empty
+// format, or one that expands to nothing, falls back to the default.
+func FormatSessionName(format string, existing []string, now time.Time) string {
+	taken := make(map[string]bool, len(existing))
+	for _, name := range existing {
+		taken[name] = true
+	}
+
+	format = strings.TrimSpace(strings.NewReplacer(
+		"{date}", now.Format("2006-01-02"),
+		"{time}", now.Format("15-04-05"),
+	).Replace(format))
+	if format == "" {
+		format = DefaultSessionNameFormat
+	}
+
+	if strings.Contains(format, "{n}") {
+		for i := 0; ; i++ {
+			name := strings.ReplaceAll(format, "{n}", strconv.Itoa(i))
+			if !taken[name] {
+				return name
+			}
+		}
+	}
+
+	if !taken[format] {
+		return format
+	}
+	for i := 1; ; i++ {
+		name := fmt.Sprintf("%s-%d", format, i)
+		if !taken[name] {
+			return name
+		}
+	}
+}
+
+// NewSessionName names a session created without one, following
+// startup.session_name_format (session-N when unset or when the config cannot
+// be loaded) and skipping every name in existing.
+func NewSessionName(existing []string) string {
+	format := ""
+	if cfg, err := LoadUserConfig(); err == nil {
+		format = cfg.Startup.SessionNameFormat
+	}
+	return FormatSessionName(format, existing, time.Now())
+}
+
 // HideClock controls whether the clock overlay is hidden
 // Set via --hide-clock flag or appearance.hide_clock config
 // Deprecated: Use ShowClock instead. HideClock takes precedence when true.
@@ -404,15 +598,132 @@ var ShowCPU = false
 // Set via --show-ram flag or appearance.show_ram config
 var ShowRAM = false
 
+// Which workspaces the dock lists. See DockWorkspaces.
+const (
+	DockWorkspacesOff      = "off"
+	DockWorkspacesAll      = "all"
+	DockWorkspacesOccupied = "occupied"
+)
+
+// DockWorkspaces adds a list of workspace numbers to the dock after the
+// workspace summary, the current one in brackets: "off" leaves it out, "all"
+// lists every workspace and "occupied" only those holding windows, plus the
+// current one.
+// Set via appearance.dock_workspaces config
+var DockWorkspaces = DockWorkspacesOff
+
+// StatusCommand is a shell command whose output is shown on the right of the
+// dock, like an i3blocks module. It runs with sh -c every StatusInterval, off
+// the render path, and is killed after StatusCommandTimeout. Only the first
+// line is shown, cut to MaxStatusWidth columns. Empty (the default) disables
+// it.
+// Set via appearance.status_command config
+var StatusCommand = ""
+
+// StatusInterval is how often StatusCommand is rerun.
+// Set via appearance.status_interval config (seconds)
+var StatusInterval = 5 * time.Second
+
+const (
+	// StatusCommandTimeout bounds one run of StatusCommand, so a hung command
+	// cannot pile up behind the next interval.
+	StatusCommandTimeout = 2 * time.Second
+	// MaxStatusWidth caps the dock columns StatusCommand's output can take.
+	MaxStatusWidth = 40
+	// MaxStatusInterval caps StatusInterval.
+	MaxStatusInterval = time.Hour
+	// StartupCommandTimeout bounds startup.startup_command. It runs off the
+	// Update loop, so the limit only stops a hung command from living on.
+	StartupCommandTimeout = 30 * time.Second
+	// ShutdownCommandTimeout bounds startup.shutdown_command, which the
+	// exiting client waits for.
+	ShutdownCommandTimeout = 5 * time.Second
+)
+
 // NeedsDockTick returns true if any dock element requires periodic updates.
 func NeedsDockTick() bool {
 	return ShowClock || ShowCPU || ShowRAM
 }
 
+// TapeFinishHide is how long the DONE indicator stays up after a tape finishes
+// playing, before script mode is left.
+// Set via appearance.tape_finish_hide_ms config
+var TapeFinishHide = 2 * time.Second
+
+// TapeFinishHold keeps a finished tape's final state and DONE indicator up
+// until a key is pressed, instead of hiding them after TapeFinishHide. The key
+// only dismisses the indicator and is not passed on.
+// Set via appearance.tape_finish_hold config
+var TapeFinishHold = false
+
+// TapeLoop restarts a tape played with tape play from its first command once
+// it has finished and TapeFinishHide has passed, for unattended demos. It
+// takes precedence over TapeFinishHold. Ctrl+P still pauses it.
+// Set via appearance.tape_loop config
+var TapeLoop = false
+
+// MaxTapeFinishHide caps TapeFinishHide.
+const MaxTapeFinishHide = time.Minute
+
 // ScrollbackLines controls the number of lines to keep in scrollback buffer
 // Set via --scrollback-lines flag or appearance.scrollback_lines config
 var ScrollbackLines = 10000
 
+// TotalScrollbackBudgetMB caps the approximate memory the scrollback of all
+// windows may use together. ScrollbackLines bounds each window, but dozens of
+// panes can still add up; over budget, the oldest scrollback is trimmed from
+// the least recently focused windows first. 0 (the default) disables it.
+// Set via appearance.total_scrollback_budget_mb config
+var TotalScrollbackBudgetMB = 0
+
+// BackgroundUpdateDivisor throttles redraws of unfocused windows: one with new
+// output is redrawn on every Nth render cycle, so the default of 3 is about
+// 20Hz at 60 FPS. 1 redraws background windows as often as the focused one.
+// A window's own UpdateDivisor takes precedence.
+// Set via appearance.background_update_divisor config
+var BackgroundUpdateDivisor = 3
+
+// MaxBackgroundUpdateDivisor caps BackgroundUpdateDivisor and per-window
+// overrides, about one redraw every 10 seconds at 60 FPS.
+const MaxBackgroundUpdateDivisor = 600
+
+// PtyReadBufferSize is the size in bytes of the buffer a window's PTY reader
+// reads program output into. A larger buffer takes fewer reads for bulky
+// output such as a big build; a smaller one hands interactive output to the
+// emulator in smaller pieces. It applies to windows created after a change.
+// Set via appearance.pty_read_buffer_size config
+var PtyReadBufferSize = DefaultPtyReadBufferSize
+
+const (
+	// DefaultPtyReadBufferSize is PtyReadBufferSize when it is not configured.
+	DefaultPtyReadBufferSize = 32 * 1024
+	// MinPtyReadBufferSize and MaxPtyReadBufferSize bound PtyReadBufferSize.
+	MinPtyReadBufferSize = 1024
+	MaxPtyReadBufferSize = 1024 * 1024
+)
+
+// ResizeDebounce is how long the host terminal's size has to hold still
+// before tuios retiles, resizes the PTYs and tells the daemon. A burst of
+// resize events, as a window manager or a nested multiplexer sends while it
+// is dragged, then costs one relayout instead of one per event; the screen
+// itself follows every event. 0 applies each resize as it arrives.
+// Set via appearance.resize_debounce_ms config
+var ResizeDebounce = DefaultResizeDebounce
+
+const (
+	// DefaultResizeDebounce is ResizeDebounce when it is not configured.
+	DefaultResizeDebounce = 30 * time.Millisecond
+	// MaxResizeDebounce caps ResizeDebounce.
+	MaxResizeDebounce = time.Second
+)
+
+const (
+	// MaxScrollbackBudgetMB caps TotalScrollbackBudgetMB.
+	MaxScrollbackBudgetMB = 65536
+	// ScrollbackBudgetInterval is how often the budget is checked.
+	ScrollbackBudgetInterval = time.Second
+)
+
 // ScrollLines is how many lines one mouse wheel notch scrolls in scrollback,
 // copy mode and the scrollback browser.
 // Set via appearance.scroll_lines config
@@ -427,6 +738,379 @@ var NiriReverseScroll = false
 // Set via appearance.leader_key config
 var LeaderKey = "ctrl+b"
 
+// Copy mode exit targets. See CopyModeExitTo.
+const (
+	CopyModeExitWindow   = "window"
+	CopyModeExitTerminal = "terminal"
+)
+
+// CopyModeExitTo is the mode that q or esc in copy mode returns to: "window"
+// (window management mode, the default) or "terminal", which drops straight
+// back into typing. The i key always enters terminal mode regardless.
+// Set via appearance.copy_mode_exit_to config
+var CopyModeExitTo = CopyModeExitWindow
+
+// PaneNumbersDuration is how long the pane-number overlay (leader #) stays up
+// before dismissing itself.
+// Set via appearance.pane_numbers_duration config (milliseconds)
+var PaneNumbersDuration = DefaultPaneNumbersDuration
+
+// DefaultPaneNumbersDuration is PaneNumbersDuration when it is not configured.
+const DefaultPaneNumbersDuration = time.Second
+
+// CopyModeKeyAccel makes a held movement key in copy mode move further per
+// repeat the longer it is held, so long buffers scroll quickly without typing
+// a count. An explicit count always wins.
+// Set via appearance.copy_mode_key_accel config
+var CopyModeKeyAccel = false
+
+// CopyModeSmartCase makes copy-mode search ignore case unless the query has an
+// uppercase letter, like vim's smartcase. A window's explicit case-sensitive
+// flag still wins.
+// Set via appearance.copy_mode_smart_case config
+var CopyModeSmartCase = false
+
+// AutoRenameFromCommand names each window after the command in its
+// foreground, like tmux's automatic-rename: "vim" while vim runs, the shell's
+// name again once it exits. A window renamed by hand keeps its name.
+// Set via appearance.auto_rename_from_command config
+var AutoRenameFromCommand = false
+
+// AutoRenameInterval is how often windows are checked for a new foreground
+// command when AutoRenameFromCommand is on.
+const AutoRenameInterval = time.Second
+
+// CopyModeSearchHistory is how many past search queries a window keeps for
+// recall with up and down in the copy-mode search prompt; 0 keeps none.
+// Set via appearance.copy_mode_search_history config
+var CopyModeSearchHistory = DefaultCopyModeSearchHistory
+
+const (
+	// DefaultCopyModeSearchHistory is CopyModeSearchHistory when unset.
+	DefaultCopyModeSearchHistory = 50
+	// MaxCopyModeSearchHistory caps CopyModeSearchHistory.
+	MaxCopyModeSearchHistory = 1000
+)
+
+// MaxPtyBytesPerSec caps how fast a window consumes output from its program,
+// so a pane flooding output (cat /dev/urandom) cannot make the whole window
+// manager unresponsive. Output over the limit is delayed, which slows the
+// program down; 0 means no limit.
+// Set via appearance.max_pty_bytes_per_sec config
+var MaxPtyBytesPerSec = 0
+
+// CopyModeAccelWindow is the longest gap between two presses of the same
+// movement key that still counts as the key being held.
+const CopyModeAccelWindow = 80 * time.Millisecond
+
+// Window open animation styles. See WindowOpenAnimation.
+const (
+	OpenAnimationNone   = "none"
+	OpenAnimationCenter = "center"
+	OpenAnimationCursor = "cursor"
+)
+
+// WindowOpenAnimation is how a new window appears: "none" (instantly, the
+// default), "center" (grows out of the middle of its final position) or
+// "cursor" (grows out of the last mouse position). Only takes effect while
+// animations are enabled.
+// Set via appearance.window_open_animation config
+var WindowOpenAnimation = OpenAnimationNone
+
+// DefaultNewWindowSize is the size a new floating window gets on each axis
+// when new_window_width or new_window_height is unset.
+const DefaultNewWindowSize = "50%"
+
+// NewWindowWidth and NewWindowHeight size a newly created floating window,
+// either in cells ("100") or as a share of the usable screen ("40%"). Tiling
+// ignores them. See ResolveWindowSize.
+// Set via appearance.new_window_width and appearance.new_window_height config
+var (
+	NewWindowWidth  = DefaultNewWindowSize
+	NewWindowHeight = DefaultNewWindowSize
+)
+
+// Where a new floating window is stacked. See NewFloatingZOrder.
+const (
+	NewFloatingZTop           = "top"
+	NewFloatingZBottom        = "bottom"
+	NewFloatingZRespectCursor = "respect-cursor"
+)
+
+// NewFloatingZOrder decides where a new floating window goes in the stack:
+// "top" focuses it above everything, "bottom" puts it beneath every other
+// window and leaves focus where it was, and "respect-cursor" goes on top unless
+// the mouse is over a window, which then stays above the new one and keeps
+// the focus it had. Tiled windows are not stacked and ignore it.
+// Set via appearance.new_floating_z_order config
+var NewFloatingZOrder = NewFloatingZTop
+
+// AutoTileFromSecondWindow splits the screen between the two windows when a
+// second one opens on a floating workspace: the first is snapped to the left
+// half and the new one to the right. Tiling mode stays off, so the windows can
+// still be moved and a third one floats as usual.
+// Set via appearance.auto_tile_from_second_window config
+var AutoTileFromSecondWindow = false
+
+// NotificationDuration is how long a notification stays up, fade-out included.
+// Set via appearance.notification_duration_ms config
+var NotificationDuration = DefaultNotificationDuration
+
+const (
+	// MinNotificationDurationMs keeps a notification up past its fade-out.
+	MinNotificationDurationMs = 500
+	// MaxNotificationDurationMs caps notification_duration_ms.
+	MaxNotificationDurationMs = 60000
+)
+
+// MaxNotifications is how many notifications are kept on screen at once; a
+// new one past it drops the oldest.
+// Set via appearance.max_notifications config
+var MaxNotifications = DefaultMaxNotifications
+
+const (
+	// DefaultMaxNotifications is MaxNotifications when unset.
+	DefaultMaxNotifications = 3
+	// MaxNotificationsLimit caps MaxNotifications.
+	MaxNotificationsLimit = 10
+)
+
+// Where notifications stack. See NotificationPosition.
+const (
+	NotificationTopRight     = "top-right"
+	NotificationTopLeft      = "top-left"
+	NotificationTopCenter    = "top-center"
+	NotificationBottomRight  = "bottom-right"
+	NotificationBottomLeft   = "bottom-left"
+	NotificationBottomCenter = "bottom-center"
+)
+
+// NotificationPosition is the corner or edge notifications stack from. At the
+// top the oldest is highest and newer ones go below it; at the bottom the
+// newest sits nearest the edge.
+// Set via appearance.notification_position config
+var NotificationPosition = NotificationTopRight
+
+// ParseWindowSize reads a new-window size: a whole number of cells, or a
+// whole percentage from 1 to 100 with a trailing "%". It reports false for
+// anything else.
+func ParseWindowSize(spec string) (value int, percent bool, ok bool) {
+	spec = strings.TrimSpace(spec)
+	if rest, found := strings.CutSuffix(spec, "%"); found {
+		n, err := strconv.Atoi(strings.TrimSpace(rest))
+		if err != nil || n < 1 || n > 100 {
+			return 0, false, false
+		}
+		return n, true, true
+	}
+	n, err := strconv.Atoi(spec)
+	if err != nil || n < 1 {
+		return 0, false, false
+	}
+	return n, false, true
+}
+
+// ResolveWindowSize turns a size spec into cells for a screen of the given
+// size, clamped to [minimum, screen]. An invalid spec means half the screen.
+func ResolveWindowSize(spec string, screen, minimum int) int {
+	size := screen / 2
+	if value, percent, ok := ParseWindowSize(spec); ok {
+		size = value
+		if percent {
+			size = screen * value / 100
+		}
+	}
+	return max(min(size, screen), min(minimum, screen))
+}
+
+// WindowRules adjust windows started with a matching command. See
+// MatchWindowRule.
+// Set via [[window_rules]] config
+var WindowRules []WindowRule
+
+// MatchWindowRule returns the first rule whose pattern matches the program
+// name of command: its first word with any directory stripped, so "htop",
+// "/usr/bin/htop -d 10" and "htop; exit" all match "htop". It reports false
+// for an empty command or when no rule matches.
+func MatchWindowRule(command string) (WindowRule, bool) {
+	fields := strings.FieldsFunc(command, func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune(";&|()", r)
+	})
+	if len(fields) == 0 {
+		return WindowRule{}, false
+	}
+	program := filepath.Base(fields[0])
+	for _, rule := range WindowRules {
+		if ok, err := filepath.Match(rule.Match, program); err == nil && ok {
+			return rule, true
+		}
+	}
+	return WindowRule{}, false
+}
+
+// Maximize button actions. See MaximizeButtonAction.
+const (
+	MaximizeFullscreenToggle    = "fullscreen-toggle"
+	MaximizeZoom                = "zoom"
+	MaximizeWorkspaceFullscreen = "workspace-fullscreen"
+)
+
+// MaximizeButtonAction is what the title-bar maximize button of a floating
+// window does: "fullscreen-toggle" (fill the screen, and put the window back
+// where it was on the next click; the default), "zoom" (the same as the zoom
+// key) or "workspace-fullscreen" (fullscreen-toggle that also minimizes the
+// workspace's other windows and restores them on the way back).
+// Set via appearance.maximize_button_action config
+var MaximizeButtonAction = MaximizeFullscreenToggle
+
+// Window close animation styles. See WindowCloseAnimation.
+const (
+	CloseAnimationNone = "none"
+	CloseAnimationDock = "dock"
+)
+
+// WindowCloseAnimation is how a closed window disappears: "none" (instantly,
+// the default) or "dock" (shrinks into the dock before it is removed). Only
+// takes effect while animations are enabled.
+// Set via appearance.window_close_animation config
+var WindowCloseAnimation = CloseAnimationNone
+
+// WindowShadows draws a shaded band below and to the right of each floating
+// window, so overlapping windows read as stacked. Tiled windows never overlap
+// and get none.
+// Set via appearance.window_shadows config
+var WindowShadows = false
+
+// SelectionColor is the hex background of selected text, both the copy-mode
+// visual range and a mouse or selection-mode selection. Empty keeps the
+// built-in color.
+// Set via appearance.selection_color config
+var SelectionColor = ""
+
+// SelectionCursorColor is the hex background of the copy-mode cursor and the
+// selection-mode cursor. Empty keeps the built-in color.
+// Set via appearance.selection_cursor_color config
+var SelectionCursorColor = ""
+
+// What Enter does in window management mode. See EnterAction.
+const (
+	EnterActionInsert = "insert"
+	EnterActionNone   = "none"
+	EnterActionNew    = "new"
+)
+
+// EnterAction is what pressing Enter does in window management mode: "insert"
+// (enter terminal mode in the focused window, the default), "none" (nothing,
+// so a stray Enter cannot drop into a pane) or "new" (open a new window). It
+// only applies while Enter is bound to enter_terminal_mode; i is unaffected.
+// Set via appearance.enter_action config
+var EnterAction = EnterActionInsert
+
+// What a left click on empty desktop does. See EmptyClickAction.
+const (
+	EmptyClickNone       = "none"
+	EmptyClickSpawn      = "spawn"
+	EmptyClickClearFocus = "clear-focus"
+)
+
+// EmptyClickAction is what a left click on the desktop, outside every window,
+// does: "none" (nothing, the default), "spawn" (open a new window, at the
+// click when floating) or "clear-focus" (unfocus all windows).
+// Set via appearance.empty_click_action config
+var EmptyClickAction = EmptyClickNone
+
+// How the mouse moves focus between windows. See FocusMode.
+const (
+	FocusModeClick = "click"
+	FocusModeHover = "hover"
+)
+
+// FocusMode is how the mouse moves focus: "click" (a window is focused when
+// clicked, the default) or "hover" (the window under the pointer is focused
+// once the pointer has rested on it for HoverFocusDelay). Clicking focuses in
+// both modes.
+// Set via appearance.focus_mode config
+var FocusMode = FocusModeClick
+
+// HoverFocusDelay is how long the pointer has to stay over a window before
+// hover focus moves to it, so sweeping across panes on the way somewhere else
+// does not flicker focus through each of them. Clamped to
+// [1ms, MaxHoverFocusDelay].
+// Set via appearance.hover_focus_delay_ms config (milliseconds)
+var HoverFocusDelay = 150 * time.Millisecond
+
+// MaxHoverFocusDelay caps HoverFocusDelay.
+const MaxHoverFocusDelay = 2 * time.Second
+
+// DefaultSplitRatio is the share of a split pane the new window gets when it
+// opens in tiling mode, clamped to [MinSplitRatio, MaxSplitRatio]. 0.5 halves
+// the pane; 0.3 gives the new window 30% and leaves 70% to the existing one.
+// A two-digit count typed before a split key overrides it for that split.
+// Set via appearance.default_split_ratio config
+var DefaultSplitRatio = 0.5
+
+// Bounds for DefaultSplitRatio and split counts, so neither side of a new
+// split can be squeezed to nothing.
+const (
+	MinSplitRatio = 0.1
+	MaxSplitRatio = 0.9
+)
+
+// MasterRatioMin and MasterRatioMax bound the master window's share of the
+// screen in master-stack tiling, both when resizing it and for a workspace
+// that has no ratio of its own yet. They are clamped to
+// [MasterRatioFloor, MasterRatioCeiling], and MasterRatioMax never drops
+// below MasterRatioMin.
+// Set via appearance.master_ratio_min and appearance.master_ratio_max config
+var (
+	MasterRatioMin = 0.3
+	MasterRatioMax = 0.7
+)
+
+// MasterRatioPresets are the master ratios the cycle-master-ratio binding
+// steps through, in order. They are clamped to [MasterRatioMin,
+// MasterRatioMax]; presets that clamp to the same ratio count once.
+// Set via appearance.master_ratio_presets config
+var MasterRatioPresets = DefaultMasterRatioPresets()
+
+// DefaultMasterRatioPresets returns MasterRatioPresets when it is not
+// configured: a third, a half and two thirds of the screen.
+func DefaultMasterRatioPresets() []float64 {
+	return []float64{0.33, 0.5, 0.67}
+}
+
+// Absolute bounds for MasterRatioMin and MasterRatioMax, so the master or the
+// stack always keeps a usable width.
+const (
+	MasterRatioFloor   = 0.1
+	MasterRatioCeiling = 0.9
+)
+
+// ClampMasterRatio limits a master ratio to [MasterRatioMin, MasterRatioMax].
+func ClampMasterRatio(r float64) float64 {
+	return min(max(r, MasterRatioMin), MasterRatioMax)
+}
+
+// SplitCountWindow is how long a typed split count stays pending. Digits in
+// window mode still select windows, so a count only applies to a split key
+// pressed right after it.
+const SplitCountWindow = time.Second
+
+// BSP auto-insertion schemes. See TilingScheme.
+const (
+	TilingSchemeSpiral      = "spiral"
+	TilingSchemeLongestSide = "longest_side"
+	TilingSchemeAlternate   = "alternate"
+	TilingSchemeSmartSplit  = "smart_split"
+)
+
+// TilingScheme is how a new tiled window picks its split axis: "spiral" (the
+// default, alternating with depth), "longest_side" (splits the target pane
+// across its longer side, so wide panes go side by side and tall panes stack),
+// "alternate" or "smart_split".
+// Set via appearance.tiling_scheme config
+var TilingScheme = TilingSchemeSpiral
+
 // ZoomMaxWidth is the maximum width in cells for zoom/zen mode.
 // 0 means fullscreen (no max width cap). When set (e.g., 120), the zoomed
 // window is centered horizontally and capped at this width.
@@ -713,10 +1397,20 @@ const (
 // Limits
 // =============================================================================
 
+// MaxLogMessages is how many log messages the log viewer keeps in memory;
+// older ones are dropped as new ones arrive.
+// Set via debug.max_log_messages config
+var MaxLogMessages = DefaultMaxLogMessages
+
 const (
-	// MaxLogMessages is the maximum number of log messages to keep in memory
-	MaxLogMessages = 100
+	// DefaultMaxLogMessages is MaxLogMessages when unset.
+	DefaultMaxLogMessages = 100
+	// MinLogMessages and MaxLogMessagesLimit bound MaxLogMessages.
+	MinLogMessages      = 10
+	MaxLogMessagesLimit = 100000
+)
 
+const (
 	// MaxWorkspaces is the maximum number of workspaces supported
 	MaxWorkspaces = 9
 
@@ -756,6 +1450,9 @@ const (
 	// ZIndexHelp is the z-index for help overlay
 	ZIndexHelp = 1000
 
+	// ZIndexPeek is the z-index for the peeked-workspace panel (leader w p)
+	ZIndexPeek = 1000
+
 	// ZIndexDock is the z-index for the dock
 	ZIndexDock = 1000
 
@@ -780,6 +1477,21 @@ const (
 	// ZIndexLayoutPicker is the z-index for layout picker overlay
 	ZIndexLayoutPicker = 1006
 
+	// ZIndexPaneNumbers is the z-index for the pane-number badges (leader #)
+	ZIndexPaneNumbers = 1007
+
+	// ZIndexThemeCycle is the z-index for the theme cycling banner (leader > and <)
+	ZIndexThemeCycle = 1008
+
+	// ZIndexTooltip is the z-index for the hover tooltip on title-bar buttons and dock items
+	ZIndexTooltip = 1009
+
+	// ZIndexContextMenu is the z-index for the right-click menu on title bars and the desktop
+	ZIndexContextMenu = 1010
+
+	// ZIndexWindowJump is the z-index for the jump-to-window prompt (leader f)
+	ZIndexWindowJump = 1011
+
 	// ZIndexOverlayBase is the base z-index for the draggable floating overlay
 	// panels (settings, theme picker, palette, etc.). Each open panel is stacked
 	// at this base plus its position in the click-to-raise order, so clicking a
@@ -24,6 +24,29 @@ func GetPrefixKeybindings(prefixType string, isDaemonSession ...bool) []Keybindi
 		return []Keybinding{
 			{"1-9", "Switch to workspace"},
 			{"Shift+1-9", "Move window to workspace"},
+			{"s", "Send window to workspace..."},
+			{"M", "Merge into workspace..."},
+			{"p", "Peek at workspace... (again to close)"},
+			{"Esc", "Cancel"},
+		}
+	case "merge":
+		return []Keybinding{
+			{"1-9", "Merge all windows into workspace"},
+			{"Esc", "Cancel"},
+		}
+	case "send":
+		return []Keybinding{
+			{"1-9", "Send window to workspace, stay here"},
+			{"Esc", "Cancel"},
+		}
+	case "peek":
+		return []Keybinding{
+			{"1-9", "Show workspace beside this one"},
+			{"Esc", "Cancel"},
+		}
+	case "header":
+		return []Keybinding{
+			{"1-9", "Freeze that many top rows"},
 			{"Esc", "Cancel"},
 		}
 	case "minimize":
@@ -38,8 +61,19 @@ func GetPrefixKeybindings(prefixType string, isDaemonSession ...bool) []Keybindi
 			{"n", "New window"},
 			{"x", "Close window"},
 			{"r", "Rename window"},
+			{"R", "Reload window (fresh shell)"},
+			{"u", "Cycle background update rate"},
 			{"Tab", "Next window"},
 			{"Shift+Tab", "Previous window"},
+			{"f", "Next floating window"},
+			{"T", "Next tiled window"},
+			{"Enter", "Promote to master"},
+			{"m", "Cycle master ratio"},
+			{"d", "Compare two windows"},
+			{"p", "Scrollback in $PAGER"},
+			{"e", "Scrollback in $EDITOR"},
+			{"h", "Freeze header rows"},
+			{"i", "Invert window colors"},
 			{"t", "Toggle tiling mode"},
 			{"Esc", "Cancel"},
 		}
@@ -49,6 +83,7 @@ func GetPrefixKeybindings(prefixType string, isDaemonSession ...bool) []Keybindi
 			{"c", "Toggle cache statistics"},
 			{"k", "Toggle showkeys overlay"},
 			{"a", "Toggle animations"},
+			{"r", "Write bug report"},
 			{"Esc", "Cancel"},
 		}
 	case "tape":
@@ -63,6 +98,24 @@ func GetPrefixKeybindings(prefixType string, isDaemonSession ...bool) []Keybindi
 		return []Keybinding{
 			{"l", "Load layout"},
 			{"s", "Save layout"},
+			{"c", "Arrange in columns"},
+			{"r", "Arrange in rows"},
+			{"g", "Arrange in a grid"},
+			{"m", "Toggle monocle"},
+			{"h", "Flip layout left/right"},
+			{"v", "Flip layout top/bottom"},
+			{"+", "Widen tiling gap"},
+			{"-", "Narrow tiling gap"},
+			{"Esc", "Cancel"},
+		}
+	case "signal":
+		return []Keybinding{
+			{"i", "Interrupt (SIGINT)"},
+			{"t", "Terminate (SIGTERM)"},
+			{"k", "Kill (SIGKILL)"},
+			{"z", "Suspend (SIGTSTP)"},
+			{"c", "Continue (SIGCONT)"},
+			{"h", "Hang up (SIGHUP)"},
 			{"Esc", "Cancel"},
 		}
 	default: // general prefix
@@ -73,13 +126,28 @@ func GetPrefixKeybindings(prefixType string, isDaemonSession ...bool) []Keybindi
 			{",", "Settings"},
 			{"n", "Next window"},
 			{"p", "Previous window"},
+			{";", "Last window"},
+			{"f", "Jump to window by name"},
 			{"0-9", "Jump to window"},
+			{"#", "Show pane numbers"},
+			{"I", "Window numbers in titles"},
+			{"/", "Find in window"},
+			{"u", "Peek scrollback"},
+			{"C", "Copy working directory"},
+			{"o", "Select last output"},
+			{"b", "Toggle multifocus"},
+			{"B", "Multifocus workspace"},
+			{"V", "Synchronized scroll"},
+			{"l/U", "Link/unlink scroll with last window"},
+			{">/<", "Cycle themes"},
 			{"z", "Toggle zoom"},
 			{"space", "Toggle tiling"},
 			{"-", "Split horizontal (top/bottom)"},
 			{"|/\\", "Split vertical (left/right)"},
 			{"R", "Rotate split direction"},
 			{"=", "Equalize splits"},
+			{"_", "Equalize focused split"},
+			{"E", "Retile workspace"},
 			{"w", "Workspace commands..."},
 			{"m", "Minimize commands..."},
 			{"t", "Window commands..."},
@@ -88,6 +156,7 @@ func GetPrefixKeybindings(prefixType string, isDaemonSession ...bool) []Keybindi
 			{"P", "Command palette"},
 			{"S", "Session switcher"},
 			{"L", "Layout commands..."},
+			{"k", "Signal commands..."},
 		}
 
 		// In daemon mode, d and Esc have different behaviors
@@ -144,6 +213,11 @@ func GetKeybindings(registry *KeybindRegistry) []KeybindingSection {
 	addBinding(&windowMgmt, registry, "restore_all", "Restore all")
 	addBinding(&windowMgmt, registry, "next_window", "Next window")
 	addBinding(&windowMgmt, registry, "prev_window", "Previous window")
+	addBinding(&windowMgmt, registry, "last_window", "Last focused window")
+	addBinding(&windowMgmt, registry, "multifocus", "Toggle multifocus")
+	addBinding(&windowMgmt, registry, "multifocus_all", "Multifocus workspace")
+	addBinding(&windowMgmt, registry, "copy_selection", "Copy selection")
+	addBinding(&windowMgmt, registry, "repeat_last_action", "Repeat last action")
 	if len(windowMgmt.Bindings) > 0 {
 		sections = append(sections, windowMgmt)
 	}
@@ -220,6 +294,8 @@ func getDefaultKeybindings() []KeybindingSection {
 				{"%s+Shift+1-9", "Move window and follow"}, // %s will be replaced with modifier key
 				{"Ctrl+B, w, 1-9", "Switch workspace (prefix)"},
 				{"Ctrl+B, w, Shift+1-9", "Move window (prefix)"},
+				{"Ctrl+B, w, s, 1-9", "Send window, stay here"},
+				{"Ctrl+B, w, M, 1-9", "Merge workspace into another"},
 			},
 		},
 		{
@@ -295,21 +371,36 @@ func getStaticHelpSections() []KeybindingSection {
 				{",/r", "Rename window"},
 				{"n/Tab", "Next window"},
 				{"p/Shift+Tab", "Previous window"},
+				{";", "Last focused window"},
+				{"f", "Jump to a window by name (any workspace)"},
 				{"0-9", "Jump to window"},
+				{"#", "Show pane numbers"},
+				{"I", "Toggle window numbers in title bars"},
+				{"/", "Find in window"},
+				{"u", "Peek a page up (any key returns)"},
+				{"b", "Toggle multifocus (broadcast) for window"},
+				{"B", "Multifocus whole workspace (again to undo)"},
+				{"V", "Synchronized scroll across visible windows"},
+				{"l", "Link scroll with the last focused window"},
+				{"U", "Unlink the focused window's scroll"},
+				{">/<", "Cycle themes (Enter keeps, Esc reverts)"},
 				{"z", "Toggle zoom"},
 				{"space", "Toggle tiling"},
 				{"-", "Split horizontal"},
 				{"|/\\", "Split vertical"},
 				{"R", "Rotate split"},
 				{"=", "Equalize splits"},
+				{"_", "Equalize focused split only"},
+				{"E", "Retile from scratch"},
 				{"w", "Workspace commands"},
 				{"m", "Minimize commands"},
 				{"t", "Window commands"},
 				{"P", "Command palette"},
 				{"S", "Session switcher"},
-				{"L", "Load layout"},
+				{"L", "Layout commands"},
 				{"D", "Debug commands"},
 				{"T", "Tape manager"},
+				{"k", "Send signal to window"},
 				{"d", "Detach (daemon) / Window mode (local)"},
 				{"Esc", "Window management mode"},
 				{"[", "Enter scrollback mode"},
@@ -338,6 +429,16 @@ func getStaticHelpSections() []KeybindingSection {
 				{"s", "Stop recording"},
 			},
 		},
+		{
+			Title: "SIGNAL PREFIX (Ctrl+B, k):",
+			Bindings: []Keybinding{
+				{"i", "Interrupt (SIGINT)"},
+				{"t", "Terminate (SIGTERM)"},
+				{"k", "Kill (SIGKILL)"},
+				{"z/c", "Suspend / continue"},
+				{"h", "Hang up (SIGHUP)"},
+			},
+		},
 		{
 			Title: "",
 			Bindings: []Keybinding{
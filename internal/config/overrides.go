@@ -2,6 +2,8 @@ package config
 
 import (
 	"log"
+	"os"
+	"strconv"
 
 	"github.com/Gaurav-Gosain/tuios/internal/theme"
 )
@@ -56,11 +58,56 @@ type Overrides struct {
 
 	// ZoomMaxWidth caps the zoom mode width (0 = fullscreen)
 	ZoomMaxWidth int
+
+	// TilingGap overrides the gap between tiled windows (0 means use config)
+	TilingGap int
+}
+
+// Environment variables that override the config file. They are for contexts
+// such as containers, where setting the environment is easier than shipping a
+// config file.
+const (
+	EnvTheme           = "TUIOS_THEME"
+	EnvBorderStyle     = "TUIOS_BORDER_STYLE"
+	EnvScrollbackLines = "TUIOS_SCROLLBACK_LINES"
+	EnvDockbarPosition = "TUIOS_DOCKBAR_POSITION"
+)
+
+// withEnv fills every override that a CLI flag left unset from its environment
+// variable. Running it before the overrides are applied is what makes the
+// precedence flags > env > config file > defaults: a flag still wins because
+// its field is already set, and the env value then beats the config file
+// exactly the way a flag would.
+func (overrides Overrides) withEnv() Overrides {
+	if overrides.ThemeName == "" {
+		overrides.ThemeName = os.Getenv(EnvTheme)
+	}
+	if overrides.BorderStyle == "" {
+		overrides.BorderStyle = os.Getenv(EnvBorderStyle)
+	}
+	if overrides.DockbarPosition == "" {
+		overrides.DockbarPosition = os.Getenv(EnvDockbarPosition)
+	}
+	if overrides.ScrollbackLines == 0 {
+		if value := os.Getenv(EnvScrollbackLines); value != "" {
+			lines, err := strconv.Atoi(value)
+			if err != nil || lines <= 0 {
+				log.Printf("Warning: ignoring %s=%q: not a positive number", EnvScrollbackLines, value)
+			} else {
+				overrides.ScrollbackLines = lines
+			}
+		}
+	}
+	return overrides
 }
 
 // ApplyOverrides applies CLI flag overrides to global config, falling back to user config defaults.
 // If userConfig is nil, only CLI flag values (when set) are applied.
+// Settings with a TUIOS_* environment variable (see withEnv) read it between
+// the flag and the config file.
 func ApplyOverrides(overrides Overrides, userConfig *UserConfig) {
+	overrides = overrides.withEnv()
+
 	// ASCII Only - simple flag override
 	if overrides.ASCIIOnly {
 		UseASCIIOnly = true
@@ -164,6 +211,12 @@ func ApplyOverrides(overrides Overrides, userConfig *UserConfig) {
 		SharedBorders = *userConfig.Appearance.SharedBorders
 	}
 
+	// Tiling Gap - CLI flag takes precedence; the config value is already
+	// applied by ApplyAppearanceConfig
+	if overrides.TilingGap > 0 {
+		TilingGap = min(overrides.TilingGap, MaxTilingGap)
+	}
+
 	// Theme - CLI flag takes precedence, otherwise use user config
 	themeName := overrides.ThemeName
 	if themeName == "" && userConfig != nil && userConfig.Appearance.Theme != "" {
@@ -99,9 +99,25 @@ func (r *KeybindRegistry) GetTerminalModeAction(key string) string {
 	return r.lookupKeyInSection(key, r.config.Keybindings.TerminalMode)
 }
 
+// GetCopyModeAction returns the action name for a given key in copy mode.
+// Letters are matched by exact case: copy mode gives w and W, n and N
+// different motions, so an unbound capital must not fall back to the
+// lowercase binding the way it does in the other sections.
+func (r *KeybindRegistry) GetCopyModeAction(key string) string {
+	key = strings.TrimSpace(key)
+	if isSingleRuneLetter(key) {
+		return r.sectionKeyMap(r.config.Keybindings.CopyMode)[key]
+	}
+	return r.lookupKeyInSection(key, r.config.Keybindings.CopyMode)
+}
+
 // lookupKeyInSection looks up a key in a specific config section
 func (r *KeybindRegistry) lookupKeyInSection(key string, section map[string][]string) string {
-	// Build a temporary map for this section
+	return r.lookupKey(key, r.sectionKeyMap(section))
+}
+
+// sectionKeyMap builds a temporary key-to-action map for one config section
+func (r *KeybindRegistry) sectionKeyMap(section map[string][]string) map[string]string {
 	tempMap := make(map[string]string)
 	for action, keys := range section {
 		expandedKeys := r.normalizer.ExpandKeys(keys)
@@ -109,7 +125,7 @@ func (r *KeybindRegistry) lookupKeyInSection(key string, section map[string][]st
 			tempMap[k] = action
 		}
 	}
-	return r.lookupKey(key, tempMap)
+	return tempMap
 }
 
 // lookupKey performs the actual key lookup with case handling
@@ -154,6 +170,7 @@ func (r *KeybindRegistry) GetKeys(action string) []string {
 		r.config.Keybindings.DebugPrefix,
 		r.config.Keybindings.TapePrefix,
 		r.config.Keybindings.TerminalMode,
+		r.config.Keybindings.CopyMode,
 	}
 
 	for _, section := range sections {
@@ -248,6 +265,9 @@ var ActionDescriptions = map[string]string{
 	"toggle_zoom":     "Toggle zoom (fullscreen)",
 	"next_window":     "Next window",
 	"prev_window":     "Previous window",
+	"last_window":     "Toggle the last focused window",
+	"multifocus":      "Add or remove the focused window from multifocus (broadcast input)",
+	"multifocus_all":  "Multifocus every window on the workspace, or remove them",
 	"select_window_1": "Select window 1",
 	"select_window_2": "Select window 2",
 	"select_window_3": "Select window 3",
@@ -258,6 +278,8 @@ var ActionDescriptions = map[string]string{
 	"select_window_8": "Select window 8",
 	"select_window_9": "Select window 9",
 
+	"repeat_last_action": "Repeat the last snap, swap, resize or split",
+
 	// Workspaces
 	"switch_workspace_1": "Switch to workspace 1",
 	"switch_workspace_2": "Switch to workspace 2",
@@ -306,6 +328,7 @@ var ActionDescriptions = map[string]string{
 	"split_vertical":   "Split window vertically (left/right)",
 	"rotate_split":     "Rotate split direction",
 	"equalize_splits":  "Equalize all split ratios",
+	"equalize_focused": "Equalize the focused window's split only",
 	"preselect_left":   "Preselect left for next window",
 	"preselect_right":  "Preselect right for next window",
 	"preselect_up":     "Preselect up for next window",
@@ -318,6 +341,7 @@ var ActionDescriptions = map[string]string{
 	"quit":                "Quit",
 
 	// Clipboard
+	"copy_selection":  "Copy the selected text",
 	"paste_clipboard": "Paste from clipboard",
 
 	// System
@@ -359,10 +383,28 @@ var ActionDescriptions = map[string]string{
 	"prefix_split_vertical":   "Split window vertically",
 	"prefix_rotate_split":     "Rotate split direction",
 	"prefix_equalize_splits":  "Equalize all splits",
+	"prefix_equalize_focused": "Equalize the focused window's split only",
 	"prefix_scrollback":       "Open the scrollback browser",
 	"prefix_command_palette":  "Open the command palette",
 	"prefix_session_switcher": "Open the session switcher",
 	"prefix_layout":           "Enter layout prefix",
+	"prefix_display_panes":    "Show pane numbers",
+	"prefix_window_indices":   "Toggle window numbers in title bars",
+	"prefix_find":             "Find in window",
+	"prefix_signal":           "Enter signal prefix",
+	"prefix_theme_next":       "Cycle to the next theme",
+	"prefix_theme_prev":       "Cycle to the previous theme",
+	"prefix_peek":             "Peek one page up the scrollback",
+	"prefix_retile":           "Rebuild the tiling layout from scratch",
+	"prefix_last_window":      "Toggle the last focused window",
+	"prefix_jump_window":      "Jump to a window on any workspace by name",
+	"prefix_copy_cwd":         "Copy the focused window's working directory",
+	"prefix_multifocus":       "Add or remove the focused window from multifocus (broadcast input)",
+	"prefix_multifocus_all":   "Multifocus every window on the workspace, or remove them",
+	"prefix_sync_scroll":      "Toggle synchronized scroll: scrolling one window scrolls every visible window",
+	"prefix_link_scroll":      "Link the focused window's scroll with the previously focused window",
+	"prefix_unlink_scroll":    "Unlink the focused window's scroll",
+	"prefix_select_output":    "Select the last command's output in copy mode",
 
 	// Tape Prefix
 	"tape_prefix_manager": "Open tape manager",
@@ -380,10 +422,60 @@ var ActionDescriptions = map[string]string{
 	"debug_prefix_cache":      "Toggle cache statistics",
 	"debug_prefix_animations": "Toggle animations",
 	"debug_prefix_showkeys":   "Toggle showkeys overlay",
+	"debug_prefix_report":     "Write a bug report bundle",
 	"debug_prefix_cancel":     "Cancel debug prefix",
 
 	// Terminal Mode (direct keybinds, no prefix required)
 	"terminal_next_window": "Next window (terminal mode)",
 	"terminal_prev_window": "Previous window (terminal mode)",
 	"terminal_exit_mode":   "Exit terminal mode (to window mode)",
+	"terminal_passthrough": "Toggle sending the leader key to the pane",
+
+	// Copy Mode
+	"copy_mode_exit":                "Exit copy mode (visual: back to normal)",
+	"copy_mode_terminal":            "Exit copy mode into terminal mode",
+	"copy_mode_left":                "Move cursor left",
+	"copy_mode_down":                "Move cursor down",
+	"copy_mode_up":                  "Move cursor up",
+	"copy_mode_right":               "Move cursor right",
+	"copy_mode_word_forward":        "Next word",
+	"copy_mode_word_backward":       "Previous word",
+	"copy_mode_word_end":            "End of word",
+	"copy_mode_big_word_forward":    "Next WORD",
+	"copy_mode_big_word_backward":   "Previous WORD",
+	"copy_mode_big_word_end":        "End of WORD",
+	"copy_mode_line_start":          "Start of line",
+	"copy_mode_first_non_blank":     "First non-blank of line",
+	"copy_mode_line_end":            "End of line",
+	"copy_mode_half_page_up":        "Half page up",
+	"copy_mode_half_page_down":      "Half page down",
+	"copy_mode_page_up":             "Page up",
+	"copy_mode_page_down":           "Page down",
+	"copy_mode_top":                 "Jump to top (press twice)",
+	"copy_mode_bottom":              "Jump to bottom, or line N with a count",
+	"copy_mode_screen_top":          "Top of screen",
+	"copy_mode_screen_middle":       "Middle of screen",
+	"copy_mode_screen_bottom":       "Bottom of screen",
+	"copy_mode_paragraph_up":        "Previous paragraph",
+	"copy_mode_paragraph_down":      "Next paragraph",
+	"copy_mode_prev_prompt":         "Previous shell prompt (press twice)",
+	"copy_mode_next_prompt":         "Next shell prompt (press twice)",
+	"copy_mode_matching_bracket":    "Jump to matching bracket",
+	"copy_mode_find_forward":        "Find char forward on line",
+	"copy_mode_find_backward":       "Find char backward on line",
+	"copy_mode_till_forward":        "Till char forward on line",
+	"copy_mode_till_backward":       "Till char backward on line",
+	"copy_mode_repeat_find":         "Repeat last char search",
+	"copy_mode_repeat_find_reverse": "Repeat last char search reversed",
+	"copy_mode_search_forward":      "Search forward",
+	"copy_mode_search_backward":     "Search backward",
+	"copy_mode_next_match":          "Next search match",
+	"copy_mode_prev_match":          "Previous search match",
+	"copy_mode_clear_search":        "Clear search highlights",
+	"copy_mode_visual":              "Toggle visual mode",
+	"copy_mode_visual_line":         "Toggle visual line mode",
+	"copy_mode_select_output":       "Select the last command's output",
+	"copy_mode_set_mark":            "Set a mark (then a-z)",
+	"copy_mode_jump_mark":           "Jump to a mark (then a-z, or ` for the last jump)",
+	"copy_mode_yank":                "Yank selection to clipboard",
 }
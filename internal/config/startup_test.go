@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/Gaurav-Gosain/tuios/internal/config"
 	toml "github.com/pelletier/go-toml/v2"
@@ -20,6 +21,9 @@ func TestStartupConfigDefaults(t *testing.T) {
 	if cfg.Startup.StartInTerminalMode {
 		t.Error("start_in_terminal_mode should default to false")
 	}
+	if cfg.Startup.AutoAttach {
+		t.Error("auto_attach should default to false")
+	}
 }
 
 // TestStartupConfigParsing confirms both options round-trip from TOML.
@@ -29,6 +33,7 @@ func TestStartupConfigParsing(t *testing.T) {
 open_default_window = true
 tiled = true
 start_in_terminal_mode = true
+auto_attach = true
 `
 	var cfg config.UserConfig
 	if err := toml.Unmarshal([]byte(src), &cfg); err != nil {
@@ -43,6 +48,9 @@ start_in_terminal_mode = true
 	if !cfg.Startup.StartInTerminalMode {
 		t.Error("expected start_in_terminal_mode = true after parsing")
 	}
+	if !cfg.Startup.AutoAttach {
+		t.Error("expected auto_attach = true after parsing")
+	}
 }
 
 // TestStartupConfigAbsentDefaultsFalse confirms that omitting the [startup]
@@ -61,3 +69,31 @@ border_style = "rounded"
 			cfg.Startup.OpenDefaultWindow, cfg.Startup.Tiled, cfg.Startup.StartInTerminalMode)
 	}
 }
+
+// TestFormatSessionName covers startup.session_name_format: the default keeps
+// the historical session-N names, and no format ever hands back a name that is
+// already taken.
+func TestFormatSessionName(t *testing.T) {
+	now := time.Date(2026, 3, 4, 9, 5, 7, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		format   string
+		existing []string
+		want     string
+	}{
+		{name: "empty format is session-N", format: "", want: "session-0"},
+		{name: "counter skips used names", format: "", existing: []string{"session-0", "session-1"}, want: "session-2"},
+		{name: "custom counter", format: "work{n}", existing: []string{"work0"}, want: "work1"},
+		{name: "date and time", format: "{date}_{time}", want: "2026-03-04_09-05-07"},
+		{name: "collision without counter gets a suffix", format: "{date}", existing: []string{"2026-03-04", "2026-03-04-1"}, want: "2026-03-04-2"},
+		{name: "whitespace only falls back", format: "  ", want: "session-0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := config.FormatSessionName(tt.format, tt.existing, now); got != tt.want {
+				t.Errorf("FormatSessionName(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"runtime"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/Gaurav-Gosain/tuios/internal/theme"
 	"github.com/adrg/xdg"
@@ -21,6 +22,19 @@ type UserConfig struct {
 	Tape        TapeConfig        `toml:"tape"`
 	Hooks       HooksConfig       `toml:"hooks"`
 	Debug       DebugConfig       `toml:"debug"`
+	WindowRules []WindowRule      `toml:"window_rules,omitempty"`
+}
+
+// WindowRule applies settings to a window started with a command whose
+// program name matches Match, like i3's for_window and assign. Rules are
+// written as [[window_rules]] tables and the first match wins.
+type WindowRule struct {
+	Match     string `toml:"match"`     // Glob matched against the command's program name, e.g. "htop" or "*top"
+	Floating  bool   `toml:"floating"`  // Keep the window out of the tiling layout
+	Sticky    bool   `toml:"sticky"`    // Float the window and carry it along on workspace switches
+	Width     string `toml:"width"`     // Width in cells ("100") or percent of the screen ("40%"), like new_window_width
+	Height    string `toml:"height"`    // Height in cells ("30") or percent of the screen ("40%"), like new_window_height
+	Workspace int    `toml:"workspace"` // Open the window on this workspace instead of the current one
 }
 
 // DebugConfig holds diagnostic settings. These are off by default so a normal
@@ -32,15 +46,40 @@ type DebugConfig struct {
 	// --show-keys flag, the settings entry, the command palette, and the
 	// leader-D-k keybinding. Default false.
 	ShowKeyEvents bool `toml:"show_key_events"`
+	// MaxLogMessages is how many messages the log viewer (leader-D-l) keeps
+	// before dropping the oldest, between 10 and 100000. Raise it to capture a
+	// longer trail before exporting the logs for a bug report. Default 100.
+	MaxLogMessages int `toml:"max_log_messages"`
 }
 
 // StartupConfig holds settings that only take effect when a session starts.
-// Both default to false so a fresh install behaves exactly as before: the
+// All default to false so a fresh install behaves exactly as before: the
 // session comes up empty and floating, and the user opens the first window.
 type StartupConfig struct {
 	OpenDefaultWindow   bool `toml:"open_default_window"`    // Open one terminal window automatically when a session starts with none (default: false)
 	Tiled               bool `toml:"tiled"`                  // Start a new session with tiling enabled instead of floating (default: false)
 	StartInTerminalMode bool `toml:"start_in_terminal_mode"` // Start focused in terminal mode so typing goes straight to the shell, when a window is present (default: false)
+	AutoAttach          bool `toml:"auto_attach"`            // Make a bare 'tuios' attach to the most recent daemon session when one exists, like --attach-or-new (default: false)
+	RestoreModeOnAttach bool `toml:"restore_mode_on_attach"` // Come back from a reattach in the mode the session was left in instead of always terminal mode (default: false)
+
+	// StartupCommand and ShutdownCommand are shell commands run with sh -c
+	// when a client starts and when it exits, for side effects such as
+	// telling a status bar or setting up the environment. Their output goes
+	// to the log. Empty (the default) runs nothing.
+	StartupCommand  string `toml:"startup_command"`
+	ShutdownCommand string `toml:"shutdown_command"`
+
+	// OnEmptySessionAttach is what attaching to an existing session with no
+	// windows left does: "stay" (the default) lands in the empty session,
+	// "spawn-shell" opens a terminal in it, and "kill-session" kills it and
+	// exits instead of attaching. A session that has just been created is not
+	// affected; open_default_window covers that.
+	OnEmptySessionAttach string `toml:"on_empty_session_attach"`
+
+	// SessionNameFormat is the template for sessions created without a name.
+	// Supports {n} (lowest free counter), {date} and {time}. Empty means
+	// "session-{n}".
+	SessionNameFormat string `toml:"session_name_format"`
 }
 
 // TapeConfig holds settings for per-directory project tapes (.tuios.tape).
@@ -92,21 +131,87 @@ type AppearanceConfig struct {
 	AnimationsEnabled   *bool  `toml:"animations_enabled"`    // Enable UI animations (default: true). Set to false for instant transitions.
 	ConfirmQuit         *bool  `toml:"confirm_quit"`          // Always show quit confirmation dialog (default: false). When false, only shown if foreground processes are running.
 	WhichKeyEnabled     *bool  `toml:"whichkey_enabled"`      // Show which-key popup after pressing leader key (default: true)
+	ShowTooltips        *bool  `toml:"show_tooltips"`         // Show a label when the mouse rests on a title-bar button or dock item (default: true)
+	WindowCycleWrap     *bool  `toml:"window_cycle_wrap"`     // Cycling windows goes round from the last to the first and back; false stops at the ends (default: true)
 	WhichKeyPosition    string `toml:"whichkey_position"`     // Which-key popup position: bottom-right, bottom-left, top-right, top-left, center (default: bottom-right)
 	WindowTitlePosition string `toml:"window_title_position"` // Window title position: bottom, top, hidden (default: bottom). Shows CustomName if set, else terminal title.
+	ShowTitleBars       string `toml:"show_title_bars"`       // When windows draw their title bar row: always, multiple (only with other windows on the workspace), never (default: always)
 	HideClock           bool   `toml:"hide_clock"`            // Hide the clock overlay (deprecated, use show_clock)
 	ShowClock           bool   `toml:"show_clock"`            // Show the clock overlay (default: false)
 	ShowCPU             bool   `toml:"show_cpu"`              // Show CPU graph in dock (default: false)
 	ShowRAM             bool   `toml:"show_ram"`              // Show RAM usage in dock (default: false)
+	DockWorkspaces      string `toml:"dock_workspaces"`       // Workspace list in the dock: off, all, occupied (only those with windows, plus the current) (default: off)
 	Theme               string `toml:"theme"`                 // Color theme name (e.g., dracula, nord, my-custom-theme)
 	SharedBorders       *bool  `toml:"shared_borders"`        // Share borders between adjacent tiled windows (default: false)
 	// Customization
-	BorderFocusedColor   string `toml:"border_focused_color"`   // Hex color for focused pane border (e.g., "#89b4fa")
-	BorderUnfocusedColor string `toml:"border_unfocused_color"` // Hex color for unfocused pane border (e.g., "#585b70")
-	WindowTitleFormat    string `toml:"window_title_format"`    // Format string for window titles: {title}, {index}, {cwd}
-	ZoomMaxWidth         int    `toml:"zoom_max_width"`         // Max width in cells for zoom mode (0 = fullscreen, e.g. 120 centers at 120 cols)
-	NiriReverseScroll    bool   `toml:"niri_reverse_scroll"`    // Reverse mouse scroll direction in niri scrolling mode (default: false)
-	MaxFPS               int    `toml:"max_fps"`                // Maximum render FPS (default: 60, max: 120)
+	BorderFocusedColor   string  `toml:"border_focused_color"`   // Hex color for focused pane border (e.g., "#89b4fa")
+	BorderUnfocusedColor string  `toml:"border_unfocused_color"` // Hex color for unfocused pane border (e.g., "#585b70")
+	SelectionColor       string  `toml:"selection_color"`        // Hex background for selected text in copy and selection mode (e.g., "#45475a")
+	SelectionCursorColor string  `toml:"selection_cursor_color"` // Hex background for the copy-mode and selection cursor (e.g., "#f5c2e7")
+	WindowTitleFormat    string  `toml:"window_title_format"`    // Format string for window titles: {title}, {index}, {cwd}
+	ZoomMaxWidth         int     `toml:"zoom_max_width"`         // Max width in cells for zoom mode (0 = fullscreen, e.g. 120 centers at 120 cols)
+	NiriReverseScroll    bool    `toml:"niri_reverse_scroll"`    // Reverse mouse scroll direction in niri scrolling mode (default: false)
+	MaxFPS               int     `toml:"max_fps"`                // Maximum render FPS (default: 60, max: 120)
+	CopyModeExitTo       string  `toml:"copy_mode_exit_to"`      // Mode that q/esc in copy mode returns to: window, terminal (default: window)
+	PaneNumbersDuration  int     `toml:"pane_numbers_duration"`  // Milliseconds the pane-number overlay (leader #) stays up (default: 1000)
+	CopyModeKeyAccel     bool    `toml:"copy_mode_key_accel"`    // Speed up held h/j/k/l in copy mode by growing the step count (default: false)
+	WindowOpenAnimation  string  `toml:"window_open_animation"`  // How new windows appear: none, center, cursor (default: none)
+	WindowCloseAnimation string  `toml:"window_close_animation"` // How closed windows disappear: none, dock (default: none)
+	WindowShadows        bool    `toml:"window_shadows"`         // Draw a drop shadow under floating windows (default: false)
+	MaximizeButtonAction string  `toml:"maximize_button_action"` // Title-bar maximize button: fullscreen-toggle, zoom, workspace-fullscreen (default: fullscreen-toggle)
+	NewWindowWidth       string  `toml:"new_window_width"`       // Width of a new floating window, in cells ("100") or percent of the screen ("40%") (default: "50%")
+	NewWindowHeight      string  `toml:"new_window_height"`      // Height of a new floating window, in cells ("30") or percent of the screen ("40%") (default: "50%")
+	NewFloatingZOrder    string  `toml:"new_floating_z_order"`   // Where a new floating window is stacked: top, bottom, respect-cursor (default: top)
+	DockAutoHide         bool    `toml:"dock_auto_hide"`         // Hide the dock while nothing is minimized; reveal it at the screen edge (default: false)
+	MaxPtyBytesPerSec    int     `toml:"max_pty_bytes_per_sec"`  // Cap on PTY output consumed per window per second (default: 0, no limit)
+	TilingScheme         string  `toml:"tiling_scheme"`          // How new tiled windows split: spiral, longest_side, alternate, smart_split (default: spiral)
+	EnterAction          string  `toml:"enter_action"`           // What Enter does in window mode: insert, none, new (default: insert)
+	EmptyClickAction     string  `toml:"empty_click_action"`     // What a left click on empty desktop does: none, spawn, clear-focus (default: none)
+	DefaultSplitRatio    float64 `toml:"default_split_ratio"`    // Share of a split pane a new tiled window gets, 0.1-0.9 (default: 0.5)
+	DockMargin           int     `toml:"dock_margin"`            // Blank rows between windows and the dock, 0-5 (default: 0)
+	DynamicWorkspaces    bool    `toml:"dynamic_workspaces"`     // Create workspaces on demand and remove empty ones, GNOME-style (default: false)
+	PeekSide             string  `toml:"peek_side"`              // Half of the screen a peeked workspace covers: auto, left, right (default: auto)
+	FocusMode            string  `toml:"focus_mode"`             // How the mouse focuses windows: click, hover (default: click)
+	HoverFocusDelayMs    int     `toml:"hover_focus_delay_ms"`   // Milliseconds the pointer rests on a window before hover focus moves there (default: 150)
+	MasterRatioMin       float64 `toml:"master_ratio_min"`       // Smallest master window share in master-stack tiling, 0.1-0.9 (default: 0.3)
+	MasterRatioMax       float64 `toml:"master_ratio_max"`       // Largest master window share in master-stack tiling, 0.1-0.9 (default: 0.7)
+	StatusCommand        string  `toml:"status_command"`         // Shell command whose first output line is shown in the dock (default: none)
+	StatusInterval       int     `toml:"status_interval"`        // Seconds between status_command runs (default: 5)
+	// Master ratio presets
+	MasterRatioPresets []float64 `toml:"master_ratio_presets"` // Master ratios Ctrl+B t m cycles through in master-stack tiling, clamped to master_ratio_min/max (default: [0.33, 0.5, 0.67])
+	// Tiling
+	PromoteKeepsFocusSlot bool `toml:"promote_keeps_focus_slot"` // Promoting to master leaves focus on the slot it was in instead of following the window (default: false)
+	// Resource limits
+	TotalScrollbackBudgetMB int `toml:"total_scrollback_budget_mb"` // Memory cap for all windows' scrollback together, trimming the least recently focused first (default: 0, off)
+	BackgroundUpdateDivisor int `toml:"background_update_divisor"`  // Redraw unfocused windows with new output every Nth render cycle, 1-600 (default: 3, ~20Hz)
+	PtyReadBufferSize       int `toml:"pty_read_buffer_size"`       // Bytes read from a window's PTY at a time, 1024-1048576 (default: 32768)
+	ResizeDebounceMs        int `toml:"resize_debounce_ms"`         // Milliseconds the terminal size must hold still before windows are relaid out, up to 1000; negative applies every resize at once (default: 30)
+	// Input
+	PasteStripTrailingNewline bool              `toml:"paste_strip_trailing_newline"` // Drop trailing newlines from pasted text so the last line is not run (default: false)
+	MouseButtons              map[string]string `toml:"mouse_buttons"`                // Action per button (left, middle, right): drag, resize, close, paste, none (default: left=drag, right=resize, middle=none)
+	KeyBytes                  map[string]string `toml:"key_bytes"`                    // Raw bytes to send for a key in terminal mode, e.g. "ctrl+left" = "\u001b[1;5D" (default: none)
+	// Notifications
+	MaxNotifications       int    `toml:"max_notifications"`        // Notifications on screen at once; a new one past it drops the oldest, up to 10 (default: 3)
+	NotificationPosition   string `toml:"notification_position"`    // Where notifications stack: top-right, top-left, top-center, bottom-right, bottom-left, bottom-center (default: top-right)
+	NotificationDurationMs int    `toml:"notification_duration_ms"` // Milliseconds a notification stays up, 500 to 60000 (default: 1500)
+	// Window content
+	WindowContentPadding int `toml:"window_content_padding"` // Blank cells between a window's border and its terminal on each side, up to 4 (default: 0)
+	// Quitting
+	ConfirmQuitIfBusy *bool `toml:"confirm_quit_if_busy"` // Confirm quitting when a window runs something besides its shell, such as an editor or ssh (default: true)
+	// New windows
+	AutoTileFromSecondWindow bool `toml:"auto_tile_from_second_window"` // Split the screen side by side when a second window opens on a floating workspace (default: false)
+	// Copy mode
+	CopyModeSearchHistory int `toml:"copy_mode_search_history"` // Past search queries each window keeps for up/down in the / and ? prompts, up to 1000; negative keeps none (default: 50)
+	// Tiling gaps
+	TilingGap int `toml:"tiling_gap"` // Blank cells between tiled windows and around them, up to 8 (default: 0)
+	// Copy mode search case
+	CopyModeSmartCase bool `toml:"copy_mode_smart_case"` // Search ignores case unless the query has an uppercase letter (default: false)
+	// Window names
+	AutoRenameFromCommand bool `toml:"auto_rename_from_command"` // Name windows after their foreground command until renamed by hand (default: false)
+	// Tape playback
+	TapeFinishHideMs int  `toml:"tape_finish_hide_ms"` // Milliseconds a finished tape's DONE indicator stays up (default: 2000)
+	TapeFinishHold   bool `toml:"tape_finish_hold"`    // Keep a finished tape's DONE indicator up until a key is pressed (default: false)
+	TapeLoop         bool `toml:"tape_loop"`           // Restart a played tape from the top when it finishes (default: false)
 }
 
 // Tape autorun modes. See TapeConfig.Autorun.
@@ -119,6 +224,16 @@ const (
 // TapeAutorunModes lists the valid values for tape.autorun.
 var TapeAutorunModes = []string{TapeAutorunOff, TapeAutorunAsk, TapeAutorunAuto}
 
+// What attaching to an empty session does. See StartupConfig.OnEmptySessionAttach.
+const (
+	EmptyAttachStay        = "stay"
+	EmptyAttachSpawnShell  = "spawn-shell"
+	EmptyAttachKillSession = "kill-session"
+)
+
+// EmptyAttachModes lists the valid values for startup.on_empty_session_attach.
+var EmptyAttachModes = []string{EmptyAttachStay, EmptyAttachSpawnShell, EmptyAttachKillSession}
+
 // HooksConfig holds shell command hooks for events.
 type HooksConfig map[string]any
 
@@ -139,6 +254,7 @@ type KeybindingsConfig struct {
 	DebugPrefix      map[string][]string `toml:"debug_prefix"`
 	TapePrefix       map[string][]string `toml:"tape_prefix"`
 	TerminalMode     map[string][]string `toml:"terminal_mode"` // Direct keybinds in terminal mode (no prefix required)
+	CopyMode         map[string][]string `toml:"copy_mode"`     // Motions and commands inside copy mode (leader + [)
 }
 
 // DefaultConfig returns the default configuration
@@ -161,6 +277,7 @@ func DefaultConfig() *UserConfig {
 			OpenDefaultWindow:   false,
 			Tiled:               false,
 			StartInTerminalMode: false,
+			AutoAttach:          false,
 		},
 		Tape: TapeConfig{
 			Autorun:    TapeAutorunAsk,
@@ -177,6 +294,10 @@ func DefaultConfig() *UserConfig {
 				"toggle_zoom":     {"z"},
 				"next_window":     {"tab"},
 				"prev_window":     {"shift+tab"},
+				"last_window":     {";"},
+				"multifocus":      {"b"},
+				"multifocus_all":  {"B"},
+				"copy_selection":  {"y"},
 				"select_window_1": {"1"},
 				"select_window_2": {"2"},
 				"select_window_3": {"3"},
@@ -186,6 +307,8 @@ func DefaultConfig() *UserConfig {
 				"select_window_7": {"7"},
 				"select_window_8": {"8"},
 				"select_window_9": {"9"},
+				// Replays the last snap, swap, resize or split
+				"repeat_last_action": {"a"},
 			},
 			Workspaces: getDefaultWorkspaceKeybinds(),
 			Layout:     getDefaultLayoutKeybinds(),
@@ -253,19 +376,48 @@ func DefaultConfig() *UserConfig {
 				"prefix_split_vertical":   {"|", "\\"},
 				"prefix_rotate_split":     {"R"},
 				"prefix_equalize_splits":  {"="},
+				"prefix_equalize_focused": {"_"},
 				"prefix_scrollback":       {"s"},
 				"prefix_command_palette":  {"P"},
 				"prefix_session_switcher": {"S"},
 				"prefix_layout":           {"L"},
+				"prefix_display_panes":    {"#"},
+				"prefix_window_indices":   {"I"},
+				"prefix_find":             {"/"},
+				"prefix_signal":           {"k"},
+				"prefix_theme_next":       {">"},
+				"prefix_theme_prev":       {"<"},
+				"prefix_peek":             {"u"},
+				"prefix_retile":           {"E"},
+				"prefix_last_window":      {";"},
+				"prefix_jump_window":      {"f"},
+				"prefix_copy_cwd":         {"C"},
+				"prefix_select_output":    {"o"},
+				"prefix_multifocus":       {"b"},
+				"prefix_multifocus_all":   {"B"},
+				"prefix_sync_scroll":      {"V"},
+				"prefix_link_scroll":      {"l"},
+				"prefix_unlink_scroll":    {"U"},
 			},
 			WindowPrefix: map[string][]string{
-				"window_prefix_new":    {"n"},
-				"window_prefix_close":  {"x"},
-				"window_prefix_rename": {"r"},
-				"window_prefix_next":   {"tab"},
-				"window_prefix_prev":   {"shift+tab"},
-				"window_prefix_tiling": {"t"},
-				"window_prefix_cancel": {"esc"},
+				"window_prefix_new":         {"n"},
+				"window_prefix_close":       {"x"},
+				"window_prefix_rename":      {"r"},
+				"window_prefix_reload":      {"R"},
+				"window_prefix_update_rate": {"u"},
+				"window_prefix_next":        {"tab"},
+				"window_prefix_prev":        {"shift+tab"},
+				"window_prefix_next_float":  {"f"},
+				"window_prefix_next_tiled":  {"T"},
+				"window_prefix_promote":     {"enter"},
+				"window_prefix_cycle_ratio": {"m"},
+				"window_prefix_compare":     {"d"},
+				"window_prefix_pager":       {"p"},
+				"window_prefix_editor":      {"e"},
+				"window_prefix_header":      {"h"},
+				"window_prefix_invert":      {"i"},
+				"window_prefix_tiling":      {"t"},
+				"window_prefix_cancel":      {"esc"},
 			},
 			MinimizePrefix: map[string][]string{
 				"minimize_prefix_focused":     {"m"},
@@ -300,6 +452,9 @@ func DefaultConfig() *UserConfig {
 				"workspace_prefix_move_7":   {"&"},
 				"workspace_prefix_move_8":   {"*"},
 				"workspace_prefix_move_9":   {"("},
+				"workspace_prefix_send":     {"s"},
+				"workspace_prefix_merge":    {"M"},
+				"workspace_prefix_peek":     {"p"},
 				"workspace_prefix_cancel":   {"esc"},
 			},
 			DebugPrefix: map[string][]string{
@@ -307,6 +462,7 @@ func DefaultConfig() *UserConfig {
 				"debug_prefix_cache":      {"c"},
 				"debug_prefix_animations": {"a"},
 				"debug_prefix_showkeys":   {"k"},
+				"debug_prefix_report":     {"r"},
 				"debug_prefix_cancel":     {"esc"},
 			},
 			TapePrefix: map[string][]string{
@@ -317,6 +473,54 @@ func DefaultConfig() *UserConfig {
 				"tape_prefix_cancel":  {"esc"},
 			},
 			TerminalMode: getDefaultTerminalModeKeybinds(),
+			CopyMode: map[string][]string{
+				"copy_mode_exit":                {"q", "esc"},
+				"copy_mode_terminal":            {"i"},
+				"copy_mode_left":                {"h", "left"},
+				"copy_mode_down":                {"j", "down"},
+				"copy_mode_up":                  {"k", "up"},
+				"copy_mode_right":               {"l", "right"},
+				"copy_mode_word_forward":        {"w"},
+				"copy_mode_word_backward":       {"b"},
+				"copy_mode_word_end":            {"e"},
+				"copy_mode_big_word_forward":    {"W"},
+				"copy_mode_big_word_backward":   {"B"},
+				"copy_mode_big_word_end":        {"E"},
+				"copy_mode_line_start":          {"0"},
+				"copy_mode_first_non_blank":     {"^"},
+				"copy_mode_line_end":            {"$"},
+				"copy_mode_half_page_up":        {"ctrl+u"},
+				"copy_mode_half_page_down":      {"ctrl+d"},
+				"copy_mode_page_up":             {"ctrl+b", "pgup"},
+				"copy_mode_page_down":           {"ctrl+f", "pgdown"},
+				"copy_mode_top":                 {"g"},
+				"copy_mode_bottom":              {"G"},
+				"copy_mode_screen_top":          {"H"},
+				"copy_mode_screen_middle":       {"M"},
+				"copy_mode_screen_bottom":       {"L"},
+				"copy_mode_paragraph_up":        {"{"},
+				"copy_mode_paragraph_down":      {"}"},
+				"copy_mode_prev_prompt":         {"["},
+				"copy_mode_next_prompt":         {"]"},
+				"copy_mode_matching_bracket":    {"%"},
+				"copy_mode_find_forward":        {"f"},
+				"copy_mode_find_backward":       {"F"},
+				"copy_mode_till_forward":        {"t"},
+				"copy_mode_till_backward":       {"T"},
+				"copy_mode_repeat_find":         {";"},
+				"copy_mode_repeat_find_reverse": {","},
+				"copy_mode_search_forward":      {"/"},
+				"copy_mode_search_backward":     {"?"},
+				"copy_mode_next_match":          {"n"},
+				"copy_mode_prev_match":          {"N"},
+				"copy_mode_clear_search":        {"ctrl+l"},
+				"copy_mode_visual":              {"v"},
+				"copy_mode_visual_line":         {"V"},
+				"copy_mode_select_output":       {"o"},
+				"copy_mode_set_mark":            {"m"},
+				"copy_mode_jump_mark":           {"`"},
+				"copy_mode_yank":                {"y", "c"},
+			},
 		},
 	}
 	return cfg
@@ -330,12 +534,14 @@ func getDefaultTerminalModeKeybinds() map[string][]string {
 			"terminal_next_window": {"opt+tab", "alt+n"},
 			"terminal_prev_window": {"opt+shift+tab", "alt+p"},
 			"terminal_exit_mode":   {"opt+esc"},
+			"terminal_passthrough": {"f12"},
 		}
 	}
 	return map[string][]string{
 		"terminal_next_window": {"alt+n"},
 		"terminal_prev_window": {"alt+p"},
 		"terminal_exit_mode":   {"alt+esc"},
+		"terminal_passthrough": {"f12"},
 	}
 }
 
@@ -425,6 +631,7 @@ func getDefaultLayoutKeybinds() map[string][]string {
 		"split_vertical":   {"|", "\\"},
 		"rotate_split":     {"R"},
 		"equalize_splits":  {"="},
+		"equalize_focused": {"_"},
 	}
 
 	// Add platform-specific BSP preselect bindings
@@ -574,11 +781,26 @@ func ApplyAppearanceConfig(cfg *UserConfig) {
 		AlwaysConfirmQuit = *cfg.Appearance.ConfirmQuit
 	}
 
+	// ConfirmQuitIfBusy defaults to true; a reload without it turns it back on.
+	ConfirmQuitIfBusy = cfg.Appearance.ConfirmQuitIfBusy == nil || *cfg.Appearance.ConfirmQuitIfBusy
+
 	// SharedBorders defaults to true (nil means use default)
 	if cfg.Appearance.SharedBorders != nil {
 		SharedBorders = *cfg.Appearance.SharedBorders
 	}
 
+	// ShowTooltips defaults to true (nil means use default)
+	if cfg.Appearance.ShowTooltips != nil {
+		ShowTooltips = *cfg.Appearance.ShowTooltips
+	}
+
+	PromoteKeepsFocusSlot = cfg.Appearance.PromoteKeepsFocusSlot
+
+	// WindowCycleWrap defaults to true (nil means use default)
+	if cfg.Appearance.WindowCycleWrap != nil {
+		WindowCycleWrap = *cfg.Appearance.WindowCycleWrap
+	}
+
 	// WhichKeyEnabled defaults to true (nil means use default)
 	if cfg.Appearance.WhichKeyEnabled != nil {
 		WhichKeyEnabled = *cfg.Appearance.WhichKeyEnabled
@@ -595,6 +817,23 @@ func ApplyAppearanceConfig(cfg *UserConfig) {
 		WindowTitlePosition = cfg.Appearance.WindowTitlePosition
 	}
 
+	// DockWorkspaces defaults to off; an empty or unrecognized value resets it.
+	switch cfg.Appearance.DockWorkspaces {
+	case DockWorkspacesAll, DockWorkspacesOccupied:
+		DockWorkspaces = cfg.Appearance.DockWorkspaces
+	default:
+		DockWorkspaces = DockWorkspacesOff
+	}
+
+	// ShowTitleBars defaults to always; an empty or unrecognized value
+	// resets it.
+	switch cfg.Appearance.ShowTitleBars {
+	case TitleBarsMultiple, TitleBarsNever:
+		ShowTitleBars = cfg.Appearance.ShowTitleBars
+	default:
+		ShowTitleBars = TitleBarsAlways
+	}
+
 	// HideClock defaults to false
 	// Only apply from config if not already set via flag (run.go applies flags separately)
 	if !HideClock {
@@ -616,6 +855,292 @@ func ApplyAppearanceConfig(cfg *UserConfig) {
 		ZoomMaxWidth = cfg.Appearance.ZoomMaxWidth
 	}
 
+	// PaneNumbersDuration is in milliseconds; an unset value restores the
+	// default so a reload can undo it.
+	PaneNumbersDuration = DefaultPaneNumbersDuration
+	if cfg.Appearance.PaneNumbersDuration > 0 {
+		PaneNumbersDuration = time.Duration(cfg.Appearance.PaneNumbersDuration) * time.Millisecond
+	}
+
+	// CopyModeExitTo defaults to window. Anything else, including an empty or
+	// unrecognized value, restores the default so a reload can undo it.
+	if cfg.Appearance.CopyModeExitTo == CopyModeExitTerminal {
+		CopyModeExitTo = CopyModeExitTerminal
+	} else {
+		CopyModeExitTo = CopyModeExitWindow
+	}
+
+	// CopyModeSearchHistory of 0 (unset) keeps the default; a negative value
+	// keeps no history.
+	switch {
+	case cfg.Appearance.CopyModeSearchHistory > 0:
+		CopyModeSearchHistory = min(cfg.Appearance.CopyModeSearchHistory, MaxCopyModeSearchHistory)
+	case cfg.Appearance.CopyModeSearchHistory < 0:
+		CopyModeSearchHistory = 0
+	default:
+		CopyModeSearchHistory = DefaultCopyModeSearchHistory
+	}
+
+	// MaxLogMessages of 0 (unset) keeps the default; other values are clamped.
+	if cfg.Debug.MaxLogMessages > 0 {
+		MaxLogMessages = max(MinLogMessages, min(cfg.Debug.MaxLogMessages, MaxLogMessagesLimit))
+	} else {
+		MaxLogMessages = DefaultMaxLogMessages
+	}
+
+	// CopyModeKeyAccel is off unless configured, and a reload can turn it off.
+	CopyModeKeyAccel = cfg.Appearance.CopyModeKeyAccel
+
+	// CopyModeSmartCase likewise.
+	CopyModeSmartCase = cfg.Appearance.CopyModeSmartCase
+
+	// AutoRenameFromCommand is off unless configured.
+	AutoRenameFromCommand = cfg.Appearance.AutoRenameFromCommand
+
+	// TilingGap is 0 unless configured; negative values count as 0.
+	TilingGap = max(0, min(cfg.Appearance.TilingGap, MaxTilingGap))
+
+	// Open and close animations default to none; an empty or unrecognized
+	// value restores the default so a reload can turn them off.
+	switch cfg.Appearance.WindowOpenAnimation {
+	case OpenAnimationCenter, OpenAnimationCursor:
+		WindowOpenAnimation = cfg.Appearance.WindowOpenAnimation
+	default:
+		WindowOpenAnimation = OpenAnimationNone
+	}
+	// New-window sizes fall back to half the screen when unset or unreadable.
+	NewWindowWidth, NewWindowHeight = DefaultNewWindowSize, DefaultNewWindowSize
+	if _, _, ok := ParseWindowSize(cfg.Appearance.NewWindowWidth); ok {
+		NewWindowWidth = strings.TrimSpace(cfg.Appearance.NewWindowWidth)
+	}
+	if _, _, ok := ParseWindowSize(cfg.Appearance.NewWindowHeight); ok {
+		NewWindowHeight = strings.TrimSpace(cfg.Appearance.NewWindowHeight)
+	}
+
+	// MaxNotifications and NotificationDurationMs of 0 (unset) keep their
+	// defaults; other values are clamped.
+	MaxNotifications = DefaultMaxNotifications
+	if cfg.Appearance.MaxNotifications != 0 {
+		MaxNotifications = max(min(cfg.Appearance.MaxNotifications, MaxNotificationsLimit), 1)
+	}
+	NotificationDuration = DefaultNotificationDuration
+	if ms := cfg.Appearance.NotificationDurationMs; ms != 0 {
+		NotificationDuration = time.Duration(max(min(ms, MaxNotificationDurationMs), MinNotificationDurationMs)) * time.Millisecond
+	}
+
+	// NotificationPosition defaults to top-right; an empty or unrecognized
+	// value resets it.
+	switch cfg.Appearance.NotificationPosition {
+	case NotificationTopLeft, NotificationTopCenter, NotificationBottomRight, NotificationBottomLeft, NotificationBottomCenter:
+		NotificationPosition = cfg.Appearance.NotificationPosition
+	default:
+		NotificationPosition = NotificationTopRight
+	}
+
+	// WindowContentPadding is clamped; a reload without it goes back to none.
+	WindowContentPadding = max(min(cfg.Appearance.WindowContentPadding, MaxWindowContentPadding), 0)
+
+	// AutoTileFromSecondWindow is off unless configured, and a reload can turn it off.
+	AutoTileFromSecondWindow = cfg.Appearance.AutoTileFromSecondWindow
+
+	// NewFloatingZOrder defaults to top; an empty or unrecognized value resets it.
+	switch cfg.Appearance.NewFloatingZOrder {
+	case NewFloatingZBottom, NewFloatingZRespectCursor:
+		NewFloatingZOrder = cfg.Appearance.NewFloatingZOrder
+	default:
+		NewFloatingZOrder = NewFloatingZTop
+	}
+
+	switch cfg.Appearance.MaximizeButtonAction {
+	case MaximizeZoom, MaximizeWorkspaceFullscreen:
+		MaximizeButtonAction = cfg.Appearance.MaximizeButtonAction
+	default:
+		MaximizeButtonAction = MaximizeFullscreenToggle
+	}
+	if cfg.Appearance.WindowCloseAnimation == CloseAnimationDock {
+		WindowCloseAnimation = CloseAnimationDock
+	} else {
+		WindowCloseAnimation = CloseAnimationNone
+	}
+	WindowShadows = cfg.Appearance.WindowShadows
+	SelectionColor = cfg.Appearance.SelectionColor
+	SelectionCursorColor = cfg.Appearance.SelectionCursorColor
+
+	// DockAutoHide is off unless configured, and a reload can turn it off.
+	DockAutoHide = cfg.Appearance.DockAutoHide
+
+	// DockMargin is clamped rather than rejected; 0 (unset) keeps windows
+	// flush against the dock as before.
+	DockMargin = min(max(cfg.Appearance.DockMargin, 0), MaxDockMargin)
+
+	// DynamicWorkspaces is off unless configured, and a reload can turn it off.
+	DynamicWorkspaces = cfg.Appearance.DynamicWorkspaces
+
+	// PeekSide defaults to auto; an empty or unrecognized value resets it.
+	switch cfg.Appearance.PeekSide {
+	case PeekSideLeft, PeekSideRight:
+		PeekSide = cfg.Appearance.PeekSide
+	default:
+		PeekSide = PeekSideAuto
+	}
+
+	// FocusMode defaults to click; an empty or unrecognized value restores the
+	// default so a reload can undo it.
+	if cfg.Appearance.FocusMode == FocusModeHover {
+		FocusMode = FocusModeHover
+	} else {
+		FocusMode = FocusModeClick
+	}
+
+	// HoverFocusDelayMs of 0 (unset) keeps the default delay.
+	if cfg.Appearance.HoverFocusDelayMs > 0 {
+		HoverFocusDelay = min(time.Duration(cfg.Appearance.HoverFocusDelayMs)*time.Millisecond, MaxHoverFocusDelay)
+	} else {
+		HoverFocusDelay = 150 * time.Millisecond
+	}
+
+	// MaxPtyBytesPerSec of 0 (or less) means no limit; a reload can remove it.
+	MaxPtyBytesPerSec = max(cfg.Appearance.MaxPtyBytesPerSec, 0)
+
+	// EnterAction defaults to insert; an empty or unrecognized value restores
+	// the default so a reload can undo it.
+	switch cfg.Appearance.EnterAction {
+	case EnterActionNone, EnterActionNew:
+		EnterAction = cfg.Appearance.EnterAction
+	default:
+		EnterAction = EnterActionInsert
+	}
+
+	// EmptyClickAction defaults to none; an empty or unrecognized value
+	// restores the default so a reload can undo it.
+	switch cfg.Appearance.EmptyClickAction {
+	case EmptyClickSpawn, EmptyClickClearFocus:
+		EmptyClickAction = cfg.Appearance.EmptyClickAction
+	default:
+		EmptyClickAction = EmptyClickNone
+	}
+
+	// DefaultSplitRatio of 0 (unset) means an even split; anything else is
+	// clamped so neither pane can be squeezed to nothing.
+	if cfg.Appearance.DefaultSplitRatio > 0 {
+		DefaultSplitRatio = min(max(cfg.Appearance.DefaultSplitRatio, MinSplitRatio), MaxSplitRatio)
+	} else {
+		DefaultSplitRatio = 0.5
+	}
+
+	// StatusCommand is empty unless configured, and a reload can remove it.
+	// StatusInterval of 0 (unset) keeps the default.
+	StatusCommand = strings.TrimSpace(cfg.Appearance.StatusCommand)
+	if cfg.Appearance.StatusInterval > 0 {
+		StatusInterval = min(time.Duration(cfg.Appearance.StatusInterval)*time.Second, MaxStatusInterval)
+	} else {
+		StatusInterval = 5 * time.Second
+	}
+
+	PasteStripTrailingNewline = cfg.Appearance.PasteStripTrailingNewline
+
+	// MouseButtons overrides the defaults per button; unknown buttons and
+	// actions are ignored so a reload always starts from the stock map.
+	MouseButtonMap = DefaultMouseButtonMap()
+	for button, action := range cfg.Appearance.MouseButtons {
+		button = strings.ToLower(strings.TrimSpace(button))
+		action = strings.ToLower(strings.TrimSpace(action))
+		if _, ok := MouseButtonMap[button]; !ok {
+			continue
+		}
+		switch action {
+		case MouseActionDrag, MouseActionResize, MouseActionClose, MouseActionPaste, MouseActionNone:
+			MouseButtonMap[button] = action
+		}
+	}
+
+	// KeyBytes is rebuilt from scratch so a reload drops removed entries. Each
+	// key is registered under every spelling the keybinding normalizer accepts.
+	KeyByteOverrides = nil
+	if len(cfg.Appearance.KeyBytes) > 0 {
+		normalizer := NewKeyNormalizer()
+		KeyByteOverrides = make(map[string][]byte, len(cfg.Appearance.KeyBytes))
+		for key, bytes := range cfg.Appearance.KeyBytes {
+			for _, k := range normalizer.NormalizeKey(key) {
+				KeyByteOverrides[k] = []byte(bytes)
+			}
+		}
+	}
+
+	// TapeFinishHideMs of 0 (unset) keeps the default.
+	if cfg.Appearance.TapeFinishHideMs > 0 {
+		TapeFinishHide = min(time.Duration(cfg.Appearance.TapeFinishHideMs)*time.Millisecond, MaxTapeFinishHide)
+	} else {
+		TapeFinishHide = 2 * time.Second
+	}
+	TapeFinishHold = cfg.Appearance.TapeFinishHold
+	TapeLoop = cfg.Appearance.TapeLoop
+
+	// TotalScrollbackBudgetMB of 0 disables the budget.
+	TotalScrollbackBudgetMB = min(max(cfg.Appearance.TotalScrollbackBudgetMB, 0), MaxScrollbackBudgetMB)
+
+	// BackgroundUpdateDivisor of 0 (unset) keeps the default.
+	if cfg.Appearance.BackgroundUpdateDivisor > 0 {
+		BackgroundUpdateDivisor = min(cfg.Appearance.BackgroundUpdateDivisor, MaxBackgroundUpdateDivisor)
+	} else {
+		BackgroundUpdateDivisor = 3
+	}
+
+	// PtyReadBufferSize of 0 (unset) keeps the default; set values are
+	// clamped to the supported range.
+	if cfg.Appearance.PtyReadBufferSize > 0 {
+		PtyReadBufferSize = min(max(cfg.Appearance.PtyReadBufferSize, MinPtyReadBufferSize), MaxPtyReadBufferSize)
+	} else {
+		PtyReadBufferSize = DefaultPtyReadBufferSize
+	}
+
+	// ResizeDebounceMs of 0 (unset) keeps the default; a negative value turns
+	// the debounce off.
+	switch {
+	case cfg.Appearance.ResizeDebounceMs > 0:
+		ResizeDebounce = min(time.Duration(cfg.Appearance.ResizeDebounceMs)*time.Millisecond, MaxResizeDebounce)
+	case cfg.Appearance.ResizeDebounceMs < 0:
+		ResizeDebounce = 0
+	default:
+		ResizeDebounce = DefaultResizeDebounce
+	}
+
+	// MasterRatioMin/Max of 0 (unset) keep the defaults; set values are
+	// clamped to the absolute bounds, and a max below the min is raised to it.
+	MasterRatioMin, MasterRatioMax = 0.3, 0.7
+	if cfg.Appearance.MasterRatioMin > 0 {
+		MasterRatioMin = min(max(cfg.Appearance.MasterRatioMin, MasterRatioFloor), MasterRatioCeiling)
+	}
+	if cfg.Appearance.MasterRatioMax > 0 {
+		MasterRatioMax = min(max(cfg.Appearance.MasterRatioMax, MasterRatioFloor), MasterRatioCeiling)
+	}
+	MasterRatioMax = max(MasterRatioMax, MasterRatioMin)
+
+	// MasterRatioPresets are clamped into that range once it is known; an
+	// empty list keeps the defaults.
+	presets := cfg.Appearance.MasterRatioPresets
+	if len(presets) == 0 {
+		presets = DefaultMasterRatioPresets()
+	}
+	MasterRatioPresets = nil
+	for _, r := range presets {
+		r = ClampMasterRatio(r)
+		if !slices.Contains(MasterRatioPresets, r) {
+			MasterRatioPresets = append(MasterRatioPresets, r)
+		}
+	}
+
+	// TilingScheme defaults to spiral; an empty or unrecognized value restores
+	// the default so a reload can undo it.
+	switch cfg.Appearance.TilingScheme {
+	case TilingSchemeLongestSide, TilingSchemeAlternate, TilingSchemeSmartSplit:
+		TilingScheme = cfg.Appearance.TilingScheme
+	default:
+		TilingScheme = TilingSchemeSpiral
+	}
+
+	WindowRules = cfg.WindowRules
+
 	// Custom border colors override the theme-derived colors. Empty strings
 	// clear any override and restore theme colors.
 	theme.SetBorderOverrides(cfg.Appearance.BorderFocusedColor, cfg.Appearance.BorderUnfocusedColor)
@@ -712,6 +1237,9 @@ func fillMissingKeybinds(cfg, defaultCfg *UserConfig) {
 	if cfg.Keybindings.TerminalMode == nil {
 		cfg.Keybindings.TerminalMode = make(map[string][]string)
 	}
+	if cfg.Keybindings.CopyMode == nil {
+		cfg.Keybindings.CopyMode = make(map[string][]string)
+	}
 
 	migrateLegacyKeybinds(cfg)
 
@@ -735,6 +1263,7 @@ func fillMissingKeybinds(cfg, defaultCfg *UserConfig) {
 	fillMapDefaults(cfg.Keybindings.DebugPrefix, defaultCfg.Keybindings.DebugPrefix)
 	fillMapDefaults(cfg.Keybindings.TapePrefix, defaultCfg.Keybindings.TapePrefix)
 	fillMapDefaults(cfg.Keybindings.TerminalMode, defaultCfg.Keybindings.TerminalMode)
+	fillMapDefaults(cfg.Keybindings.CopyMode, defaultCfg.Keybindings.CopyMode)
 }
 
 func fillMapDefaults(target, defaults map[string][]string) {
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"path/filepath"
 	"regexp"
 	"slices"
 	"strings"
@@ -94,12 +95,15 @@ func ValidateConfig(cfg *UserConfig) *ValidationResult {
 	validateSection("debug_prefix", cfg.Keybindings.DebugPrefix)
 	validateSection("tape_prefix", cfg.Keybindings.TapePrefix)
 	validateSection("terminal_mode", cfg.Keybindings.TerminalMode)
+	validateSection("copy_mode", cfg.Keybindings.CopyMode)
 
 	// Validate enum appearance options (warn on unknown values; they fall back to defaults)
 	validateAppearanceEnums(cfg, result)
 
 	// Validate the tape section (warn on an unknown autorun mode)
 	validateTapeConfig(cfg, result)
+	validateStartupConfig(cfg, result)
+	validateWindowRules(cfg, result)
 
 	// Check for keybinding conflicts (same key bound to multiple actions)
 	conflicts := findConflicts(cfg, normalizer)
@@ -165,6 +169,20 @@ func ValidateConfig(cfg *UserConfig) *ValidationResult {
 	return result
 }
 
+// validateStartupConfig warns when startup.on_empty_session_attach holds an
+// unknown value, which is treated as "stay".
+func validateStartupConfig(cfg *UserConfig, result *ValidationResult) {
+	value := cfg.Startup.OnEmptySessionAttach
+	if value == "" || slices.Contains(EmptyAttachModes, value) {
+		return
+	}
+	result.Warnings = append(result.Warnings, ValidationError{
+		Field:   "startup",
+		Key:     "on_empty_session_attach",
+		Message: fmt.Sprintf("'%s' is not a valid value (allowed: %s); falling back to default", value, strings.Join(EmptyAttachModes, ", ")),
+	})
+}
+
 // validateTapeConfig warns when tape.autorun holds a value outside its allowed
 // set. An unknown value silently falls back to the safe default ("ask"), so a
 // typo would otherwise go unnoticed. An empty value is left to the default.
@@ -206,7 +224,35 @@ func validateAppearanceEnums(cfg *UserConfig, result *ValidationResult) {
 		[]string{"bottom-right", "bottom-left", "top-right", "top-left", "center"})
 	checkEnum("window_title_position", cfg.Appearance.WindowTitlePosition,
 		[]string{"bottom", "top", "hidden"})
+	checkEnum("dock_workspaces", cfg.Appearance.DockWorkspaces,
+		[]string{DockWorkspacesOff, DockWorkspacesAll, DockWorkspacesOccupied})
+	checkEnum("new_floating_z_order", cfg.Appearance.NewFloatingZOrder,
+		[]string{NewFloatingZTop, NewFloatingZBottom, NewFloatingZRespectCursor})
+	checkEnum("notification_position", cfg.Appearance.NotificationPosition,
+		[]string{NotificationTopRight, NotificationTopLeft, NotificationTopCenter, NotificationBottomRight, NotificationBottomLeft, NotificationBottomCenter})
+	checkEnum("peek_side", cfg.Appearance.PeekSide,
+		[]string{PeekSideAuto, PeekSideLeft, PeekSideRight})
+	checkEnum("show_title_bars", cfg.Appearance.ShowTitleBars,
+		[]string{TitleBarsAlways, TitleBarsMultiple, TitleBarsNever})
+	checkEnum("copy_mode_exit_to", cfg.Appearance.CopyModeExitTo,
+		[]string{CopyModeExitWindow, CopyModeExitTerminal})
+	checkEnum("window_open_animation", cfg.Appearance.WindowOpenAnimation,
+		[]string{OpenAnimationNone, OpenAnimationCenter, OpenAnimationCursor})
+	checkEnum("window_close_animation", cfg.Appearance.WindowCloseAnimation,
+		[]string{CloseAnimationNone, CloseAnimationDock})
+	checkEnum("maximize_button_action", cfg.Appearance.MaximizeButtonAction,
+		[]string{MaximizeFullscreenToggle, MaximizeZoom, MaximizeWorkspaceFullscreen})
+	checkEnum("tiling_scheme", cfg.Appearance.TilingScheme,
+		[]string{TilingSchemeSpiral, TilingSchemeLongestSide, TilingSchemeAlternate, TilingSchemeSmartSplit})
+	checkEnum("enter_action", cfg.Appearance.EnterAction,
+		[]string{EnterActionInsert, EnterActionNone, EnterActionNew})
+	checkEnum("focus_mode", cfg.Appearance.FocusMode,
+		[]string{FocusModeClick, FocusModeHover})
+	checkEnum("empty_click_action", cfg.Appearance.EmptyClickAction,
+		[]string{EmptyClickNone, EmptyClickSpawn, EmptyClickClearFocus})
 	validateTitleFormat(cfg.Appearance.WindowTitleFormat, result)
+	validateWindowSize("appearance", "new_window_width", cfg.Appearance.NewWindowWidth, result)
+	validateWindowSize("appearance", "new_window_height", cfg.Appearance.NewWindowHeight, result)
 }
 
 // knownTitlePlaceholders are the placeholders FormatWindowTitle expands.
@@ -230,6 +276,50 @@ func validateTitleFormat(format string, result *ValidationResult) {
 	}
 }
 
+func validateWindowSize(field, key, spec string, result *ValidationResult) {
+	if spec == "" {
+		return
+	}
+	if _, _, ok := ParseWindowSize(spec); ok {
+		return
+	}
+	result.Warnings = append(result.Warnings, ValidationError{
+		Field:   field,
+		Key:     key,
+		Message: fmt.Sprintf("'%s' is not a size; use a number of cells like \"100\" or a percentage like \"40%%\" (using %s)", spec, DefaultNewWindowSize),
+	})
+}
+
+// validateWindowRules warns about window rules that can never match or that
+// ask for something that will not be applied.
+func validateWindowRules(cfg *UserConfig, result *ValidationResult) {
+	for i, rule := range cfg.WindowRules {
+		field := fmt.Sprintf("window_rules[%d]", i)
+		if rule.Match == "" {
+			result.Warnings = append(result.Warnings, ValidationError{
+				Field:   field,
+				Key:     "match",
+				Message: "rule has no match pattern and never applies",
+			})
+		} else if _, err := filepath.Match(rule.Match, ""); err != nil {
+			result.Warnings = append(result.Warnings, ValidationError{
+				Field:   field,
+				Key:     "match",
+				Message: fmt.Sprintf("'%s' is not a valid pattern: %v", rule.Match, err),
+			})
+		}
+		if rule.Workspace < 0 || rule.Workspace > MaxWorkspaces {
+			result.Warnings = append(result.Warnings, ValidationError{
+				Field:   field,
+				Key:     "workspace",
+				Message: fmt.Sprintf("workspace %d is out of range (1-%d); the window opens on the current workspace", rule.Workspace, MaxWorkspaces),
+			})
+		}
+		validateWindowSize(field, "width", rule.Width, result)
+		validateWindowSize(field, "height", rule.Height, result)
+	}
+}
+
 // findConflicts finds keys that are bound to multiple actions within the same context
 func findConflicts(cfg *UserConfig, normalizer *KeyNormalizer) map[string][]string {
 	// Define action groups by context - actions in different contexts can share keys
@@ -1,6 +1,7 @@
 package input
 
 import (
+	"fmt"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
@@ -37,6 +38,10 @@ func (d *ActionDispatcher) registerHandlers() {
 	d.Register("restore_all", handleRestoreAll)
 	d.Register("next_window", handleNextWindow)
 	d.Register("prev_window", handlePrevWindow)
+	d.Register("last_window", handleLastWindow)
+	d.Register("multifocus", handleToggleMultifocus)
+	d.Register("multifocus_all", handleMultifocusWorkspace)
+	d.Register(repeatLastAction, handleRepeatLastAction)
 
 	// Window selection (1-9)
 	for i := 1; i <= 9; i++ {
@@ -91,6 +96,7 @@ func (d *ActionDispatcher) registerHandlers() {
 	d.Register("split_vertical", handleSplitVertical)
 	d.Register("rotate_split", handleRotateSplit)
 	d.Register("equalize_splits", handleEqualizeSplits)
+	d.Register("equalize_focused", handleEqualizeFocused)
 	d.Register("preselect_left", handlePreselectLeft)
 	d.Register("preselect_right", handlePreselectRight)
 	d.Register("preselect_up", handlePreselectUp)
@@ -103,6 +109,7 @@ func (d *ActionDispatcher) registerHandlers() {
 	d.Register("quit", handleQuit)
 
 	// Clipboard actions
+	d.Register("copy_selection", handleCopySelection)
 	d.Register("paste_clipboard", handlePasteClipboard)
 
 	// System actions
@@ -141,11 +148,19 @@ func (d *ActionDispatcher) Register(action string, handler ActionHandler) {
 
 // Dispatch executes the handler for a given action
 func (d *ActionDispatcher) Dispatch(action string, msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	// Repeating runs the recorded action itself, so a tape records what
+	// actually happened rather than the repeat key.
+	if action == repeatLastAction && o.LastAction != "" {
+		action = o.LastAction
+	}
 	if handler, ok := d.handlers[action]; ok {
 		// Record the action if tape recording is active
 		if o.TapeRecorder != nil && o.TapeRecorder.IsRecording() {
 			o.TapeRecorder.RecordAction(action)
 		}
+		if isRepeatable(action) {
+			o.LastAction = action
+		}
 		return handler(msg, o)
 	}
 	return o, nil
@@ -186,7 +201,7 @@ func handleCloseWindow(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 func handleRenameWindow(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	// If showing cache stats, reset them instead
 	if o.ShowCacheStats {
-		app.GetGlobalStyleCache().ResetStats()
+		o.ResetCacheStats()
 		o.ShowNotification("Cache statistics reset", "info", 2*time.Second)
 		return o, nil
 	}
@@ -244,6 +259,21 @@ func handlePrevWindow(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	return o, nil
 }
 
+func handleLastWindow(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.FocusLastWindow()
+	return o, nil
+}
+
+func handleToggleMultifocus(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.ToggleMultifocus(o.FocusedWindow)
+	return o, nil
+}
+
+func handleMultifocusWorkspace(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.MultifocusWorkspace()
+	return o, nil
+}
+
 // makeSelectWindowHandler creates a handler for selecting a window by index
 func makeSelectWindowHandler(_ int) ActionHandler {
 	return handleNumberKey
@@ -467,6 +497,13 @@ func handleEqualizeSplits(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	return o, nil
 }
 
+func handleEqualizeFocused(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	if o.EqualizeFocusedSiblings() {
+		o.ShowNotification("Split Equalized", "info", config.NotificationDuration)
+	}
+	return o, nil
+}
+
 func handlePreselectLeft(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	if o.AutoTiling {
 		o.SetPreselection(layout.PreselectionLeft)
@@ -585,6 +622,20 @@ func handleToggleCacheStats(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	return o, nil
 }
 
+// handleCopySelection copies the focused window's mouse selection (a
+// double-click word or triple-click line) to the clipboard. It has a key of its
+// own so copying never depends on which mode another key happens to be in.
+func handleCopySelection(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	focusedWindow := o.GetFocusedWindow()
+	if focusedWindow == nil || focusedWindow.SelectedText == "" {
+		o.ShowNotification("Nothing selected to copy", "info", config.NotificationDuration)
+		return o, nil
+	}
+	text := focusedWindow.SelectedText
+	o.ShowNotification(fmt.Sprintf("Copied %d chars", len(text)), "success", config.NotificationDuration)
+	return o, tea.SetClipboard(text)
+}
+
 func handlePasteClipboard(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	if o.FocusedWindow >= 0 && o.FocusedWindow < len(o.Windows) {
 		focusedWindow := o.GetFocusedWindow()
@@ -178,6 +178,56 @@ func TestRebindingATerminalModeKeyTakesEffect(t *testing.T) {
 	}
 }
 
+// TestRebindingACopyModeKeyTakesEffect covers the [keybindings.copy_mode]
+// section, which lets users on other layouts move the vim motions.
+func TestRebindingACopyModeKeyTakesEffect(t *testing.T) {
+	o := osWithBindings(t, func(k *config.KeybindingsConfig) {
+		k.CopyMode["copy_mode_visual"] = []string{"x"}
+	})
+
+	win := newCopyModeWindow(t, "rebound-x")
+	HandleCopyModeKey(press("v"), o, win)
+	if win.CopyMode.State != terminal.CopyModeNormal {
+		t.Error("replaced default key v still entered visual mode")
+	}
+	HandleCopyModeKey(press("x"), o, win)
+	if win.CopyMode.State != terminal.CopyModeVisualChar {
+		t.Fatal("rebound key x did not enter visual mode")
+	}
+	HandleCopyModeKey(press("x"), o, win)
+	if win.CopyMode.State != terminal.CopyModeNormal {
+		t.Error("rebound key x did not leave visual mode")
+	}
+
+	// Capitals are distinct motions in copy mode: an unbound J must not fall
+	// back to the j binding.
+	if action := o.KeybindRegistry.GetCopyModeAction("J"); action != "" {
+		t.Errorf("unbound J resolved to %q", action)
+	}
+}
+
+// TestEnterCanBeAddedAsAYankKey covers the tmux copy-mode-vi habit of
+// yanking with Enter: adding it to copy_mode_yank must yank from visual mode
+// without taking y away.
+func TestEnterCanBeAddedAsAYankKey(t *testing.T) {
+	o := osWithBindings(t, func(k *config.KeybindingsConfig) {
+		k.CopyMode["copy_mode_yank"] = []string{"y", "c", "enter"}
+	})
+
+	for _, key := range []tea.KeyPressMsg{{Code: tea.KeyEnter}, press("y")} {
+		win := newCopyModeWindow(t, "yank-"+key.String())
+		HandleCopyModeKey(press("v"), o, win)
+		HandleCopyModeKey(press("l"), o, win)
+		_, cmd := HandleCopyModeKey(key, o, win)
+		if win.CopyMode.State != terminal.CopyModeNormal {
+			t.Errorf("%s did not leave visual mode", key)
+		}
+		if cmd == nil {
+			t.Errorf("%s did not yank the selection", key)
+		}
+	}
+}
+
 // TestUnboundKeysStillReachTheShell pins the rule that makes the terminal-mode
 // dispatch safe: only reserved chords may be intercepted, so a plain letter is
 // never swallowed no matter what the main keybind section binds it to.
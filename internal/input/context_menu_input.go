@@ -0,0 +1,23 @@
+package input
+
+import (
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/app"
+)
+
+// handleContextMenuInput drives the open right-click menu from the keyboard:
+// arrows, j/k or tab move the selection, enter runs it and esc or q closes
+// the menu. Other keys are ignored while it is open.
+func handleContextMenuInput(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k", "shift+tab":
+		o.ContextMenuMove(-1)
+	case "down", "j", "tab":
+		o.ContextMenuMove(1)
+	case "enter", "space":
+		return o.ActivateContextMenu()
+	case "esc", "q":
+		o.CloseContextMenu()
+	}
+	return o, nil
+}
@@ -3,6 +3,7 @@ package input
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
@@ -31,6 +32,7 @@ func HandleCopyModeKey(msg tea.KeyPressMsg, o *app.OS, window *terminal.Window)
 	// would be waiting on a lock it is itself holding.
 	cm := window.CopyMode
 	fx := &copyModeEffects{}
+	action := copyModeAction(o, msg.String())
 
 	func() {
 		window.RLockIO()
@@ -40,17 +42,35 @@ func HandleCopyModeKey(msg tea.KeyPressMsg, o *app.OS, window *terminal.Window)
 		case terminal.CopyModeSearch:
 			handleSearchInput(msg, cm, window, fx)
 		case terminal.CopyModeVisualChar, terminal.CopyModeVisualLine:
-			handleVisualInput(msg, cm, window, fx)
+			handleVisualInput(msg, action, cm, window, fx)
 		case terminal.CopyModeNormal:
-			handleNormalInput(msg, cm, window, fx)
+			handleNormalInput(msg, action, cm, window, fx)
 		}
 	}()
 
 	return fx.apply(o, window)
 }
 
+// defaultCopyModeRegistry resolves copy-mode keys when the OS has no keybind
+// registry, so copy mode still works with the stock bindings.
+var defaultCopyModeRegistry = sync.OnceValue(func() *config.KeybindRegistry {
+	return config.NewKeybindRegistry(config.DefaultConfig())
+})
+
+// copyModeAction maps a key to its copy-mode action through the [copy_mode]
+// keybindings section, so motions can be remapped for other keyboard layouts.
+// Count digits and the character after f/F/t/T are read literally and never
+// reach this lookup.
+func copyModeAction(o *app.OS, key string) string {
+	registry := o.KeybindRegistry
+	if registry == nil {
+		registry = defaultCopyModeRegistry()
+	}
+	return registry.GetCopyModeAction(key)
+}
+
 // handleNormalInput handles keys in normal navigation mode
-func handleNormalInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *terminal.Window, fx *copyModeEffects) {
+func handleNormalInput(msg tea.KeyPressMsg, action string, cm *terminal.CopyMode, window *terminal.Window, fx *copyModeEffects) {
 	keyStr := msg.String()
 
 	// Handle pending character search (f/F/t/T followed by character)
@@ -78,6 +98,12 @@ func handleNormalInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *termi
 		return
 	}
 
+	// Handle pending mark (m or ` followed by the mark letter)
+	if cm.PendingMark != "" {
+		handlePendingMark(keyStr, cm, window, fx)
+		return
+	}
+
 	// Handle digit keys for count prefix (1-9, 0 only if already has count)
 	if len(keyStr) == 1 && keyStr[0] >= '0' && keyStr[0] <= '9' {
 		digit := int(keyStr[0] - '0')
@@ -98,6 +124,10 @@ func handleNormalInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *termi
 	if count == 0 {
 		count = 1
 	}
+	switch action {
+	case "copy_mode_left", "copy_mode_right", "copy_mode_down", "copy_mode_up":
+		count = acceleratedCount(cm, action, count)
+	}
 
 	// Clear count after reading it (will be reset after command execution)
 	defer func() {
@@ -107,12 +137,17 @@ func handleNormalInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *termi
 		}
 	}()
 
-	switch keyStr {
-	case "q", "esc":
+	switch action {
+	case "copy_mode_exit":
 		fx.ExitCopyMode()
+		if config.CopyModeExitTo == config.CopyModeExitTerminal {
+			fx.ShowNotification("Terminal Mode", "info", config.NotificationDuration)
+			fx.EnterTerminalMode()
+			return
+		}
 		fx.ShowNotification("Copy Mode Exited", "info", config.NotificationDuration)
 		return
-	case "i":
+	case "copy_mode_terminal":
 		// Exit copy mode and enter terminal mode
 		fx.ExitCopyMode()
 		fx.ShowNotification("Terminal Mode", "info", config.NotificationDuration)
@@ -121,86 +156,88 @@ func handleNormalInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *termi
 		return
 
 	// Navigation - basic movement
-	case "h", "left":
+	case "copy_mode_left":
 		for range count {
 			moveLeft(cm, window)
 		}
-	case "l", "right":
+	case "copy_mode_right":
 		for range count {
 			moveRight(cm, window)
 		}
-	case "j", "down":
+	case "copy_mode_down":
 		for range count {
 			moveDown(cm, window)
 		}
-	case "k", "up":
+	case "copy_mode_up":
 		for range count {
 			moveUp(cm, window)
 		}
 
 	// Navigation - word movement
-	case "w":
+	case "copy_mode_word_forward":
 		for range count {
 			moveWordForward(cm, window)
 		}
-	case "b":
+	case "copy_mode_word_backward":
 		for range count {
 			moveWordBackward(cm, window)
 		}
-	case "e":
+	case "copy_mode_word_end":
 		for range count {
 			moveWordEnd(cm, window)
 		}
-	case "W":
+	case "copy_mode_big_word_forward":
 		for range count {
 			moveWordForwardBig(cm, window)
 		}
-	case "B":
+	case "copy_mode_big_word_backward":
 		for range count {
 			moveWordBackwardBig(cm, window)
 		}
-	case "E":
+	case "copy_mode_big_word_end":
 		for range count {
 			moveWordEndBig(cm, window)
 		}
 
 	// Navigation - line movement
-	case "0":
+	case "copy_mode_line_start":
 		cm.CursorX = 0
-	case "^":
+	case "copy_mode_first_non_blank":
 		cm.CursorX = 0 // Could be enhanced to skip leading whitespace
-	case "$":
-		cm.CursorX = max(0, window.Width-3) // Account for borders
+	case "copy_mode_line_end":
+		cm.CursorX = max(0, window.ContentWidth()-1)
 
 	// Navigation - page movement
-	case "ctrl+u":
+	case "copy_mode_half_page_up":
 		for range count {
 			moveHalfPageUp(cm, window)
 		}
-	case "ctrl+d":
+	case "copy_mode_half_page_down":
 		for range count {
 			moveHalfPageDown(cm, window)
 		}
-	case "ctrl+b", "pgup":
+	case "copy_mode_page_up":
 		for range count {
 			movePageUp(cm, window)
 		}
-	case "ctrl+f", "pgdown":
+	case "copy_mode_page_down":
 		for range count {
 			movePageDown(cm, window)
 		}
 
 	// Navigation - jump to top/bottom
-	case "g":
+	case "copy_mode_top":
 		// Handle 'gg' sequence
 		if cm.PendingGCount && time.Since(cm.LastCommandTime) < 500*time.Millisecond {
+			rememberJump(cm, window)
 			moveToTop(cm, window)
 			cm.PendingGCount = false
 		} else {
 			cm.PendingGCount = true
 			cm.LastCommandTime = time.Now()
 		}
-	case "G":
+	case "copy_mode_bottom":
+		rememberJump(cm, window)
 		// count + G goes to specific line (e.g., 10G goes to line 10)
 		if count > 1 {
 			// Go to specific line number (count is the line number)
@@ -227,85 +264,104 @@ func handleNormalInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *termi
 			moveToBottom(cm, window)
 		}
 
+	// Navigation - shell prompts (OSC 133), '[[' and ']]'
+	case "copy_mode_prev_prompt", "copy_mode_next_prompt":
+		if !promptJumpReady(cm, action) {
+			return
+		}
+		from := cursorMark(cm, window)
+		if !jumpToPrompt(cm, window, action == "copy_mode_next_prompt") {
+			fx.ShowNotification(noPromptMessage, "warning", config.NotificationDuration)
+			return
+		}
+		setJumpMark(cm, from)
+
 	// Navigation - screen position
-	case "H":
+	case "copy_mode_screen_top":
 		// Move to top of screen
 		cm.CursorY = 0
-	case "M":
+	case "copy_mode_screen_middle":
 		// Move to middle of screen
 		cm.CursorY = window.Height / 2
-	case "L":
+	case "copy_mode_screen_bottom":
 		// Move to bottom of screen
-		cm.CursorY = window.Height - 3
+		cm.CursorY = window.ContentHeight() - 1
 
 	// Navigation - paragraph movement
-	case "{":
+	case "copy_mode_paragraph_up":
 		for range count {
 			moveParagraphUp(cm, window)
 		}
-	case "}":
+	case "copy_mode_paragraph_down":
 		for range count {
 			moveParagraphDown(cm, window)
 		}
 
 	// Navigation - matching bracket
-	case "%":
+	case "copy_mode_matching_bracket":
 		moveToMatchingBracket(cm, window)
 
 	// Character search (f/F/t/T)
-	case "f":
+	case "copy_mode_find_forward":
 		// Find character forward on current line
 		cm.PendingCharSearch = true
 		cm.LastCharSearchDir = 1
 		cm.LastCharSearchTill = false
-		fx.ShowNotification("f", "info", 0)
+		fx.ShowNotification(keyStr, "info", 0)
 		return
-	case "F":
+	case "copy_mode_find_backward":
 		// Find character backward on current line
 		cm.PendingCharSearch = true
 		cm.LastCharSearchDir = -1
 		cm.LastCharSearchTill = false
-		fx.ShowNotification("F", "info", 0)
+		fx.ShowNotification(keyStr, "info", 0)
 		return
-	case "t":
+	case "copy_mode_till_forward":
 		// Till character forward (stop before)
 		cm.PendingCharSearch = true
 		cm.LastCharSearchDir = 1
 		cm.LastCharSearchTill = true
-		fx.ShowNotification("t", "info", 0)
+		fx.ShowNotification(keyStr, "info", 0)
 		return
-	case "T":
+	case "copy_mode_till_backward":
 		// Till character backward (stop before)
 		cm.PendingCharSearch = true
 		cm.LastCharSearchDir = -1
 		cm.LastCharSearchTill = true
-		fx.ShowNotification("T", "info", 0)
+		fx.ShowNotification(keyStr, "info", 0)
 		return
-	case ";":
+	case "copy_mode_repeat_find":
 		// Repeat last character search
 		for range count {
 			repeatCharSearch(cm, window, false)
 		}
-	case ",":
+	case "copy_mode_repeat_find_reverse":
 		// Repeat last character search in opposite direction
 		for range count {
 			repeatCharSearch(cm, window, true)
 		}
 
 	// Search
-	case "/":
+	case "copy_mode_search_forward":
+		rememberJump(cm, window)
 		cm.State = terminal.CopyModeSearch
 		cm.SearchQuery = ""
+		cm.HistoryIndex = 0
 		cm.SearchBackward = false
 		fx.ShowNotification("/", "info", 0) // Persistent until search complete
 		return
-	case "?":
+	case "copy_mode_search_backward":
+		rememberJump(cm, window)
 		cm.State = terminal.CopyModeSearch
 		cm.SearchQuery = ""
+		cm.HistoryIndex = 0
 		cm.SearchBackward = true
 		fx.ShowNotification("?", "info", 0) // Persistent until search complete
 		return
-	case "n":
+	case "copy_mode_next_match":
+		if len(cm.SearchMatches) > 0 {
+			rememberJump(cm, window)
+		}
 		// n goes forward for /, backward for ?
 		for range count {
 			if cm.SearchBackward {
@@ -314,7 +370,10 @@ func handleNormalInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *termi
 				nextMatch(cm, window)
 			}
 		}
-	case "N":
+	case "copy_mode_prev_match":
+		if len(cm.SearchMatches) > 0 {
+			rememberJump(cm, window)
+		}
 		// N goes backward for /, forward for ?
 		for range count {
 			if cm.SearchBackward {
@@ -323,7 +382,7 @@ func handleNormalInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *termi
 				prevMatch(cm, window)
 			}
 		}
-	case "ctrl+l":
+	case "copy_mode_clear_search":
 		// Clear search highlighting (like vim's :noh)
 		cm.SearchQuery = ""
 		cm.SearchMatches = nil
@@ -333,17 +392,35 @@ func handleNormalInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *termi
 		fx.InvalidateCache()
 		return
 
+	// Marks
+	case "copy_mode_set_mark":
+		cm.PendingMark = "m"
+		fx.ShowNotification(keyStr, "info", 0)
+		return
+	case "copy_mode_jump_mark":
+		cm.PendingMark = "`"
+		fx.ShowNotification(keyStr, "info", 0)
+		return
+
 	// Visual mode
-	case "v":
+	case "copy_mode_visual":
 		enterVisualChar(cm, window)
 		fx.InvalidateCache()
 		fx.ShowNotification("VISUAL", "info", 0)
 		return
-	case "V":
+	case "copy_mode_visual_line":
 		enterVisualLine(cm, window)
 		fx.InvalidateCache()
 		fx.ShowNotification("VISUAL LINE", "info", 0)
 		return
+	case "copy_mode_select_output":
+		if !selectLastOutput(cm, window) {
+			fx.ShowNotification(noOutputMessage, "warning", config.NotificationDuration)
+			return
+		}
+		fx.InvalidateCache()
+		fx.ShowNotification("VISUAL LINE (last output)", "info", 0)
+		return
 	}
 
 	fx.InvalidateCache()
@@ -361,6 +438,17 @@ func handleSearchInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *termi
 
 	switch key.Code {
 	case tea.KeyEnter:
+		if cm.QuickFind {
+			// Quick find with nothing to land on has nowhere to continue
+			// from, so treat it like a cancel.
+			if len(cm.SearchMatches) == 0 {
+				fx.ExitCopyMode()
+				fx.ShowNotification("No matches", "warning", config.NotificationDuration)
+				return
+			}
+			cm.QuickFind = false
+		}
+		recordSearchHistory(cm)
 		cm.State = terminal.CopyModeNormal
 		matchInfo := ""
 		if len(cm.SearchMatches) > 0 {
@@ -368,10 +456,24 @@ func handleSearchInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *termi
 		}
 		fx.ShowNotification(fmt.Sprintf("%s%s%s", searchPrefix, cm.SearchQuery, matchInfo), "info", config.NotificationDuration)
 	case tea.KeyEscape:
+		if cm.QuickFind {
+			fx.ExitCopyMode()
+			fx.ShowNotification("", "info", 0)
+			return
+		}
 		cm.State = terminal.CopyModeNormal
 		cm.SearchQuery = ""
 		cm.SearchMatches = nil
 		fx.ShowNotification("", "info", 0)
+	case tea.KeyUp, tea.KeyDown:
+		delta := 1
+		if key.Code == tea.KeyDown {
+			delta = -1
+		}
+		if recallSearchHistory(cm, delta) {
+			executeSearch(cm, window)
+		}
+		fx.ShowNotification(searchPrefix+cm.SearchQuery, "info", 0)
 	case tea.KeyBackspace:
 		if len(cm.SearchQuery) > 0 {
 			cm.SearchQuery = cm.SearchQuery[:len(cm.SearchQuery)-1]
@@ -390,7 +492,7 @@ func handleSearchInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *termi
 }
 
 // handleVisualInput handles keys in visual selection mode
-func handleVisualInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *terminal.Window, fx *copyModeEffects) {
+func handleVisualInput(msg tea.KeyPressMsg, action string, cm *terminal.CopyMode, window *terminal.Window, fx *copyModeEffects) {
 	keyStr := msg.String()
 
 	// Handle pending character search (f/F/t/T followed by character)
@@ -438,6 +540,10 @@ func handleVisualInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *termi
 	if count == 0 {
 		count = 1
 	}
+	switch action {
+	case "copy_mode_left", "copy_mode_right", "copy_mode_down", "copy_mode_up":
+		count = acceleratedCount(cm, action, count)
+	}
 
 	// Clear count after reading it
 	defer func() {
@@ -447,11 +553,11 @@ func handleVisualInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *termi
 		}
 	}()
 
-	switch keyStr {
-	case "esc", "q":
+	switch action {
+	case "copy_mode_exit":
 		cm.State = terminal.CopyModeNormal
 		fx.ShowNotification("", "info", 0)
-	case "y", "c":
+	case "copy_mode_yank":
 		text := extractVisualText(cm, window)
 		cm.State = terminal.CopyModeNormal
 		fx.ShowNotification(fmt.Sprintf("Yanked %d chars", len(text)), "success", config.NotificationDuration)
@@ -460,115 +566,115 @@ func handleVisualInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *termi
 		return
 
 	// Movement in visual mode extends selection - basic
-	case "h", "left":
+	case "copy_mode_left":
 		for range count {
 			moveLeft(cm, window)
 		}
 		updateVisualEnd(cm, window)
-	case "l", "right":
+	case "copy_mode_right":
 		for range count {
 			moveRight(cm, window)
 		}
 		updateVisualEnd(cm, window)
-	case "j", "down":
+	case "copy_mode_down":
 		for range count {
 			moveDown(cm, window)
 		}
 		updateVisualEnd(cm, window)
-	case "k", "up":
+	case "copy_mode_up":
 		for range count {
 			moveUp(cm, window)
 		}
 		updateVisualEnd(cm, window)
 
 	// Word movement
-	case "w":
+	case "copy_mode_word_forward":
 		for range count {
 			moveWordForward(cm, window)
 		}
 		updateVisualEnd(cm, window)
-	case "b":
+	case "copy_mode_word_backward":
 		for range count {
 			moveWordBackward(cm, window)
 		}
 		updateVisualEnd(cm, window)
-	case "e":
+	case "copy_mode_word_end":
 		for range count {
 			moveWordEnd(cm, window)
 		}
 		updateVisualEnd(cm, window)
-	case "W":
+	case "copy_mode_big_word_forward":
 		for range count {
 			moveWordForwardBig(cm, window)
 		}
 		updateVisualEnd(cm, window)
-	case "B":
+	case "copy_mode_big_word_backward":
 		for range count {
 			moveWordBackwardBig(cm, window)
 		}
 		updateVisualEnd(cm, window)
-	case "E":
+	case "copy_mode_big_word_end":
 		for range count {
 			moveWordEndBig(cm, window)
 		}
 		updateVisualEnd(cm, window)
 
 	// Character search (f/F/t/T)
-	case "f":
+	case "copy_mode_find_forward":
 		cm.PendingCharSearch = true
 		cm.LastCharSearchDir = 1
 		cm.LastCharSearchTill = false
-		fx.ShowNotification("f", "info", 0)
+		fx.ShowNotification(keyStr, "info", 0)
 		return
-	case "F":
+	case "copy_mode_find_backward":
 		cm.PendingCharSearch = true
 		cm.LastCharSearchDir = -1
 		cm.LastCharSearchTill = false
-		fx.ShowNotification("F", "info", 0)
+		fx.ShowNotification(keyStr, "info", 0)
 		return
-	case "t":
+	case "copy_mode_till_forward":
 		cm.PendingCharSearch = true
 		cm.LastCharSearchDir = 1
 		cm.LastCharSearchTill = true
-		fx.ShowNotification("t", "info", 0)
+		fx.ShowNotification(keyStr, "info", 0)
 		return
-	case "T":
+	case "copy_mode_till_backward":
 		cm.PendingCharSearch = true
 		cm.LastCharSearchDir = -1
 		cm.LastCharSearchTill = true
-		fx.ShowNotification("T", "info", 0)
+		fx.ShowNotification(keyStr, "info", 0)
 		return
-	case ";":
+	case "copy_mode_repeat_find":
 		repeatCharSearch(cm, window, false)
 		updateVisualEnd(cm, window)
-	case ",":
+	case "copy_mode_repeat_find_reverse":
 		repeatCharSearch(cm, window, true)
 		updateVisualEnd(cm, window)
 
 	// Line movement
-	case "0", "^":
+	case "copy_mode_line_start", "copy_mode_first_non_blank":
 		cm.CursorX = 0
 		updateVisualEnd(cm, window)
-	case "$":
-		cm.CursorX = max(0, window.Width-3)
+	case "copy_mode_line_end":
+		cm.CursorX = max(0, window.ContentWidth()-1)
 		updateVisualEnd(cm, window)
 
 	// Page movement
-	case "ctrl+u":
+	case "copy_mode_half_page_up":
 		moveHalfPageUp(cm, window)
 		updateVisualEnd(cm, window)
-	case "ctrl+d":
+	case "copy_mode_half_page_down":
 		moveHalfPageDown(cm, window)
 		updateVisualEnd(cm, window)
-	case "ctrl+b", "pgup":
+	case "copy_mode_page_up":
 		movePageUp(cm, window)
 		updateVisualEnd(cm, window)
-	case "ctrl+f", "pgdown":
+	case "copy_mode_page_down":
 		movePageDown(cm, window)
 		updateVisualEnd(cm, window)
 
 	// Jump movement
-	case "g":
+	case "copy_mode_top":
 		// Detect the 'gg' sequence. Keys arrive singly, so a literal "gg" case
 		// never matches; mirror the pending-g state used in normal mode.
 		if cm.PendingGCount && time.Since(cm.LastCommandTime) < 500*time.Millisecond {
@@ -579,40 +685,50 @@ func handleVisualInput(msg tea.KeyPressMsg, cm *terminal.CopyMode, window *termi
 			cm.PendingGCount = true
 			cm.LastCommandTime = time.Now()
 		}
-	case "G":
+	case "copy_mode_bottom":
 		moveToBottom(cm, window)
 		updateVisualEnd(cm, window)
 
 	// Screen position
-	case "H":
+	case "copy_mode_screen_top":
 		cm.CursorY = 0
 		updateVisualEnd(cm, window)
-	case "M":
+	case "copy_mode_screen_middle":
 		cm.CursorY = window.Height / 2
 		updateVisualEnd(cm, window)
-	case "L":
-		cm.CursorY = window.Height - 3
+	case "copy_mode_screen_bottom":
+		cm.CursorY = window.ContentHeight() - 1
 		updateVisualEnd(cm, window)
 
 	// Paragraph movement
-	case "{":
+	case "copy_mode_paragraph_up":
 		moveParagraphUp(cm, window)
 		updateVisualEnd(cm, window)
-	case "}":
+	case "copy_mode_paragraph_down":
 		moveParagraphDown(cm, window)
 		updateVisualEnd(cm, window)
 
+	// Shell prompt movement
+	case "copy_mode_prev_prompt", "copy_mode_next_prompt":
+		if promptJumpReady(cm, action) {
+			if !jumpToPrompt(cm, window, action == "copy_mode_next_prompt") {
+				fx.ShowNotification(noPromptMessage, "warning", config.NotificationDuration)
+				return
+			}
+			updateVisualEnd(cm, window)
+		}
+
 	// Bracket matching
-	case "%":
+	case "copy_mode_matching_bracket":
 		moveToMatchingBracket(cm, window)
 		updateVisualEnd(cm, window)
 
 	// Toggle visual mode (pressing v/V again exits visual mode)
-	case "v":
+	case "copy_mode_visual":
 		// Exit visual mode and return to normal mode
 		cm.State = terminal.CopyModeNormal
 		fx.ShowNotification("", "info", 0)
-	case "V":
+	case "copy_mode_visual_line":
 		// Pressing V in visual char mode switches to visual line mode
 		// Pressing V in visual line mode exits to normal mode
 		if cm.State == terminal.CopyModeVisualLine {
@@ -727,9 +843,8 @@ func HandleCopyModeMouseMotion(cm *terminal.CopyMode, window *terminal.Window, m
 
 		// Auto-scroll when dragging outside content area
 		if !inContent {
-			borderOff := window.BorderOffset()
-			contentTop := window.Y + borderOff
-			contentBottom := window.Y + borderOff + window.ContentHeight()
+			contentTop := window.Y + window.ContentOffsetY()
+			contentBottom := contentTop + window.ContentHeight()
 
 			dir := 0
 			if mouseY < contentTop {
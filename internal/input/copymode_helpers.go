@@ -2,8 +2,10 @@
 package input
 
 import (
+	"time"
 	"unicode/utf8"
 
+	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/terminal"
 	vt "github.com/Gaurav-Gosain/tuios/internal/vt"
 	uv "github.com/charmbracelet/ultraviolet"
@@ -150,6 +152,10 @@ func getScreenLineCells(term *vt.Emulator, y int) []uv.Cell {
 //	Width:    2  0  1  1  1  1
 //	Text (skipping Width=0): "🎨file"
 //	Character index 1 ('f') → Column 2
+//
+// The text holds every rune of a cell, so a cell made of several runes (an
+// emoji with a variation selector, a ZWJ sequence, a combining mark) takes up
+// that many character indices; any of them maps to the cell's column.
 func charIndexToColumn(cells []uv.Cell, charIndex int) int {
 	if charIndex <= 0 {
 		return 0
@@ -167,13 +173,15 @@ func charIndexToColumn(cells []uv.Cell, charIndex int) int {
 			continue
 		}
 
-		// If we've reached the target character index, return the column
-		// (which is the cell index)
-		if charsProcessed == charIndex {
+		runes := max(utf8.RuneCountInString(cell.Content), 1)
+
+		// If the target character index falls in this cell, return the
+		// column (which is the cell index)
+		if charIndex < charsProcessed+runes {
 			return col
 		}
 
-		charsProcessed++
+		charsProcessed += runes
 	}
 
 	// Past the end - return the last column
@@ -189,3 +197,24 @@ func isBlankLine(lineText string) bool {
 	}
 	return true
 }
+
+// acceleratedCount returns the step count for a movement action. With
+// config.CopyModeKeyAccel on and no explicit count, a move repeated within
+// config.CopyModeAccelWindow is treated as held and its step doubles every
+// eight repeats, up to 8. A different movement, or a pause, resets it.
+func acceleratedCount(cm *terminal.CopyMode, move string, count int) int {
+	now := time.Now()
+	held := move == cm.LastMoveKey && now.Sub(cm.LastMoveTime) <= config.CopyModeAccelWindow
+	cm.LastMoveKey = move
+	cm.LastMoveTime = now
+	if !held {
+		cm.MoveStreak = 0
+		return count
+	}
+	cm.MoveStreak++
+
+	if !config.CopyModeKeyAccel || cm.PendingCount > 0 {
+		return count
+	}
+	return min(1<<(cm.MoveStreak/8), 8)
+}
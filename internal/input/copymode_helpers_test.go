@@ -0,0 +1,38 @@
+package input
+
+import (
+	"testing"
+
+	uv "github.com/charmbracelet/ultraviolet"
+)
+
+// TestCharIndexToColumnAgreesWithLineText checks that character indices into
+// the text built by extractLineTextFromCells map back to the right columns,
+// including past wide cells and cells made of several runes.
+func TestCharIndexToColumnAgreesWithLineText(t *testing.T) {
+	cells := []uv.Cell{
+		{Content: "漢", Width: 2}, {Width: 0},
+		{Content: "❤️", Width: 2}, {Width: 0}, // heart + variation selector
+		{Content: "a", Width: 1},
+		{Content: "b", Width: 1},
+	}
+	text := []rune(extractLineTextFromCells(cells))
+
+	for i, want := range map[rune]int{'a': 4, 'b': 5} {
+		idx := -1
+		for j, r := range text {
+			if r == i {
+				idx = j
+			}
+		}
+		if got := charIndexToColumn(cells, idx); got != want {
+			t.Errorf("%q at char %d maps to column %d, want %d", i, idx, got, want)
+		}
+	}
+	if got := charIndexToColumn(cells, 1); got != 2 {
+		t.Errorf("heart maps to column %d, want 2", got)
+	}
+	if got := charIndexToColumn(cells, len(text)); got != len(cells) {
+		t.Errorf("end of text maps to column %d, want %d", got, len(cells))
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 	"github.com/Gaurav-Gosain/tuios/internal/app"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/terminal"
 )
 
@@ -157,6 +158,23 @@ func TestCopyModeEffectsPreserveHandlerBehaviour(t *testing.T) {
 		}
 	})
 
+	t.Run("q returns to terminal mode when configured", func(t *testing.T) {
+		original := config.CopyModeExitTo
+		config.CopyModeExitTo = config.CopyModeExitTerminal
+		defer func() { config.CopyModeExitTo = original }()
+
+		win := newCopyModeWindow(t, "copymode-fx-0007")
+		o := &app.OS{Mode: app.WindowManagementMode}
+
+		HandleCopyModeKey(key("q"), o, win)
+		if win.CopyMode != nil && win.CopyMode.Active {
+			t.Fatal("q did not leave copy mode")
+		}
+		if o.Mode != app.TerminalMode {
+			t.Fatalf("q left the OS in mode %v, want TerminalMode", o.Mode)
+		}
+	})
+
 	t.Run("yank in visual mode returns a clipboard command", func(t *testing.T) {
 		win := newCopyModeWindow(t, "copymode-fx-0003")
 		o := &app.OS{Mode: app.WindowManagementMode}
@@ -211,6 +229,136 @@ func TestCopyModeEffectsPreserveHandlerBehaviour(t *testing.T) {
 	})
 }
 
+// TestQuickFind checks the find prefix: it searches while typing, Enter lands
+// in normal copy mode at the match, and Esc leaves copy mode entirely.
+func TestQuickFind(t *testing.T) {
+	t.Run("enter continues in copy mode at the match", func(t *testing.T) {
+		win := newCopyModeWindow(t, "quickfind-0001")
+		win.ExitCopyMode()
+		o := &app.OS{Mode: app.TerminalMode}
+
+		win.EnterQuickFind()
+		for _, k := range []string{"t", "h", "i", "r", "d"} {
+			HandleCopyModeKey(key(k), o, win)
+		}
+		if len(win.CopyMode.SearchMatches) != 1 {
+			t.Fatalf("got %d matches for %q, want 1", len(win.CopyMode.SearchMatches), win.CopyMode.SearchQuery)
+		}
+		if win.CopyMode.CursorY != 2 || win.CopyMode.CursorX != 0 {
+			t.Fatalf("cursor at (%d,%d), want (0,2)", win.CopyMode.CursorX, win.CopyMode.CursorY)
+		}
+
+		HandleCopyModeKey(tea.KeyPressMsg{Code: tea.KeyEnter}, o, win)
+		if !win.CopyMode.Active || win.CopyMode.State != terminal.CopyModeNormal {
+			t.Fatal("Enter did not leave the window in normal copy mode")
+		}
+		if win.CopyMode.QuickFind {
+			t.Fatal("QuickFind still set after Enter")
+		}
+	})
+
+	t.Run("esc returns to the terminal", func(t *testing.T) {
+		win := newCopyModeWindow(t, "quickfind-0002")
+		win.ExitCopyMode()
+		o := &app.OS{Mode: app.TerminalMode}
+
+		win.EnterQuickFind()
+		HandleCopyModeKey(key("a"), o, win)
+		HandleCopyModeKey(tea.KeyPressMsg{Code: tea.KeyEscape}, o, win)
+		if win.CopyMode.Active {
+			t.Fatal("Esc left copy mode active")
+		}
+	})
+}
+
+// TestSearchHistory checks up/down recall in the search prompt: confirmed
+// queries are kept newest last without consecutive repeats, up walks back
+// through them, and down past the newest restores what was being typed.
+func TestSearchHistory(t *testing.T) {
+	win := newCopyModeWindow(t, "search-history-0001")
+	o := &app.OS{Mode: app.WindowManagementMode}
+	search := func(q string) {
+		HandleCopyModeKey(key("/"), o, win)
+		for _, r := range q {
+			HandleCopyModeKey(key(string(r)), o, win)
+		}
+		HandleCopyModeKey(tea.KeyPressMsg{Code: tea.KeyEnter}, o, win)
+	}
+	up := tea.KeyPressMsg{Code: tea.KeyUp}
+	down := tea.KeyPressMsg{Code: tea.KeyDown}
+
+	search("alpha")
+	search("third")
+	search("third")
+	if got := win.CopyMode.SearchHistory; len(got) != 2 || got[0] != "alpha" || got[1] != "third" {
+		t.Fatalf("history = %q, want [alpha third]", got)
+	}
+
+	HandleCopyModeKey(key("/"), o, win)
+	HandleCopyModeKey(key("s"), o, win)
+	HandleCopyModeKey(up, o, win)
+	if q := win.CopyMode.SearchQuery; q != "third" {
+		t.Errorf("first up recalled %q, want third", q)
+	}
+	HandleCopyModeKey(up, o, win)
+	HandleCopyModeKey(up, o, win)
+	if q := win.CopyMode.SearchQuery; q != "alpha" {
+		t.Errorf("up past the oldest entry gave %q, want alpha", q)
+	}
+	if len(win.CopyMode.SearchMatches) == 0 {
+		t.Error("recalled query was not searched")
+	}
+	HandleCopyModeKey(down, o, win)
+	HandleCopyModeKey(down, o, win)
+	if q := win.CopyMode.SearchQuery; q != "s" {
+		t.Errorf("down past the newest entry gave %q, want the typed s", q)
+	}
+
+	prev := config.CopyModeSearchHistory
+	config.CopyModeSearchHistory = 1
+	defer func() { config.CopyModeSearchHistory = prev }()
+	HandleCopyModeKey(tea.KeyPressMsg{Code: tea.KeyEscape}, o, win)
+	search("second")
+	if got := win.CopyMode.SearchHistory; len(got) != 1 || got[0] != "second" {
+		t.Errorf("history over its cap = %q, want [second]", got)
+	}
+}
+
+// TestSearchSmartCase checks smart case ignores case for a lowercase query
+// and matches it exactly once the query has a capital, and that the window's
+// case-sensitive flag still overrides it.
+func TestSearchSmartCase(t *testing.T) {
+	win := newCopyModeWindow(t, "search-smartcase-0001")
+	cm := win.CopyMode
+	prev := config.CopyModeSmartCase
+	defer func() { config.CopyModeSmartCase = prev }()
+
+	matches := func(q string) int {
+		cm.SearchQuery = q
+		cm.SearchCache.Valid = false
+		executeSearch(cm, win)
+		return len(cm.SearchMatches)
+	}
+
+	config.CopyModeSmartCase = false
+	if n := matches("ALPHA"); n != 1 {
+		t.Errorf("ALPHA without smart case: %d matches, want 1", n)
+	}
+
+	config.CopyModeSmartCase = true
+	if n := matches("alpha"); n != 1 {
+		t.Errorf("alpha with smart case: %d matches, want 1", n)
+	}
+	if n := matches("Alpha"); n != 0 {
+		t.Errorf("Alpha with smart case: %d matches, want 0", n)
+	}
+
+	cm.CaseSensitive = true
+	if n := matches("ALPHA"); n != 0 {
+		t.Errorf("ALPHA case-sensitive: %d matches, want 0", n)
+	}
+}
+
 func notificationMessages(o *app.OS) []string {
 	msgs := make([]string, 0, len(o.Notifications))
 	for _, n := range o.Notifications {
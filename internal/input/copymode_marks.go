@@ -0,0 +1,107 @@
+// Package input implements vim-style copy mode for TUIOS.
+package input
+
+import (
+	"fmt"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// Named marks for copy mode: m{a-z} records the cursor, `{a-z} jumps back to
+// it, and `` returns to where the cursor was before the last jump.
+
+// jumpMark is the mark holding the position before the last jump.
+const jumpMark = '`'
+
+// cursorMark returns the cursor position in mark coordinates: the absolute
+// line offset by the lines already trimmed from the scrollback.
+func cursorMark(cm *terminal.CopyMode, window *terminal.Window) terminal.Position {
+	return terminal.Position{
+		X: cm.CursorX,
+		Y: getAbsoluteY(cm, window) + window.Terminal.ScrollbackTrimmed(),
+	}
+}
+
+// setJumpMark records pos as the position a double backtick returns to.
+func setJumpMark(cm *terminal.CopyMode, pos terminal.Position) {
+	if cm.Marks == nil {
+		cm.Marks = make(map[rune]terminal.Position)
+	}
+	cm.Marks[jumpMark] = pos
+}
+
+// rememberJump records the cursor as the position a double backtick returns
+// to, before a motion that jumps rather than steps.
+func rememberJump(cm *terminal.CopyMode, window *terminal.Window) {
+	setJumpMark(cm, cursorMark(cm, window))
+}
+
+// isMarkName reports whether r names a mark the user can set.
+func isMarkName(r rune) bool {
+	return r >= 'a' && r <= 'z'
+}
+
+// jumpToMark moves the cursor to mark r. The line is clamped into the buffer
+// when it is no longer there (trimmed from the scrollback, or the screen was
+// cleared); clamped reports that. ok is false when the mark was never set.
+func jumpToMark(cm *terminal.CopyMode, window *terminal.Window, r rune) (ok, clamped bool) {
+	pos, ok := cm.Marks[r]
+	if !ok {
+		return false, false
+	}
+	absY := pos.Y - window.Terminal.ScrollbackTrimmed()
+	last := window.ScrollbackLen() + window.Terminal.Height() - 1
+	if absY < 0 || absY > last {
+		absY = max(0, min(absY, last))
+		clamped = true
+	}
+
+	rememberJump(cm, window)
+	moveToLine(cm, window, absY)
+	cm.CursorX = min(pos.X, max(0, window.ContentWidth()-1))
+	if clamped {
+		cm.CursorX = 0
+	}
+	return true, clamped
+}
+
+// handlePendingMark handles the key after m or `. Esc or any key that does
+// not name a mark cancels.
+func handlePendingMark(keyStr string, cm *terminal.CopyMode, window *terminal.Window, fx *copyModeEffects) {
+	pending := cm.PendingMark
+	cm.PendingMark = ""
+	fx.ShowNotification("", "info", 0)
+	if len(keyStr) != 1 {
+		return
+	}
+	r := rune(keyStr[0])
+
+	if pending == "m" {
+		if !isMarkName(r) {
+			return
+		}
+		if cm.Marks == nil {
+			cm.Marks = make(map[rune]terminal.Position)
+		}
+		cm.Marks[r] = cursorMark(cm, window)
+		fx.ShowNotification(fmt.Sprintf("Mark %c set", r), "info", config.NotificationDuration)
+		return
+	}
+
+	if !isMarkName(r) && r != jumpMark {
+		return
+	}
+	ok, clamped := jumpToMark(cm, window, r)
+	switch {
+	case !ok && r == jumpMark:
+		fx.ShowNotification("No jump to return to", "warning", config.NotificationDuration)
+		return
+	case !ok:
+		fx.ShowNotification(fmt.Sprintf("Mark %c not set", r), "warning", config.NotificationDuration)
+		return
+	case clamped:
+		fx.ShowNotification(fmt.Sprintf("Mark %c's line is gone from the scrollback", r), "warning", config.NotificationDuration)
+	}
+	fx.InvalidateCache()
+}
@@ -0,0 +1,85 @@
+package input
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// TestCopyModeMarks sets a mark, jumps away and back to it and then to the
+// spot before that jump, checks a mark stays on its line as old scrollback is
+// trimmed and clamps once its line is gone, and that leaving copy mode forgets
+// the marks.
+func TestCopyModeMarks(t *testing.T) {
+	o := osWithBindings(t, func(*config.KeybindingsConfig) {})
+	win := newCopyModeWindow(t, "marks-0001")
+	win.ExitCopyMode()
+	win.Terminal.SetScrollbackMaxLines(40)
+	for i := range 50 {
+		win.WriteOutput(fmt.Appendf(nil, "line %02d\r\n", i))
+	}
+	win.EnterCopyMode()
+	cm := win.CopyMode
+
+	lineAtCursor := func() string {
+		win.RLockIO()
+		defer win.RUnlockIO()
+		return strings.TrimSpace(getLineText(cm, win, getAbsoluteY(cm, win)))
+	}
+	keys := func(ks ...string) {
+		for _, k := range ks {
+			HandleCopyModeKey(press(k), o, win)
+		}
+	}
+
+	cm.CursorY = 3
+	marked := lineAtCursor()
+	keys("m", "a", "g", "g")
+	top := lineAtCursor()
+	if top == marked {
+		t.Fatalf("gg did not move off the marked line %q", marked)
+	}
+	keys("`", "a")
+	if got := lineAtCursor(); got != marked {
+		t.Errorf("`a landed on %q, want %q", got, marked)
+	}
+	keys("`", "`")
+	if got := lineAtCursor(); got != top {
+		t.Errorf("`` landed on %q, want the line before the jump %q", got, top)
+	}
+
+	keys("`", "b")
+	if got := lineAtCursor(); got != top {
+		t.Errorf("jumping to an unset mark moved the cursor to %q", got)
+	}
+	if !hasNotification(o, "Mark b not set") {
+		t.Errorf("no notice for an unset mark, got %v", notificationMessages(o))
+	}
+
+	// Mark the oldest line, then push enough output to trim both it and a few
+	// more off the top of the scrollback. Mark a is on the live screen and must
+	// follow its line up; mark t's line is gone, so it clamps to the top.
+	keys("m", "t")
+	for i := 50; i < 60; i++ {
+		win.WriteOutput(fmt.Appendf(nil, "line %02d\r\n", i))
+	}
+	keys("`", "a")
+	if got := lineAtCursor(); got != marked {
+		t.Errorf("after trimming `a landed on %q, want %q", got, marked)
+	}
+	keys("`", "t")
+	if got := getAbsoluteY(cm, win); got != 0 {
+		t.Errorf("mark on a trimmed line jumped to line %d, want the oldest line 0", got)
+	}
+	if !hasNotification(o, "Mark t's line is gone from the scrollback") {
+		t.Errorf("no notice for a clamped mark, got %v", notificationMessages(o))
+	}
+
+	win.ExitCopyMode()
+	win.EnterCopyMode()
+	if len(win.CopyMode.Marks) != 0 {
+		t.Errorf("marks survived leaving copy mode: %v", win.CopyMode.Marks)
+	}
+}
@@ -54,7 +54,7 @@ func moveLeft(cm *terminal.CopyMode, window *terminal.Window) {
 }
 
 func moveRight(cm *terminal.CopyMode, window *terminal.Window) {
-	maxX := window.Width - 3
+	maxX := window.ContentWidth() - 1
 	if cm.CursorX < maxX {
 		cm.CursorX++
 		// Skip continuation cells (Width=0) of wide characters
@@ -97,7 +97,7 @@ func moveDown(cm *terminal.CopyMode, window *terminal.Window) {
 		// Cursor at/below middle - scroll content instead (cursor stays in place)
 		cm.ScrollOffset--
 		window.ScrollbackOffset = cm.ScrollOffset
-	} else if cm.CursorY < window.Height-3 {
+	} else if cm.CursorY < window.ContentHeight()-1 {
 		// At live content, cursor can move to bottom
 		cm.CursorY++
 	}
@@ -105,7 +105,7 @@ func moveDown(cm *terminal.CopyMode, window *terminal.Window) {
 
 // moveWordForward moves cursor to next word
 func moveWordForward(cm *terminal.CopyMode, window *terminal.Window) {
-	maxWidth := window.Width - 3
+	maxWidth := window.ContentWidth() - 1
 	maxIterations := 1000 // Prevent infinite loops
 
 	// Get current character type
@@ -155,7 +155,7 @@ func moveWordForward(cm *terminal.CopyMode, window *terminal.Window) {
 
 // moveWordBackward moves cursor to previous word
 func moveWordBackward(cm *terminal.CopyMode, window *terminal.Window) {
-	maxWidth := window.Width - 3
+	maxWidth := window.ContentWidth() - 1
 	maxIterations := 1000
 
 	// Move left at least once to leave current position
@@ -240,7 +240,7 @@ func moveWordBackward(cm *terminal.CopyMode, window *terminal.Window) {
 
 // moveWordEnd moves cursor to end of current word
 func moveWordEnd(cm *terminal.CopyMode, window *terminal.Window) {
-	maxWidth := window.Width - 3
+	maxWidth := window.ContentWidth() - 1
 	maxIterations := 1000
 
 	// Move right at least once to leave current position
@@ -307,7 +307,7 @@ func moveWordEnd(cm *terminal.CopyMode, window *terminal.Window) {
 // moveWordForwardBig moves cursor to next WORD (whitespace-delimited)
 func moveWordForwardBig(cm *terminal.CopyMode, window *terminal.Window) {
 	// Like 'w' but treats any whitespace-delimited sequence as a word
-	maxWidth := window.Width - 3
+	maxWidth := window.ContentWidth() - 1
 	maxIterations := 1000
 
 	// Phase 1: Skip current WORD (any non-whitespace)
@@ -345,7 +345,7 @@ func moveWordForwardBig(cm *terminal.CopyMode, window *terminal.Window) {
 // moveWordBackwardBig moves cursor to previous WORD (whitespace-delimited)
 func moveWordBackwardBig(cm *terminal.CopyMode, window *terminal.Window) {
 	// Like 'b' but for WORDs
-	maxWidth := window.Width - 3
+	maxWidth := window.ContentWidth() - 1
 	maxIterations := 1000
 
 	// Move left at least once
@@ -409,7 +409,7 @@ func moveWordBackwardBig(cm *terminal.CopyMode, window *terminal.Window) {
 // moveWordEndBig moves cursor to end of current WORD
 func moveWordEndBig(cm *terminal.CopyMode, window *terminal.Window) {
 	// Like 'e' but for WORDs
-	maxWidth := window.Width - 3
+	maxWidth := window.ContentWidth() - 1
 	maxIterations := 1000
 
 	// Move right at least once
@@ -510,10 +510,25 @@ func moveToTop(cm *terminal.CopyMode, window *terminal.Window) {
 func moveToBottom(cm *terminal.CopyMode, window *terminal.Window) {
 	cm.ScrollOffset = 0
 	window.ScrollbackOffset = cm.ScrollOffset // Sync for rendering
-	cm.CursorY = window.Height - 3
+	cm.CursorY = window.ContentHeight() - 1
 	cm.CursorX = 0
 }
 
+// moveToLine scrolls so the absolute line absY is on screen and puts the
+// cursor on it: at the top of the view when it is in scrollback, as search
+// jumps do, and in place when it is on the live screen.
+func moveToLine(cm *terminal.CopyMode, window *terminal.Window, absY int) {
+	scrollbackLen := window.ScrollbackLen()
+	if absY < scrollbackLen {
+		cm.ScrollOffset = scrollbackLen - absY
+		cm.CursorY = 0
+	} else {
+		cm.ScrollOffset = 0
+		cm.CursorY = min(absY-scrollbackLen, window.ContentHeight()-1)
+	}
+	window.ScrollbackOffset = cm.ScrollOffset // Sync for rendering
+}
+
 // moveParagraphUp moves cursor to start of previous paragraph
 func moveParagraphUp(cm *terminal.CopyMode, window *terminal.Window) {
 	// Move up until we find a blank line, then skip blank lines
@@ -586,7 +601,7 @@ func moveParagraphDown(cm *terminal.CopyMode, window *terminal.Window) {
 		}
 
 		// Move down
-		if cm.CursorY < window.Height-3 {
+		if cm.CursorY < window.ContentHeight()-1 {
 			cm.CursorY++
 		} else if cm.ScrollOffset > 0 {
 			cm.ScrollOffset--
@@ -605,7 +620,7 @@ func moveParagraphDown(cm *terminal.CopyMode, window *terminal.Window) {
 		}
 
 		// Move down
-		if cm.CursorY < window.Height-3 {
+		if cm.CursorY < window.ContentHeight()-1 {
 			cm.CursorY++
 		} else if cm.ScrollOffset > 0 {
 			cm.ScrollOffset--
@@ -666,12 +681,12 @@ func moveToMatchingBracket(cm *terminal.CopyMode, window *terminal.Window) {
 		// Move in search direction
 		if direction > 0 {
 			// Moving forward
-			if cm.CursorX < window.Width-3 {
+			if cm.CursorX < window.ContentWidth()-1 {
 				cm.CursorX++
 			} else {
 				// Wrap to next line
 				cm.CursorX = 0
-				if cm.CursorY < window.Height-3 {
+				if cm.CursorY < window.ContentHeight()-1 {
 					cm.CursorY++
 				} else if cm.ScrollOffset > 0 {
 					cm.ScrollOffset--
@@ -686,7 +701,7 @@ func moveToMatchingBracket(cm *terminal.CopyMode, window *terminal.Window) {
 				cm.CursorX--
 			} else {
 				// Wrap to previous line
-				cm.CursorX = window.Width - 3
+				cm.CursorX = window.ContentWidth() - 1
 				if cm.CursorY > 0 {
 					cm.CursorY--
 				} else if cm.ScrollOffset < window.ScrollbackLen() {
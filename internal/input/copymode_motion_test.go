@@ -2,7 +2,10 @@ package input
 
 import (
 	"testing"
+	"time"
 
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
 	uv "github.com/charmbracelet/ultraviolet"
 )
 
@@ -106,3 +109,50 @@ func TestGetCharType(t *testing.T) {
 		})
 	}
 }
+
+func TestAcceleratedCount(t *testing.T) {
+	defer func(v bool) { config.CopyModeKeyAccel = v }(config.CopyModeKeyAccel)
+
+	t.Run("disabled keeps single steps", func(t *testing.T) {
+		config.CopyModeKeyAccel = false
+		cm := &terminal.CopyMode{}
+		for range 40 {
+			if got := acceleratedCount(cm, "j", 1); got != 1 {
+				t.Fatalf("acceleratedCount() = %d with acceleration off, want 1", got)
+			}
+		}
+	})
+
+	t.Run("held key speeds up to the cap", func(t *testing.T) {
+		config.CopyModeKeyAccel = true
+		cm := &terminal.CopyMode{}
+		var steps []int
+		for range 40 {
+			steps = append(steps, acceleratedCount(cm, "j", 1))
+		}
+		if steps[0] != 1 || steps[8] != 2 || steps[16] != 4 || steps[39] != 8 {
+			t.Fatalf("unexpected step progression %v", steps)
+		}
+	})
+
+	t.Run("pause or other key resets", func(t *testing.T) {
+		config.CopyModeKeyAccel = true
+		cm := &terminal.CopyMode{LastMoveKey: "j", LastMoveTime: time.Now(), MoveStreak: 30}
+		if got := acceleratedCount(cm, "k", 1); got != 1 {
+			t.Fatalf("switching keys gave %d, want 1", got)
+		}
+		cm.MoveStreak = 30
+		cm.LastMoveTime = time.Now().Add(-time.Second)
+		if got := acceleratedCount(cm, "k", 1); got != 1 {
+			t.Fatalf("a paused key gave %d, want 1", got)
+		}
+	})
+
+	t.Run("explicit count wins", func(t *testing.T) {
+		config.CopyModeKeyAccel = true
+		cm := &terminal.CopyMode{LastMoveKey: "j", LastMoveTime: time.Now(), MoveStreak: 30, PendingCount: 3}
+		if got := acceleratedCount(cm, "j", 3); got != 3 {
+			t.Fatalf("acceleratedCount() = %d with a typed count, want 3", got)
+		}
+	})
+}
@@ -0,0 +1,47 @@
+// Package input implements vim-style copy mode for TUIOS.
+package input
+
+import (
+	"github.com/Gaurav-Gosain/tuios/internal/scrollback"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// lastOutputRange returns the absolute lines holding the output of the most
+// recent command that printed any, trimmed of trailing blank lines. It needs
+// OSC 133 shell integration: prompts guessed by regex do not say where output
+// ends reliably enough to select it. ok is false when there is no such output.
+func lastOutputRange(cm *terminal.CopyMode, window *terminal.Window) (start, end int, ok bool) {
+	for _, block := range scrollback.ParseBlocks(window.Terminal) {
+		if block.Method != "osc133" {
+			continue
+		}
+		start, end = block.OutputStart, block.OutputEnd
+		for end >= start && isBlankLine(getLineText(cm, window, end)) {
+			end--
+		}
+		if end >= start {
+			return start, end, true
+		}
+	}
+	return 0, 0, false
+}
+
+// selectLastOutput puts copy mode in visual line mode over the last command's
+// output, with the cursor on its last line, so y copies it and motions extend
+// it as after V. It returns false, leaving the state alone, when there is no
+// output to select.
+func selectLastOutput(cm *terminal.CopyMode, window *terminal.Window) bool {
+	start, end, ok := lastOutputRange(cm, window)
+	if !ok {
+		return false
+	}
+
+	moveToLine(cm, window, end)
+	startX, _ := getLineContentBounds(cm, window, start)
+	_, endX := getLineContentBounds(cm, window, end)
+	cm.State = terminal.CopyModeVisualLine
+	cm.VisualStart = terminal.Position{X: startX, Y: start}
+	cm.VisualEnd = terminal.Position{X: endX, Y: end}
+	cm.CursorX = endX
+	return true
+}
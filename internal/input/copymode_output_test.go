@@ -0,0 +1,107 @@
+package input
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/app"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// osc133Window returns a daemon window whose screen holds two commands marked
+// with OSC 133, as a shell with integration would print them, followed by a
+// fresh prompt.
+func osc133Window(t *testing.T, id string) *terminal.Window {
+	t.Helper()
+	win := terminal.NewDaemonWindow(id, "osc133", 0, 0, 60, 20, 0, "pty-"+id, make(chan struct{}, 64))
+	if win == nil {
+		t.Fatal("NewDaemonWindow returned nil")
+	}
+	t.Cleanup(func() { win.Close() })
+	const (
+		a = "\x1b]133;A\x07"
+		b = "\x1b]133;B\x07"
+		c = "\x1b]133;C\x07"
+		d = "\x1b]133;D;0\x07"
+	)
+	win.LockIO()
+	_, _ = win.Terminal.Write([]byte(
+		a + "$ " + b + "echo old\r\n" + c + "old\r\n" + d +
+			a + "$ " + b + "ls\r\n" + c + "alpha\r\nbeta\r\n\r\n" + d +
+			a + "$ " + b))
+	win.UnlockIO()
+	return win
+}
+
+func TestSelectLastOutput(t *testing.T) {
+	win := osc133Window(t, "select-output-01")
+	win.EnterCopyMode()
+	cm := win.CopyMode
+
+	win.RLockIO()
+	ok := selectLastOutput(cm, win)
+	text := extractVisualText(cm, win)
+	win.RUnlockIO()
+
+	if !ok {
+		t.Fatal("selectLastOutput found no output")
+	}
+	if cm.State != terminal.CopyModeVisualLine {
+		t.Errorf("state = %v, want visual line", cm.State)
+	}
+	if text != "alpha\nbeta" {
+		t.Errorf("selected %q, want the last command's output %q", text, "alpha\nbeta")
+	}
+}
+
+func TestPrefixSelectOutputWithoutShellIntegration(t *testing.T) {
+	win := terminal.NewDaemonWindow("select-output-02", "plain", 0, 0, 60, 20, 0, "pty-plain", make(chan struct{}, 64))
+	if win == nil {
+		t.Fatal("NewDaemonWindow returned nil")
+	}
+	t.Cleanup(func() { win.Close() })
+	win.LockIO()
+	_, _ = win.Terminal.Write([]byte("$ ls\r\nalpha\r\n$ "))
+	win.UnlockIO()
+
+	o := &app.OS{Windows: []*terminal.Window{win}, FocusedWindow: 0}
+	handlePrefixSelectOutput(tea.KeyPressMsg{}, o)
+	if win.CopyMode != nil && win.CopyMode.Active {
+		t.Error("copy mode left open with nothing selected")
+	}
+	if len(o.Notifications) == 0 {
+		t.Error("expected a notification explaining why nothing was selected")
+	}
+}
+
+func TestCopyModePromptJumps(t *testing.T) {
+	win := osc133Window(t, "prompt-jumps-01")
+	o := &app.OS{Windows: []*terminal.Window{win}, FocusedWindow: 0}
+	win.EnterCopyMode()
+	press := func(keys ...string) int {
+		for _, k := range keys {
+			HandleCopyModeKey(tea.KeyPressMsg{Code: rune(k[0]), Text: k}, o, win)
+		}
+		return getAbsoluteY(win.CopyMode, win)
+	}
+
+	// Prompts sit on lines 0, 2 and 6; copy mode starts mid-screen below them.
+	if got := press("["); got != win.Height/2 {
+		t.Fatalf("a single [ moved the cursor to line %d", got)
+	}
+	win.CopyMode.PendingPrompt = "" // as if the second [ never came
+	for _, want := range []int{6, 2, 0} {
+		if got := press("[", "["); got != want {
+			t.Fatalf("[[ went to line %d, want %d", got, want)
+		}
+	}
+	if got := press("]", "]"); got != 2 {
+		t.Errorf("]] went to line %d, want 2", got)
+	}
+
+	// Nothing above the first prompt: the cursor stays put.
+	press("[", "[")
+	if got := press("[", "["); got != 0 {
+		t.Errorf("[[ past the first prompt moved to line %d", got)
+	}
+}
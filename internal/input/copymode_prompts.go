@@ -0,0 +1,76 @@
+// Package input implements vim-style copy mode for TUIOS.
+package input
+
+import (
+	"slices"
+	"time"
+
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+	"github.com/Gaurav-Gosain/tuios/internal/vt"
+)
+
+// Prompt navigation for copy mode ([[ and ]]), driven by the prompt-start
+// marks (OSC 133;A) a shell with integration prints before each prompt.
+
+// noPromptMessage explains a prompt jump that found nowhere to go.
+const noPromptMessage = "No shell prompt that way (needs shell integration, OSC 133)"
+
+// promptJumpReady reports whether action completes a doubled prompt-jump key
+// ('[[' or ']]'). The first press is remembered and reports false, as the
+// first g of gg does.
+func promptJumpReady(cm *terminal.CopyMode, action string) bool {
+	if cm.PendingPrompt == action && time.Since(cm.LastCommandTime) < 500*time.Millisecond {
+		cm.PendingPrompt = ""
+		return true
+	}
+	cm.PendingPrompt = action
+	cm.LastCommandTime = time.Now()
+	return false
+}
+
+// promptLines returns the absolute lines of the window's recorded prompts in
+// ascending order, without duplicates.
+func promptLines(window *terminal.Window) []int {
+	markers := window.Terminal.SemanticMarkers()
+	if markers == nil {
+		return nil
+	}
+	var lines []int
+	for _, m := range markers.Markers() {
+		if m.Type == vt.MarkerPromptStart {
+			lines = append(lines, m.AbsLine)
+		}
+	}
+	slices.Sort(lines)
+	return slices.Compact(lines)
+}
+
+// jumpToPrompt moves the cursor to the start of the nearest prompt after
+// (forward) or before the cursor line. It returns false, leaving the cursor
+// alone, when there is none.
+func jumpToPrompt(cm *terminal.CopyMode, window *terminal.Window, forward bool) bool {
+	lines := promptLines(window)
+	cur := getAbsoluteY(cm, window)
+	target := -1
+	if forward {
+		for _, l := range lines {
+			if l > cur {
+				target = l
+				break
+			}
+		}
+	} else {
+		for i := len(lines) - 1; i >= 0; i-- {
+			if lines[i] < cur {
+				target = lines[i]
+				break
+			}
+		}
+	}
+	if target < 0 {
+		return false
+	}
+	moveToLine(cm, window, target)
+	cm.CursorX = 0
+	return true
+}
@@ -5,11 +5,61 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/terminal"
 )
 
 // Search-related functions for copy mode (/, ?, n, N, etc.)
 
+// recordSearchHistory adds a confirmed query to the window's search history,
+// skipping a repeat of the newest entry and dropping the oldest past
+// config.CopyModeSearchHistory.
+func recordSearchHistory(cm *terminal.CopyMode) {
+	cm.HistoryIndex = 0
+	limit := config.CopyModeSearchHistory
+	if cm.SearchQuery == "" || limit <= 0 {
+		return
+	}
+	if n := len(cm.SearchHistory); n > 0 && cm.SearchHistory[n-1] == cm.SearchQuery {
+		return
+	}
+	cm.SearchHistory = append(cm.SearchHistory, cm.SearchQuery)
+	if over := len(cm.SearchHistory) - limit; over > 0 {
+		cm.SearchHistory = cm.SearchHistory[over:]
+	}
+}
+
+// recallSearchHistory replaces the query with an older (delta 1, up) or newer
+// (delta -1, down) history entry. Going down past the newest entry brings back
+// what was being typed before recall started. It reports whether the query
+// changed.
+func recallSearchHistory(cm *terminal.CopyMode, delta int) bool {
+	next := cm.HistoryIndex + delta
+	if next < 0 || next > len(cm.SearchHistory) {
+		return false
+	}
+	if cm.HistoryIndex == 0 {
+		cm.HistoryDraft = cm.SearchQuery
+	}
+	cm.HistoryIndex = next
+	if next == 0 {
+		cm.SearchQuery = cm.HistoryDraft
+	} else {
+		cm.SearchQuery = cm.SearchHistory[len(cm.SearchHistory)-next]
+	}
+	return true
+}
+
+// searchCaseSensitive reports whether query is matched with case: always
+// when the window asks for it, otherwise only under smart case with an
+// uppercase letter in the query.
+func searchCaseSensitive(cm *terminal.CopyMode, query string) bool {
+	if cm.CaseSensitive {
+		return true
+	}
+	return config.CopyModeSmartCase && strings.ToLower(query) != query
+}
+
 // executeSearch performs a search operation and updates matches
 func executeSearch(cm *terminal.CopyMode, window *terminal.Window) {
 	// Check cache
@@ -28,7 +78,8 @@ func executeSearch(cm *terminal.CopyMode, window *terminal.Window) {
 	}
 
 	query := cm.SearchQuery
-	if !cm.CaseSensitive {
+	caseSensitive := searchCaseSensitive(cm, query)
+	if !caseSensitive {
 		query = strings.ToLower(query)
 	}
 
@@ -43,7 +94,7 @@ func executeSearch(cm *terminal.CopyMode, window *terminal.Window) {
 		}
 		lineText := extractLineTextFromCells(line)
 
-		if !cm.CaseSensitive {
+		if !caseSensitive {
 			lineText = strings.ToLower(lineText)
 		}
 
@@ -92,7 +143,7 @@ func executeSearch(cm *terminal.CopyMode, window *terminal.Window) {
 		for y := range screenHeight {
 			lineText := extractScreenLineText(window.Terminal, y)
 
-			if !cm.CaseSensitive {
+			if !caseSensitive {
 				lineText = strings.ToLower(lineText)
 			}
 
@@ -229,7 +280,7 @@ func jumpToMatch(cm *terminal.CopyMode, window *terminal.Window, matchIdx int) {
 		screenLine := match.Line - scrollbackLen
 		cm.ScrollOffset = 0
 		window.ScrollbackOffset = cm.ScrollOffset // Sync for rendering
-		cm.CursorY = min(screenLine, window.Height-3)
+		cm.CursorY = min(screenLine, window.ContentHeight()-1)
 	}
 
 	cm.CursorX = match.StartX
@@ -0,0 +1,40 @@
+package input
+
+import (
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/app"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// handleWindowPrefixHeader handles Ctrl+B, t, h: with a header frozen in the
+// focused window it unfreezes it, otherwise it waits for the number of rows
+// to freeze.
+func handleWindowPrefixHeader(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	if fw := o.GetFocusedWindow(); fw != nil && fw.FrozenHeaderLines > 0 {
+		o.FreezeHeader(0)
+		o.ShowNotification("Header unfrozen", "info", config.NotificationDuration)
+		return o, nil
+	}
+	return makeSubPrefixHandler(func(o *app.OS) { o.HeaderPrefixActive = true })(msg, o)
+}
+
+// handleHeaderPrefix handles the key after Ctrl+B, t, h: a digit freezes that
+// many rows at the top of the focused window. Any other key cancels.
+func handleHeaderPrefix(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.HeaderPrefixActive = false
+	o.PrefixActive = false
+
+	key := msg.String()
+	if len(key) != 1 || key[0] < '1' || key[0] > '9' {
+		return o, nil
+	}
+	n := int(key[0] - '0')
+	if !o.FreezeHeader(n) {
+		o.ShowNotification(fmt.Sprintf("Cannot freeze %d rows here", n), "warning", config.NotificationDuration)
+		return o, nil
+	}
+	o.ShowNotification(fmt.Sprintf("Froze %d header row(s)", n), "info", config.NotificationDuration)
+	return o, nil
+}
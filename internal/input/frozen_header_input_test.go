@@ -0,0 +1,37 @@
+package input
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/app"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestHeaderPrefixTogglesTheFrozenHeader checks Ctrl+B, t, h waits for a
+// digit, and with a header already frozen unfreezes it instead.
+func TestHeaderPrefixTogglesTheFrozenHeader(t *testing.T) {
+	win := &terminal.Window{ID: "header-win", Workspace: 1, FrozenHeaderLines: 2, FrozenHeader: []string{"a", "b"}}
+	o := &app.OS{
+		CurrentWorkspace: 1,
+		FocusedWindow:    0,
+		Windows:          []*terminal.Window{win},
+	}
+
+	handleWindowPrefixHeader(tea.KeyPressMsg{Code: 'h', Text: "h"}, o)
+	if o.HeaderPrefixActive {
+		t.Error("Ctrl+B, t, h with a frozen header should unfreeze it, not wait for a digit")
+	}
+	if win.FrozenHeaderLines != 0 {
+		t.Errorf("frozen header rows = %d after unfreezing, want 0", win.FrozenHeaderLines)
+	}
+
+	handleWindowPrefixHeader(tea.KeyPressMsg{Code: 'h', Text: "h"}, o)
+	if !o.HeaderPrefixActive {
+		t.Fatal("Ctrl+B, t, h should wait for the number of rows")
+	}
+	handleHeaderPrefix(tea.KeyPressMsg{Code: tea.KeyEscape}, o)
+	if o.HeaderPrefixActive || o.PrefixActive {
+		t.Error("Esc should cancel the header prefix")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	tea "charm.land/bubbletea/v2"
 	"github.com/Gaurav-Gosain/tuios/internal/app"
 	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
 )
 
 // PrefixKeyTimeout is the duration after which prefix mode times out
@@ -20,6 +21,17 @@ func HandleInput(msg tea.Msg, o *app.OS) (tea.Model, tea.Cmd) {
 	var result tea.Model
 	var cmd tea.Cmd
 
+	// In review mode, or with linked windows, measure how far the input
+	// scrolled the focused window so the same scroll can be applied to the
+	// others.
+	var scrolled *terminal.Window
+	scrolledFrom := 0
+	if o.SyncScroll || len(o.ScrollLinks) > 0 {
+		if scrolled = o.GetFocusedWindow(); scrolled != nil {
+			scrolledFrom = scrolled.ScrollbackOffset
+		}
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyPressMsg:
 		result, cmd = HandleKeyPress(msg, o)
@@ -73,6 +85,10 @@ func HandleInput(msg tea.Msg, o *app.OS) (tea.Model, tea.Cmd) {
 		return o, nil
 	}
 
+	if scrolled != nil && scrolled == o.GetFocusedWindow() {
+		o.SyncScrollFrom(scrolled, scrolled.ScrollbackOffset-scrolledFrom)
+	}
+
 	// Sync state to daemon after any input that might have changed state
 	// This ensures state persists across reconnects without explicit save
 	if o.IsDaemonSession {
@@ -84,11 +100,14 @@ func HandleInput(msg tea.Msg, o *app.OS) (tea.Model, tea.Cmd) {
 
 // shouldShowQuitDialog checks if there are any terminals with active foreground processes
 // to show quit confirmation for. Returns true if any window has a foreground process
-// (besides the shell itself), or if we're unable to detect (falls back to true).
+// (besides the shell itself), unless confirm_quit_if_busy is off.
 func shouldShowQuitDialog(o *app.OS) bool {
 	if config.AlwaysConfirmQuit {
 		return true
 	}
+	if !config.ConfirmQuitIfBusy {
+		return false
+	}
 	// Check each window for active foreground processes
 	for _, win := range o.Windows {
 		if win != nil && win.HasForegroundProcess() {
@@ -131,19 +150,46 @@ func requestQuit(o *app.OS) (*app.OS, tea.Cmd) {
 }
 
 // detachSession leaves the session running and quits this client. It pushes
-// state first so the session the user comes back to is the one they left.
-// Outside a daemon session there is nothing to detach from, and the caller
-// decides what that means instead.
+// state first so the session the user comes back to is the one they left, then
+// detaches through the daemon rather than just dropping the connection, so the
+// daemon releases this client's PTY subscriptions and tells the other clients
+// straight away. This is the same path however the client was started, local
+// or over SSH. Outside a daemon session there is nothing to detach from, and
+// the caller decides what that means instead.
 func detachSession(o *app.OS) (*app.OS, tea.Cmd, bool) {
 	if !o.IsDaemonSession {
 		return o, nil, false
 	}
 	o.SyncStateToDaemon()
 	o.FireDetached()
+	if o.DaemonClient != nil {
+		if err := o.DaemonClient.Detach(); err != nil {
+			o.LogError("Failed to detach from the daemon: %v", err)
+		}
+		o.Detached = true
+	}
 	// Deliberately no Cleanup: the session outlives this client.
 	return o, tea.Quit, true
 }
 
+// endScrollPeek returns the focused window to live output when a scroll peek
+// (leader u) is showing. Any key ends the peek and then does whatever it
+// normally does, except the leader and the peek chord itself, so the peek can
+// be repeated to go further back.
+func endScrollPeek(msg tea.KeyPressMsg, o *app.OS) {
+	focused := o.GetFocusedWindow()
+	if focused == nil || !focused.ScrollbackMode {
+		return
+	}
+	if strings.EqualFold(msg.String(), config.LeaderKey) {
+		return
+	}
+	if o.PrefixActive && o.KeybindRegistry != nil && o.KeybindRegistry.GetPrefixAction(msg.String()) == "prefix_peek" {
+		return
+	}
+	focused.ExitScrollbackMode()
+}
+
 // HandleKeyPress handles all keyboard input and routes to mode-specific handlers
 func HandleKeyPress(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	// Capture the keypress for the showkeys overlay when it is enabled. This is
@@ -154,6 +200,8 @@ func HandleKeyPress(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		o.CaptureKeyEvent(msg)
 	}
 
+	endScrollPeek(msg, o)
+
 	// Handle quit confirmation dialog (highest priority - works in any mode)
 	if o.ShowQuitConfirm {
 		key := msg.String()
@@ -200,6 +248,12 @@ func HandleKeyPress(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		return o, nil
 	}
 
+	// An open context menu is navigated like a short list; any other key
+	// is swallowed so it cannot leak to the window behind the menu.
+	if o.ContextMenu != nil {
+		return handleContextMenuInput(msg, o)
+	}
+
 	// Terminal-mode keystrokes are recorded at the point they are actually
 	// forwarded to the PTY (see recordTerminalKey in HandleTerminalModeKey), not
 	// here: recording before prefix/overlay routing captured prefix chords,
@@ -216,6 +270,12 @@ func HandleKeyPress(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		}
 	}
 
+	// Handle the workspace merge confirmation (Ctrl+B, w, M, digit): modal, so
+	// the answer never reaches the shell.
+	if o.MergeConfirmTarget != 0 {
+		return handleMergeConfirm(msg, o)
+	}
+
 	// Handle tape manager overlay (high priority - intercepts keys when shown)
 	if o.ShowTapeManager {
 		if o.HandleTapeManagerInput(msg.String()) {
@@ -224,6 +284,12 @@ func HandleKeyPress(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		// Key not handled by tape manager, fall through
 	}
 
+	// A finished tape held on screen (tape_finish_hold) is dismissed by any
+	// key, which is not passed on.
+	if o.DismissFinishedScript() {
+		return o, nil
+	}
+
 	// Handle script pause/resume (Ctrl+P) while a script is actively playing.
 	// Once a script finishes, ScriptMode is left (see maybeExitFinishedScript),
 	// so this no longer shadows the command palette binding. Matched on the
@@ -238,6 +304,19 @@ func HandleKeyPress(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		return handleRenameMode(msg, o)
 	}
 
+	// Handle the jump-to-window prompt
+	if o.JumpingToWindow {
+		return handleWindowJumpMode(msg, o)
+	}
+
+	// Handle the window comparison: picking its two windows, then the view
+	if o.ComparePicking {
+		return handleComparePick(msg, o)
+	}
+	if o.Compare != nil {
+		return handleCompareView(msg, o)
+	}
+
 	// Terminal mode handling
 	if o.Mode == app.TerminalMode {
 		return HandleTerminalModeKey(msg, o)
@@ -275,6 +354,31 @@ func HandleKeyPress(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		return handleTerminalLayoutPrefix(msg, o)
 	}
 
+	// Handle signal prefix commands (Ctrl+B, k, ...)
+	if o.SignalPrefixActive {
+		return handleSignalPrefix(msg, o)
+	}
+
+	// Handle workspace merge target (Ctrl+B, w, M, ...)
+	if o.MergePrefixActive {
+		return handleMergePrefix(msg, o)
+	}
+
+	// Handle send-to-workspace target (Ctrl+B, w, s, ...)
+	if o.SendPrefixActive {
+		return handleSendPrefix(msg, o)
+	}
+
+	// Handle the workspace to peek at (Ctrl+B, w, p, ...)
+	if o.PeekPrefixActive {
+		return handlePeekPrefix(msg, o)
+	}
+
+	// Handle the number of header rows to freeze (Ctrl+B, t, h, ...)
+	if o.HeaderPrefixActive {
+		return handleHeaderPrefix(msg, o)
+	}
+
 	// Handle tape prefix commands (Ctrl+B, T, ...)
 	if o.TapePrefixActive {
 		return HandleTapePrefixCommand(msg, o)
@@ -334,6 +438,71 @@ func handleRenameMode(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	}
 }
 
+// handleWindowJumpMode handles keyboard input in the jump-to-window prompt.
+// Typing edits the query the way rename mode edits a name.
+func handleWindowJumpMode(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		if !o.ConfirmWindowJump() {
+			o.ShowNotification("No matching window", "warning", config.NotificationDuration)
+		}
+	case "esc":
+		o.CancelWindowJump()
+	case "up", "shift+tab", "ctrl+p":
+		o.WindowJumpMove(-1)
+	case "down", "tab", "ctrl+n":
+		o.WindowJumpMove(1)
+	case "backspace":
+		if len(o.JumpBuffer) > 0 {
+			o.SetWindowJumpQuery(o.JumpBuffer[:len(o.JumpBuffer)-1])
+		}
+	case "space":
+		o.SetWindowJumpQuery(o.JumpBuffer + " ")
+	default:
+		if len(msg.String()) == 1 && msg.String()[0] >= 32 && msg.String()[0] < 127 {
+			o.SetWindowJumpQuery(o.JumpBuffer + msg.String())
+		}
+	}
+	return o, nil
+}
+
+// handleComparePick takes a pane number for each of the two windows to
+// compare. Esc cancels; any other key is swallowed.
+func handleComparePick(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	key := msg.String()
+	if key == "esc" || key == "q" {
+		o.CancelCompare()
+		return o, nil
+	}
+	if len(key) == 1 && key[0] >= '0' && key[0] <= '9' {
+		if !o.ComparePick(int(key[0] - '0')) {
+			o.ShowNotification("No other window with that number", "warning", config.NotificationDuration)
+		}
+	}
+	return o, nil
+}
+
+// handleCompareView scrolls and closes the window comparison.
+func handleCompareView(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc":
+		o.CloseCompare()
+	case "j", "down":
+		o.ScrollCompare(1)
+	case "k", "up":
+		o.ScrollCompare(-1)
+	case "ctrl+d", "pgdown", "space":
+		o.ScrollCompare(10)
+	case "ctrl+u", "pgup":
+		o.ScrollCompare(-10)
+	case "g", "home":
+		o.ScrollCompare(-len(o.Compare.Rows))
+	case "G", "end":
+		o.ScrollCompare(len(o.Compare.Rows))
+	}
+	return o, nil
+}
+
 // handlePrefixKey handles Ctrl+B prefix key activation
 func handlePrefixKey(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	// If prefix is already active, deactivate it (double leader key cancels)
@@ -359,6 +528,12 @@ func handleLogViewerKey(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		return o, nil
 	}
 
+	// Save the logs to a file for a bug report
+	if key == "s" {
+		o.SaveLogs()
+		return o, nil
+	}
+
 	logsPerPage, maxScroll := logScrollBounds(o.Height, len(o.LogMessages))
 
 	// Scroll up/down
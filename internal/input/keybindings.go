@@ -11,6 +11,7 @@ import (
 	"runtime"
 
 	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/vt"
 )
 
@@ -88,6 +89,10 @@ func getRawKeyBytes(msg tea.KeyPressMsg) []byte {
 // The applicationCursorKeys parameter indicates whether DECCKM mode is enabled,
 // which determines whether arrow keys send SS3 (ESC O) or CSI (ESC [) sequences.
 func getRawKeyBytesWithMode(msg tea.KeyPressMsg, applicationCursorKeys bool) []byte {
+	if b, ok := keyByteOverride(msg); ok {
+		return b
+	}
+
 	key := msg.Key()
 
 	// Mask off any non-modifier bits (Bubble Tea v2 may set additional flags like 128)
@@ -429,6 +434,25 @@ func IsMacOSOptionTab(r rune) string {
 	}
 }
 
+// keyByteOverride returns the appearance.key_bytes override for msg, if any.
+func keyByteOverride(msg tea.KeyPressMsg) ([]byte, bool) {
+	b, ok := config.KeyByteOverrides[msg.String()]
+	return b, ok
+}
+
+// kittyKeyBytes encodes msg as CSI u for a pane with the kitty keyboard
+// protocol enabled. A key_bytes override still wins, as it does over the
+// legacy encoding. It returns nil when the key has no CSI u form.
+func kittyKeyBytes(msg tea.KeyPressMsg, flags int) []byte {
+	if b, ok := keyByteOverride(msg); ok {
+		return b
+	}
+	if encoded := vt.EncodeKeyCSIu(vtKeyFromBubbletea(msg), flags); encoded != "" {
+		return []byte(encoded)
+	}
+	return nil
+}
+
 // vtKeyFromBubbletea converts a bubbletea KeyPressMsg to a VT emulator
 // KeyPressEvent for use with the kitty keyboard protocol's SendKey path.
 func vtKeyFromBubbletea(msg tea.KeyPressMsg) vt.KeyPressEvent {
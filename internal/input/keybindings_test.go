@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
 )
 
 func TestGetModParam(t *testing.T) {
@@ -196,3 +197,48 @@ func TestIsMacOSOptionTab(t *testing.T) {
 		})
 	}
 }
+
+// TestKeyByteOverrides checks that appearance.key_bytes replaces the built-in
+// encoding for the keys it names, whatever case they were written in, and
+// leaves every other key alone.
+func TestKeyByteOverrides(t *testing.T) {
+	original := config.KeyByteOverrides
+	defer func() { config.KeyByteOverrides = original }()
+
+	userCfg := config.DefaultConfig()
+	userCfg.Appearance.KeyBytes = map[string]string{"Ctrl+Left": "\x1bb"}
+	config.ApplyAppearanceConfig(userCfg)
+
+	got := getRawKeyBytesWithMode(tea.KeyPressMsg{Code: tea.KeyLeft, Mod: tea.ModCtrl}, false)
+	if !bytes.Equal(got, []byte("\x1bb")) {
+		t.Errorf("ctrl+left sent %q, want the override %q", got, "\x1bb")
+	}
+	got = getRawKeyBytesWithMode(tea.KeyPressMsg{Code: tea.KeyRight, Mod: tea.ModCtrl}, false)
+	if !bytes.Equal(got, []byte("\x1b[1;5C")) {
+		t.Errorf("ctrl+right sent %q, want the default %q", got, "\x1b[1;5C")
+	}
+
+	userCfg.Appearance.KeyBytes = nil
+	config.ApplyAppearanceConfig(userCfg)
+	if config.KeyByteOverrides != nil {
+		t.Error("a reload without key_bytes should clear the overrides")
+	}
+}
+
+// TestKeyByteOverridesKitty checks that key_bytes also wins for a pane that
+// turned on the kitty keyboard protocol, where keys are otherwise sent as CSI u.
+func TestKeyByteOverridesKitty(t *testing.T) {
+	original := config.KeyByteOverrides
+	defer func() { config.KeyByteOverrides = original }()
+
+	config.KeyByteOverrides = map[string][]byte{"ctrl+left": []byte("\x1bb")}
+
+	got := kittyKeyBytes(tea.KeyPressMsg{Code: tea.KeyLeft, Mod: tea.ModCtrl}, 1)
+	if !bytes.Equal(got, []byte("\x1bb")) {
+		t.Errorf("ctrl+left sent %q, want the override %q", got, "\x1bb")
+	}
+	got = kittyKeyBytes(tea.KeyPressMsg{Code: 'a', Mod: tea.ModCtrl}, 1)
+	if !bytes.Equal(got, []byte("\x1b[97;5u")) {
+		t.Errorf("ctrl+a sent %q, want the CSI u encoding %q", got, "\x1b[97;5u")
+	}
+}
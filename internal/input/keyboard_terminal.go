@@ -1,13 +1,15 @@
 package input
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"github.com/Gaurav-Gosain/tuios/internal/app"
 	"github.com/Gaurav-Gosain/tuios/internal/config"
-	"github.com/Gaurav-Gosain/tuios/internal/vt"
+	"github.com/Gaurav-Gosain/tuios/internal/layout"
+	"github.com/Gaurav-Gosain/tuios/internal/session"
 )
 
 // HandleTerminalModeKey handles keyboard input in terminal mode
@@ -119,6 +121,11 @@ func HandleTerminalModeKey(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		return handleThemePickerInput(msg, o)
 	}
 
+	// Handle theme cycling (leader > and <)
+	if o.ThemeCycleActive {
+		return handleThemeCycleInput(msg, o)
+	}
+
 	// Handle settings overlay (takes priority in terminal mode)
 	if o.ShowSettings {
 		return handleSettingsInput(msg, o)
@@ -161,7 +168,7 @@ func HandleTerminalModeKey(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 
 		// Reset cache stats with r
 		if key == "r" {
-			app.GetGlobalStyleCache().ResetStats()
+			o.ResetCacheStats()
 			o.ShowNotification("Cache statistics reset", "info", 2*time.Second)
 			return o, nil
 		}
@@ -211,16 +218,17 @@ func HandleTerminalModeKey(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	// Check for prefix key in terminal mode
 	msgStr := strings.ToLower(msg.String())
 	leaderKey := strings.ToLower(config.LeaderKey)
-	if msgStr == leaderKey {
+	// With prefix passthrough on (for a multiplexer nested in the pane) the
+	// leader is not intercepted and is forwarded like any other key below.
+	if msgStr == leaderKey && !o.PrefixPassthrough {
 		// If prefix is already active, send the leader key to terminal
 		if o.PrefixActive {
 			o.PrefixActive = false
 			if focusedWindow != nil {
 				// Use CSI u encoding if kitty keyboard is active
 				if focusedWindow.Terminal != nil && focusedWindow.Terminal.KittyKeyboardFlags() != 0 {
-					encoded := vt.EncodeKeyCSIu(vtKeyFromBubbletea(msg), focusedWindow.Terminal.KittyKeyboardFlags())
-					if len(encoded) > 0 {
-						_ = focusedWindow.SendInput([]byte(encoded))
+					if encoded := kittyKeyBytes(msg, focusedWindow.Terminal.KittyKeyboardFlags()); len(encoded) > 0 {
+						_ = focusedWindow.SendInput(encoded)
 						return o, nil
 					}
 				}
@@ -265,6 +273,31 @@ func HandleTerminalModeKey(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		return handleTerminalLayoutPrefix(msg, o)
 	}
 
+	// Handle signal prefix commands (Ctrl+B, k, ...)
+	if o.SignalPrefixActive {
+		return handleSignalPrefix(msg, o)
+	}
+
+	// Handle workspace merge target (Ctrl+B, w, M, ...)
+	if o.MergePrefixActive {
+		return handleMergePrefix(msg, o)
+	}
+
+	// Handle send-to-workspace target (Ctrl+B, w, s, ...)
+	if o.SendPrefixActive {
+		return handleSendPrefix(msg, o)
+	}
+
+	// Handle the workspace to peek at (Ctrl+B, w, p, ...)
+	if o.PeekPrefixActive {
+		return handlePeekPrefix(msg, o)
+	}
+
+	// Handle the number of header rows to freeze (Ctrl+B, t, h, ...)
+	if o.HeaderPrefixActive {
+		return handleHeaderPrefix(msg, o)
+	}
+
 	// Handle prefix commands in terminal mode
 	if o.PrefixActive {
 		return HandlePrefixCommand(msg, o)
@@ -325,10 +358,7 @@ func HandleTerminalModeKey(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		// When kitty keyboard protocol is active, encode as CSI u
 		var rawInput []byte
 		if focusedWindow.Terminal != nil && focusedWindow.Terminal.KittyKeyboardFlags() != 0 {
-			encoded := vt.EncodeKeyCSIu(vtKeyFromBubbletea(msg), focusedWindow.Terminal.KittyKeyboardFlags())
-			if len(encoded) > 0 {
-				rawInput = []byte(encoded)
-			}
+			rawInput = kittyKeyBytes(msg, focusedWindow.Terminal.KittyKeyboardFlags())
 		}
 		// Fall back to legacy encoding
 		if len(rawInput) == 0 {
@@ -382,8 +412,16 @@ func recordTerminalKey(o *app.OS, msg tea.KeyPressMsg) {
 	}
 }
 
+// layoutPresetKeys maps the layout sub-prefix keys that arrange the workspace
+// by a preset to the preset each applies.
+var layoutPresetKeys = map[string]string{
+	"c": layout.PresetColumns,
+	"r": layout.PresetRows,
+	"g": layout.PresetGrid,
+}
+
 // handleTerminalLayoutPrefix handles layout prefix commands (leader, L, ...).
-// The layout sub-prefix has no config section of its own yet, so its two keys
+// The layout sub-prefix has no config section of its own yet, so its keys
 // stay literal; entering it (prefix_layout) is configurable.
 func handleTerminalLayoutPrefix(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	o.LayoutPrefixActive = false
@@ -407,9 +445,75 @@ func handleTerminalLayoutPrefix(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cm
 		o.LayoutPickerSelected = 0
 		o.LayoutPickerScroll = 0
 		return o, nil
+	case "m":
+		if !o.ToggleMonocle() {
+			o.ShowNotification("Monocle needs BSP or master-stack tiling", "info", config.NotificationDuration)
+		}
+		return o, nil
+	case "h", "v":
+		flip := o.FlipLayoutHorizontal
+		if msg.String() == "v" {
+			flip = o.FlipLayoutVertical
+		}
+		if !flip() {
+			o.ShowNotification("Flipping needs BSP tiling with two or more windows", "info", config.NotificationDuration)
+		}
+		return o, nil
+	case "+", "=":
+		o.AdjustTilingGap(1)
+		return o, nil
+	case "-":
+		o.AdjustTilingGap(-1)
+		return o, nil
 	case "esc":
 		return o, nil
 	default:
+		if preset, ok := layoutPresetKeys[msg.String()]; ok && !o.ApplyLayoutPreset(preset) {
+			note := "No windows to arrange"
+			if o.AutoTiling && o.UseScrollingLayout {
+				note = "Layout presets do not apply to the scrolling layout"
+			}
+			o.ShowNotification(note, "info", config.NotificationDuration)
+		}
 		return o, nil
 	}
 }
+
+// signalPrefixKeys maps the keys of the signal sub-prefix to the signal each
+// sends. Like the layout sub-prefix it has no config section, so the keys are
+// literal. Names rather than syscall values keep the table buildable on
+// platforms without job control, where session.ParseSignal rejects them.
+var signalPrefixKeys = map[string]string{
+	"i": "INT",
+	"t": "TERM",
+	"k": "KILL",
+	"z": "TSTP",
+	"c": "CONT",
+	"h": "HUP",
+}
+
+// handleSignalPrefix handles signal prefix commands (leader, k, ...), sending
+// the chosen signal to the focused window's foreground job.
+func handleSignalPrefix(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.SignalPrefixActive = false
+	o.PrefixActive = false
+
+	name, ok := signalPrefixKeys[msg.String()]
+	if !ok {
+		return o, nil
+	}
+	focused := o.GetFocusedWindow()
+	if focused == nil {
+		return o, nil
+	}
+	sig, err := session.ParseSignal(name)
+	if err == nil {
+		err = o.SignalWindow(focused.ID, sig)
+	}
+	if err != nil {
+		o.ShowNotification(fmt.Sprintf("SIG%s failed: %v", name, err), "error", config.NotificationDuration)
+		return o, nil
+	}
+	o.ShowNotification(fmt.Sprintf("Sent SIG%s", name), "info", config.NotificationDuration)
+	return o, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Gaurav-Gosain/tuios/internal/app"
 	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/tape"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
 )
 
 // TestTransitionGuardCondition directly tests the guard condition that suppresses
@@ -277,3 +278,113 @@ func TestRecordTerminalKeyClassification(t *testing.T) {
 		t.Errorf("second command = %+v, want Enter", cmds[1])
 	}
 }
+
+// TestEnterActionInWindowMode checks config.EnterAction: Enter keeps entering
+// terminal mode by default, can be made inert or open a window instead, and
+// never changes what i or a user's own Enter binding does.
+func TestEnterActionInWindowMode(t *testing.T) {
+	prev := config.EnterAction
+	defer func() { config.EnterAction = prev }()
+
+	tests := []struct {
+		setting, key, bound, want string
+	}{
+		{config.EnterActionInsert, "enter", "enter_terminal_mode", "enter_terminal_mode"},
+		{config.EnterActionNone, "enter", "enter_terminal_mode", ""},
+		{config.EnterActionNew, "enter", "enter_terminal_mode", "new_window"},
+		{config.EnterActionNone, "i", "enter_terminal_mode", "enter_terminal_mode"},
+		{config.EnterActionNone, "enter", "toggle_help", "toggle_help"},
+	}
+	for _, tt := range tests {
+		config.EnterAction = tt.setting
+		if got := enterKeyAction(tt.key, tt.bound); got != tt.want {
+			t.Errorf("enter_action=%s, %s bound to %q: got %q, want %q", tt.setting, tt.key, tt.bound, got, tt.want)
+		}
+	}
+
+	// End to end: with enter_action = none, Enter leaves the mode alone.
+	config.EnterAction = config.EnterActionNone
+	o := osWithBindings(t, func(*config.KeybindingsConfig) {})
+	o.Windows = append(o.Windows, &terminal.Window{ID: "window-a", Workspace: o.CurrentWorkspace})
+	o.FocusedWindow = 0
+	o.Mode = app.WindowManagementMode
+	result, _ := HandleWindowManagementModeKey(tea.KeyPressMsg{Code: tea.KeyEnter}, o)
+	if result.Mode != app.WindowManagementMode {
+		t.Error("Enter entered terminal mode with enter_action = none")
+	}
+}
+
+// TestLastWindowAlternates checks that ";" in window mode goes back to the
+// window focused before the current one and that pressing it again returns,
+// regardless of where the two windows sit in the cycle order.
+func TestLastWindowAlternates(t *testing.T) {
+	o := osWithBindings(t, func(*config.KeybindingsConfig) {})
+	for _, id := range []string{"window-a", "window-b", "window-c"} {
+		o.Windows = append(o.Windows, &terminal.Window{ID: id, Workspace: o.CurrentWorkspace})
+	}
+	o.FocusedWindow = 0
+	o.Mode = app.WindowManagementMode
+	o.FocusWindow(2)
+
+	focusedID := func() string { return o.Windows[o.FocusedWindow].ID }
+	for _, want := range []string{"window-a", "window-c", "window-a"} {
+		o, _ = HandleWindowManagementModeKey(press(";"), o)
+		if got := focusedID(); got != want {
+			t.Fatalf("after ; focused %s, want %s", got, want)
+		}
+	}
+
+	// A window that is gone from the workspace is not jumped back to.
+	o.Windows[2].Minimized = true
+	if o.FocusLastWindow() {
+		t.Error("focused a minimized window")
+	}
+}
+
+// TestPrefixPassthrough checks that F12 hands the leader key to the pane in
+// terminal mode, so a nested multiplexer gets it, and that pressing F12 again
+// makes it the prefix once more.
+func TestPrefixPassthrough(t *testing.T) {
+	o := osWithBindings(t, func(*config.KeybindingsConfig) {})
+	o.Mode = app.TerminalMode
+	leader := tea.KeyPressMsg{Code: 'b', Mod: tea.ModCtrl}
+	f12 := tea.KeyPressMsg{Code: tea.KeyF12}
+
+	if !handleTerminalModeBinds(f12, o) || !o.PrefixPassthrough {
+		t.Fatal("F12 did not turn prefix passthrough on")
+	}
+	HandleTerminalModeKey(leader, o)
+	if o.PrefixActive {
+		t.Fatal("leader started a prefix while passthrough was on")
+	}
+
+	handleTerminalModeBinds(f12, o)
+	if o.PrefixPassthrough {
+		t.Fatal("F12 did not turn prefix passthrough off")
+	}
+	HandleTerminalModeKey(leader, o)
+	if !o.PrefixActive {
+		t.Error("leader did not start a prefix after passthrough was turned off")
+	}
+}
+
+// TestCopySelectionHasItsOwnKey checks y copies the mouse selection in window
+// mode, and that c, now unbound by default, does nothing with a selection.
+func TestCopySelectionHasItsOwnKey(t *testing.T) {
+	o := osWithBindings(t, func(*config.KeybindingsConfig) {})
+	o.Windows = append(o.Windows, &terminal.Window{ID: "window-a", Workspace: o.CurrentWorkspace})
+	o.FocusedWindow = 0
+	o.Mode = app.WindowManagementMode
+
+	if _, cmd := HandleWindowManagementModeKey(press("y"), o); cmd != nil {
+		t.Error("y with nothing selected should not touch the clipboard")
+	}
+
+	o.Windows[0].SelectedText = "hello"
+	if _, cmd := HandleWindowManagementModeKey(press("c"), o); cmd != nil {
+		t.Error("c should do nothing unless bound")
+	}
+	if _, cmd := HandleWindowManagementModeKey(press("y"), o); cmd == nil {
+		t.Error("y with a selection should copy it to the clipboard")
+	}
+}
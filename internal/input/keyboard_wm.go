@@ -5,6 +5,7 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 	"github.com/Gaurav-Gosain/tuios/internal/app"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
 )
 
 // HandleWindowManagementModeKey handles keyboard input in window management mode
@@ -26,6 +27,11 @@ func HandleWindowManagementModeKey(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea
 		return handleThemePickerInput(msg, o)
 	}
 
+	// Handle theme cycling (leader > and <)
+	if o.ThemeCycleActive {
+		return handleThemeCycleInput(msg, o)
+	}
+
 	// Handle settings overlay
 	if o.ShowSettings {
 		return handleSettingsInput(msg, o)
@@ -144,7 +150,7 @@ func HandleWindowManagementModeKey(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea
 
 		// Reset cache stats with r
 		if key == "r" {
-			app.GetGlobalStyleCache().ResetStats()
+			o.ResetCacheStats()
 			o.ShowNotification("Cache statistics reset", "info", 2*time.Second)
 			return o, nil
 		}
@@ -153,6 +159,16 @@ func HandleWindowManagementModeKey(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea
 		return o, nil
 	}
 
+	// Digits double as a split count: "30" then a split key gives the new pane
+	// 30%. They still go on to select windows below. Any other key ends the
+	// count once it has been handled, so only a split pressed straight after
+	// the digits can use it.
+	if len(key) == 1 && key[0] >= '0' && key[0] <= '9' {
+		o.NoteSplitCountDigit(key)
+	} else {
+		defer o.ClearSplitCount()
+	}
+
 	// Settings: comma opens the settings page directly in window mode. Checked
 	// before the config dispatch because the default keybinds map "," to a
 	// tiling resize action, which would otherwise swallow it.
@@ -163,7 +179,7 @@ func HandleWindowManagementModeKey(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea
 
 	// Try config-based dispatch first (if registry is available)
 	if o.KeybindRegistry != nil {
-		action := o.KeybindRegistry.GetAction(key)
+		action := enterKeyAction(key, o.KeybindRegistry.GetAction(key))
 		if action != "" {
 			dispatcher := GetDispatcher()
 			if dispatcher.HasAction(action) {
@@ -211,3 +227,20 @@ func HandleWindowManagementModeKey(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea
 		return o, nil
 	}
 }
+
+// enterKeyAction applies config.EnterAction to an Enter press that is bound to
+// enter_terminal_mode, turning it into nothing or a new window. Other keys,
+// and an Enter the user has bound to something else, keep their action.
+func enterKeyAction(key, action string) string {
+	if key != "enter" || action != "enter_terminal_mode" {
+		return action
+	}
+	switch config.EnterAction {
+	case config.EnterActionNone:
+		return ""
+	case config.EnterActionNew:
+		return "new_window"
+	default:
+		return action
+	}
+}
@@ -137,9 +137,8 @@ func scrollToPosition(win *terminal.Window, mouseY int) {
 		return
 	}
 
-	borderOff := win.BorderOffset()
 	contentH := win.ContentHeight()
-	relY := mouseY - win.Y - borderOff
+	relY := mouseY - win.Y - win.ContentOffsetY()
 	relY = max(min(relY, contentH-1), 0)
 
 	// relY=0 → top (max scroll), relY=contentH-1 → bottom (0 scroll)
@@ -18,6 +18,23 @@ func handleMouseClick(msg tea.MouseClickMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	X := mouse.X
 	Y := mouse.Y
 
+	// A click acts on whatever the tooltip described; it has done its job.
+	o.HideTooltip()
+
+	// An open context menu takes the click: a row runs its item, anything
+	// else dismisses the menu without reaching what is underneath.
+	if o.ContextMenu != nil {
+		if idx, inside := o.ContextMenuItemAt(X, Y); inside {
+			if idx >= 0 && msg.Button == tea.MouseLeft {
+				o.ContextMenu.Selected = idx
+				return o.ActivateContextMenu()
+			}
+			return o, nil
+		}
+		o.CloseContextMenu()
+		return o, nil
+	}
+
 	// Floating overlay panels (help, settings, palette, theme picker) consume
 	// clicks before they can reach the window layer: select a tab/row/control,
 	// grab the title bar or right-drag to move, or click away to dismiss.
@@ -56,8 +73,8 @@ func handleMouseClick(msg tea.MouseClickMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		return o, nil
 	}
 
-	// Check if click is in the dock area (always reserved)
-	if ((config.DockbarPosition == "bottom") && (Y >= o.Height-config.DockHeight)) || ((config.DockbarPosition == "top") && (Y <= config.DockHeight)) {
+	// Check if click is in the dock area (when it is shown)
+	if ((o.DockPosition() == "bottom") && (Y >= o.Height-config.DockHeight)) || ((o.DockPosition() == "top") && (Y <= config.DockHeight)) {
 		// Handle dock click only if there are minimized windows
 		if o.HasMinimizedWindows() {
 			dockIndex := findDockItemClicked(X, Y, o)
@@ -127,11 +144,27 @@ func handleMouseClick(msg tea.MouseClickMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	}
 	if clickedWindowIndex == -1 {
 		// Consume the event even if no window is hit to prevent leaking
+		switch msg.Button {
+		case tea.MouseLeft:
+			handleEmptyClick(X, Y, o)
+		case tea.MouseRight:
+			o.OpenDesktopContextMenu(X, Y)
+		}
 		return o, nil
 	}
 
 	clickedWindow := o.Windows[clickedWindowIndex]
 
+	// Right-click on a title bar opens the window's context menu, unless
+	// mouse_buttons remaps the right button, in which case the title bar does
+	// what that action does anywhere else on the window.
+	if msg.Button == tea.MouseRight && mouseButtonAction(msg.Button) == config.MouseActionResize &&
+		Y == clickedWindow.Y && !clickedWindow.TitleBarHidden {
+		o.OpenWindowContextMenu(clickedWindowIndex, X, Y+1)
+		o.InteractionMode = false
+		return o, nil
+	}
+
 	leftMost := clickedWindow.X + clickedWindow.Width
 
 	// DEBUG: Log click attempts
@@ -144,8 +177,8 @@ func handleMouseClick(msg tea.MouseClickMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	}
 
 	// Check button clicks FIRST before mode switching or focus changes
-	// Only check if buttons are not hidden
-	if !config.HideWindowButtons {
+	// Only check if buttons are not hidden, along with the title bar
+	if !config.HideWindowButtons && clickedWindow.TopOffset() > 0 {
 		// Title bar is at window.Y (buttons are on the first line of the window)
 		titleBarY := clickedWindow.Y
 
@@ -169,7 +202,7 @@ func handleMouseClick(msg tea.MouseClickMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		} else {
 			// Non-tiling: maximize button in middle
 			if mouse.Button == tea.MouseLeft && X >= leftMost-7 && X <= leftMost-5 && Y == titleBarY {
-				o.Snap(clickedWindowIndex, app.SnapFullScreen)
+				o.ToggleMaximize(clickedWindowIndex)
 				o.InteractionMode = false
 				return o, nil
 			}
@@ -201,6 +234,20 @@ func handleMouseClick(msg tea.MouseClickMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		// If click is outside content area, fall through to normal window interaction
 	}
 
+	// Buttons mapped to close or paste act on the window without starting a
+	// drag or resize.
+	action := mouseButtonAction(mouse.Button)
+	switch action {
+	case config.MouseActionClose:
+		o.DeleteWindow(clickedWindowIndex)
+		o.InteractionMode = false
+		return o, nil
+	case config.MouseActionPaste:
+		// The clipboard reply is only pasted in terminal mode.
+		o.FocusWindow(clickedWindowIndex)
+		return o, tea.Batch(o.EnterTerminalMode(), tea.ReadClipboard)
+	}
+
 	// Focus the clicked window and bring to front Z-index
 	// This happens AFTER button and copy mode checks
 	o.FocusWindow(clickedWindowIndex)
@@ -210,7 +257,7 @@ func handleMouseClick(msg tea.MouseClickMsg, o *app.OS) (*app.OS, tea.Cmd) {
 
 	// Zoomed windows are immune to drag/resize  - skip interaction state setup.
 	// The click still focuses the window (already done above) but no drag/resize starts.
-	if clickedWindow.Zoomed {
+	if clickedWindow.Zoomed || action == config.MouseActionNone {
 		return o, nil
 	}
 
@@ -221,8 +268,8 @@ func handleMouseClick(msg tea.MouseClickMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	o.DragOffsetX = X - clickedWindow.X
 	o.DragOffsetY = Y - clickedWindow.Y
 
-	switch mouse.Button {
-	case tea.MouseRight:
+	switch action {
+	case config.MouseActionResize:
 		// Already in interaction mode, now set resize-specific flags
 		o.Resizing = true
 		o.DraggedWindowIndex = clickedWindowIndex
@@ -264,7 +311,7 @@ func handleMouseClick(msg tea.MouseClickMsg, o *app.OS) (*app.OS, tea.Cmd) {
 			app.SetPointerShape(app.PointerNESWResize)
 		}
 
-	case tea.MouseLeft:
+	case config.MouseActionDrag:
 		// Check if we're in selection mode
 		if o.SelectionMode {
 			// Calculate terminal coordinates relative to window content
@@ -344,6 +391,26 @@ func handleMouseClick(msg tea.MouseClickMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	return o, nil
 }
 
+// mouseButtonAction returns the configured action for a button, treating
+// buttons outside the map (wheel, back, forward) as none.
+func mouseButtonAction(button tea.MouseButton) string {
+	var name string
+	switch button {
+	case tea.MouseLeft:
+		name = "left"
+	case tea.MouseMiddle:
+		name = "middle"
+	case tea.MouseRight:
+		name = "right"
+	default:
+		return config.MouseActionNone
+	}
+	if action, ok := config.MouseButtonMap[name]; ok {
+		return action
+	}
+	return config.MouseActionNone
+}
+
 // selectWord selects the word at the given position
 func selectWord(window *terminal.Window, x, y int, o *app.OS) {
 	if window.Terminal == nil {
@@ -381,7 +448,7 @@ func selectWord(window *terminal.Window, x, y int, o *app.OS) {
 	window.SelectionEnd.Y = y
 
 	// Extract the selected text
-	window.SelectedText = extractSelectedText(window, o)
+	window.SelectedText = o.ExtractSelectedText(window)
 	window.InvalidateCache()
 }
 
@@ -406,3 +473,16 @@ func isWordChar(r rune) bool {
 		(r >= '0' && r <= '9') ||
 		r == '_' || r == '-' || r == '.'
 }
+
+// handleEmptyClick does the configured empty_click_action for a left click on
+// the desktop, outside every window.
+func handleEmptyClick(x, y int, o *app.OS) {
+	switch config.EmptyClickAction {
+	case config.EmptyClickSpawn:
+		// A floating window opens where the pointer is; see NewWindowPlacement.
+		o.LastMouseX, o.LastMouseY = x, y
+		o.AddWindow("")
+	case config.EmptyClickClearFocus:
+		o.ClearFocus()
+	}
+}
@@ -17,6 +17,13 @@ func handleMouseMotion(msg tea.MouseMotionMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	o.Y = mouse.Y
 	o.LastMouseX = mouse.X
 	o.LastMouseY = mouse.Y
+	o.UpdateDockReveal(mouse.Y)
+
+	// The pointer picks the context menu row it is over.
+	if o.ContextMenu != nil {
+		o.ContextMenuHover(mouse.X, mouse.Y)
+		return o, nil
+	}
 
 	// Drag an overlay panel that was grabbed by its title bar / right-click.
 	if o.OverlayMouseMotion(mouse.X, mouse.Y) {
@@ -26,6 +33,19 @@ func handleMouseMotion(msg tea.MouseMotionMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	// Update pointer shape based on what we're hovering over (OSC 22)
 	o.UpdatePointerForPosition(mouse.X, mouse.Y)
 
+	// Hover focus (config.FocusMode) only follows a pointer with no button
+	// held, so drags, resizes and selections never move focus.
+	// Tooltips for title-bar buttons and dock items follow the same rule.
+	var hoverCmd tea.Cmd
+	if mouse.Button == tea.MouseNone && !o.Dragging && !o.Resizing && !o.OverlayActive() {
+		hoverCmd = tea.Batch(
+			o.HoverFocus(findClickedWindow(mouse.X, mouse.Y, o)),
+			o.HoverTooltip(mouse.X, mouse.Y),
+		)
+	} else {
+		o.HideTooltip()
+	}
+
 	// Forward mouse motion to terminal if in terminal mode and window supports motion events.
 	// Only modes 1002 (button-event) and 1003 (any-event) support motion forwarding.
 	// Mode 1000/1001 (normal tracking) only supports click/release  - forwarding motion
@@ -34,6 +54,11 @@ func handleMouseMotion(msg tea.MouseMotionMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		focusedWindow := o.GetFocusedWindow()
 		if focusedWindow != nil && focusedWindow.Terminal != nil {
 			shouldForward := focusedWindow.Terminal.SupportsMotionEvents()
+			// Hover focus turns on any-motion reporting; an app that asked
+			// only for button-event tracking (1002) must not see bare motion.
+			if mouse.Button == tea.MouseNone && !focusedWindow.Terminal.HasAllMotionMode() {
+				shouldForward = false
+			}
 
 			if shouldForward {
 				// Convert to terminal-relative coordinates (0-based)
@@ -49,7 +74,7 @@ func handleMouseMotion(msg tea.MouseMotionMsg, o *app.OS) (*app.OS, tea.Cmd) {
 					}
 					// Send to the terminal (uses PTY for daemon windows)
 					sendMouseToWindow(focusedWindow, adjustedMouse)
-					return o, nil
+					return o, hoverCmd
 				}
 			}
 		}
@@ -92,9 +117,8 @@ func handleMouseMotion(msg tea.MouseMotionMsg, o *app.OS) (*app.OS, tea.Cmd) {
 				focusedWindow.SelectionEnd.Y = terminalY
 			} else {
 				// Auto-scroll when dragging above or below the content area
-				borderOff := focusedWindow.BorderOffset()
-				contentTop := focusedWindow.Y + borderOff
-				contentBottom := focusedWindow.Y + borderOff + focusedWindow.ContentHeight()
+				contentTop := focusedWindow.Y + focusedWindow.ContentOffsetY()
+				contentBottom := contentTop + focusedWindow.ContentHeight()
 
 				if mouse.Y < contentTop {
 					// Dragging above  - enter copy mode and scroll up
@@ -126,7 +150,7 @@ func handleMouseMotion(msg tea.MouseMotionMsg, o *app.OS) (*app.OS, tea.Cmd) {
 
 	if !o.Dragging && !o.Resizing {
 		// Always consume motion events to prevent leaking to terminals
-		return o, nil
+		return o, hoverCmd
 	}
 
 	focusedWindow := o.GetFocusedWindow()
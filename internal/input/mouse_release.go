@@ -70,10 +70,10 @@ func handleMouseRelease(msg tea.MouseReleaseMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		focusedWindow := o.GetFocusedWindow()
 		if focusedWindow != nil && focusedWindow.IsSelecting {
 			// Extract selected text from terminal
-			selectedText := extractSelectedText(focusedWindow, o)
+			selectedText := o.ExtractSelectedText(focusedWindow)
 			if selectedText != "" {
 				focusedWindow.SelectedText = selectedText
-				o.ShowNotification(fmt.Sprintf("Selected %d chars - Press 'c' to copy", len(selectedText)), "success", config.NotificationDuration)
+				o.ShowNotification(selectedNotice(o, len(selectedText)), "success", config.NotificationDuration)
 			}
 			focusedWindow.IsSelecting = false
 			return o, nil
@@ -336,3 +336,14 @@ func handleMouseRelease(msg tea.MouseReleaseMsg, o *app.OS) (*app.OS, tea.Cmd) {
 
 	return o, nil
 }
+
+// selectedNotice reports a finished selection and the key bound to
+// copy_selection, when there is one.
+func selectedNotice(o *app.OS, chars int) string {
+	if o.KeybindRegistry != nil {
+		if keys := o.KeybindRegistry.GetKeysForDisplay("copy_selection"); keys != "" {
+			return fmt.Sprintf("Selected %d chars - press %s to copy", chars, keys)
+		}
+	}
+	return fmt.Sprintf("Selected %d chars", chars)
+}
@@ -3,6 +3,9 @@ package input
 import (
 	"testing"
 
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/app"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/terminal"
 	"github.com/Gaurav-Gosain/tuios/internal/vt"
 )
@@ -147,3 +150,91 @@ func TestIsInTerminalContent(t *testing.T) {
 		})
 	}
 }
+
+func TestMouseButtonAction(t *testing.T) {
+	original := config.MouseButtonMap
+	defer func() { config.MouseButtonMap = original }()
+
+	config.MouseButtonMap = config.DefaultMouseButtonMap()
+	if got := mouseButtonAction(tea.MouseLeft); got != config.MouseActionDrag {
+		t.Errorf("left = %q, want drag", got)
+	}
+	if got := mouseButtonAction(tea.MouseRight); got != config.MouseActionResize {
+		t.Errorf("right = %q, want resize", got)
+	}
+
+	config.MouseButtonMap["middle"] = config.MouseActionPaste
+	if got := mouseButtonAction(tea.MouseMiddle); got != config.MouseActionPaste {
+		t.Errorf("remapped middle = %q, want paste", got)
+	}
+	if got := mouseButtonAction(tea.MouseBackward); got != config.MouseActionNone {
+		t.Errorf("back = %q, want none", got)
+	}
+}
+
+// TestTitleBarRightClickFollowsButtonMap checks a right click on a title bar
+// opens the context menu only while the right button keeps its default
+// action, and otherwise does what mouse_buttons maps it to.
+func TestTitleBarRightClickFollowsButtonMap(t *testing.T) {
+	original := config.MouseButtonMap
+	defer func() { config.MouseButtonMap = original }()
+
+	for _, tt := range []struct {
+		action   string
+		wantMenu bool
+		wantDrag bool
+	}{
+		{config.MouseActionResize, true, false},
+		{config.MouseActionNone, false, false},
+		{config.MouseActionDrag, false, true},
+	} {
+		config.MouseButtonMap = config.DefaultMouseButtonMap()
+		config.MouseButtonMap["right"] = tt.action
+		o := app.NewOS(app.OSOptions{})
+		o.Width, o.Height = 100, 40
+		o.Windows = []*terminal.Window{{ID: "title-click-0001", X: 10, Y: 5, Width: 40, Height: 12, Workspace: o.CurrentWorkspace}}
+		o.FocusedWindow = 0
+
+		handleMouseClick(tea.MouseClickMsg{X: 20, Y: 5, Button: tea.MouseRight}, o)
+		if got := o.ContextMenu != nil; got != tt.wantMenu {
+			t.Errorf("right = %q: context menu open = %v, want %v", tt.action, got, tt.wantMenu)
+		}
+		if o.Dragging != tt.wantDrag {
+			t.Errorf("right = %q: dragging = %v, want %v", tt.action, o.Dragging, tt.wantDrag)
+		}
+		if o.Resizing {
+			t.Errorf("right = %q: a title-bar right click started a resize", tt.action)
+		}
+	}
+}
+
+// TestEmptyClickClearFocus checks that with empty_click_action = clear-focus a
+// left click on the desktop unfocuses the focused window and leaves terminal
+// mode, and that the default leaves focus alone.
+func TestEmptyClickClearFocus(t *testing.T) {
+	prev := config.EmptyClickAction
+	t.Cleanup(func() { config.EmptyClickAction = prev })
+
+	for _, tt := range []struct {
+		action    string
+		wantFocus int
+	}{
+		{config.EmptyClickNone, 0},
+		{config.EmptyClickClearFocus, -1},
+	} {
+		config.EmptyClickAction = tt.action
+		o := app.NewOS(app.OSOptions{})
+		o.Width, o.Height = 100, 40
+		o.Windows = []*terminal.Window{{ID: "empty-click-0001", X: 0, Y: 0, Width: 20, Height: 10, Workspace: o.CurrentWorkspace}}
+		o.FocusedWindow = 0
+		o.Mode = app.TerminalMode
+
+		handleMouseClick(tea.MouseClickMsg{X: 60, Y: 20, Button: tea.MouseLeft}, o)
+		if o.FocusedWindow != tt.wantFocus {
+			t.Errorf("%s: focused window = %d, want %d", tt.action, o.FocusedWindow, tt.wantFocus)
+		}
+		if tt.wantFocus == -1 && o.Mode != app.WindowManagementMode {
+			t.Errorf("%s: still in terminal mode after clearing focus", tt.action)
+		}
+	}
+}
@@ -138,7 +138,7 @@ func handleMouseWheel(msg tea.MouseWheelMsg, o *app.OS) (*app.OS, tea.Cmd) {
 						MoveDown(focusedWindow.CopyMode, focusedWindow)
 					}
 					// Exit copy mode if at bottom
-					if focusedWindow.CopyMode.ScrollOffset == 0 && focusedWindow.CopyMode.CursorY >= focusedWindow.Height-3 {
+					if focusedWindow.CopyMode.ScrollOffset == 0 && focusedWindow.CopyMode.CursorY >= focusedWindow.ContentHeight()-1 {
 						focusedWindow.ExitCopyMode()
 						o.ShowNotification("Copy Mode Exited", "info", config.NotificationDuration)
 					}
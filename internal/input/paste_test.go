@@ -0,0 +1,48 @@
+package input
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/app"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// pasteInto pastes text into a daemon window in terminal mode and returns
+// what reached its PTY.
+func pasteInto(t *testing.T, text string) string {
+	t.Helper()
+	ptyDataChan := make(chan struct{}, 8)
+	win := terminal.NewDaemonWindow("paste-window-01", "paste", 0, 0, 40, 10, 0, "pty-paste", ptyDataChan)
+	if win == nil {
+		t.Fatal("NewDaemonWindow returned nil")
+	}
+	t.Cleanup(func() { win.Close() })
+	var sent []byte
+	win.DaemonWriteFunc = func(b []byte) error {
+		sent = append(sent, b...)
+		return nil
+	}
+
+	o := &app.OS{Windows: []*terminal.Window{win}, FocusedWindow: 0, Mode: app.TerminalMode}
+	HandleInput(tea.PasteMsg{Content: text}, o)
+	return string(sent)
+}
+
+func TestPasteStripTrailingNewline(t *testing.T) {
+	defer func(v bool) { config.PasteStripTrailingNewline = v }(config.PasteStripTrailingNewline)
+
+	config.PasteStripTrailingNewline = false
+	if got := pasteInto(t, "ls -la\n"); got != "ls -la\n" {
+		t.Errorf("default paste = %q, want it unchanged", got)
+	}
+
+	config.PasteStripTrailingNewline = true
+	if got := pasteInto(t, "make\r\nmake test\r\n\n"); got != "make\r\nmake test" {
+		t.Errorf("stripped paste = %q, want only the trailing line endings removed", got)
+	}
+	if got := pasteInto(t, "\n\n"); got != "" {
+		t.Errorf("paste of only newlines sent %q, want nothing", got)
+	}
+}
@@ -1,6 +1,7 @@
 package input
 
 import (
+	"fmt"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
@@ -42,11 +43,28 @@ func (d *ActionDispatcher) registerPrefixHandlers() {
 	d.Register("prefix_split_vertical", handlePrefixSplitVertical)
 	d.Register("prefix_rotate_split", handlePrefixRotateSplit)
 	d.Register("prefix_equalize_splits", handlePrefixEqualizeSplits)
+	d.Register("prefix_equalize_focused", handleEqualizeFocused)
+	d.Register("prefix_retile", handlePrefixRetile)
+	d.Register("prefix_last_window", handlePrefixLastWindow)
+	d.Register("prefix_jump_window", handlePrefixJumpWindow)
+	d.Register("prefix_copy_cwd", handlePrefixCopyCwd)
+	d.Register("prefix_select_output", handlePrefixSelectOutput)
+	d.Register("prefix_multifocus", handleToggleMultifocus)
+	d.Register("prefix_multifocus_all", handleMultifocusWorkspace)
+	d.Register("prefix_sync_scroll", handlePrefixSyncScroll)
+	d.Register("prefix_link_scroll", handlePrefixLinkScroll)
+	d.Register("prefix_unlink_scroll", handlePrefixUnlinkScroll)
 	d.Register("prefix_selection", handlePrefixSelection)
 	d.Register("prefix_scrollback", handlePrefixScrollback)
 	d.Register("prefix_help", handlePrefixHelp)
 	d.Register("prefix_command_palette", handlePrefixCommandPalette)
 	d.Register("prefix_session_switcher", handlePrefixSessionSwitcher)
+	d.Register("prefix_display_panes", handlePrefixDisplayPanes)
+	d.Register("prefix_window_indices", handlePrefixWindowIndices)
+	d.Register("prefix_find", handlePrefixFind)
+	d.Register("prefix_peek", handlePrefixPeek)
+	d.Register("prefix_theme_next", handlePrefixThemeNext)
+	d.Register("prefix_theme_prev", handlePrefixThemePrev)
 	d.Register("prefix_detach", handlePrefixDetach)
 	d.Register("prefix_exit_mode", handlePrefixExitMode)
 	d.Register("prefix_quit", handlePrefixQuit)
@@ -59,13 +77,25 @@ func (d *ActionDispatcher) registerPrefixHandlers() {
 	d.Register("prefix_debug", makeSubPrefixHandler(func(o *app.OS) { o.DebugPrefixActive = true }))
 	d.Register("prefix_tape", makeSubPrefixHandler(func(o *app.OS) { o.TapePrefixActive = true }))
 	d.Register("prefix_layout", makeSubPrefixHandler(func(o *app.OS) { o.LayoutPrefixActive = true }))
+	d.Register("prefix_signal", makeSubPrefixHandler(func(o *app.OS) { o.SignalPrefixActive = true }))
 
 	// Window prefix (leader, t, ...)
 	d.Register("window_prefix_new", handlePrefixNewWindow)
 	d.Register("window_prefix_close", handlePrefixCloseWindow)
 	d.Register("window_prefix_rename", handleWindowPrefixRename)
+	d.Register("window_prefix_reload", handleWindowPrefixReload)
+	d.Register("window_prefix_update_rate", handleWindowPrefixUpdateRate)
 	d.Register("window_prefix_next", handlePrefixNextWindow)
 	d.Register("window_prefix_prev", handlePrefixPrevWindow)
+	d.Register("window_prefix_next_float", handleWindowPrefixNextFloating)
+	d.Register("window_prefix_next_tiled", handleWindowPrefixNextTiled)
+	d.Register("window_prefix_promote", handleWindowPrefixPromote)
+	d.Register("window_prefix_cycle_ratio", handleWindowPrefixCycleRatio)
+	d.Register("window_prefix_compare", handleWindowPrefixCompare)
+	d.Register("window_prefix_pager", handleWindowPrefixPager)
+	d.Register("window_prefix_editor", handleWindowPrefixEditor)
+	d.Register("window_prefix_header", handleWindowPrefixHeader)
+	d.Register("window_prefix_invert", handleWindowPrefixInvert)
 	d.Register("window_prefix_tiling", handleToggleTiling)
 	d.Register("window_prefix_cancel", handlePrefixCancel)
 
@@ -82,6 +112,9 @@ func (d *ActionDispatcher) registerPrefixHandlers() {
 		d.Register("workspace_prefix_switch_"+string(rune('0'+i)), makeSwitchWorkspaceHandler(i))
 		d.Register("workspace_prefix_move_"+string(rune('0'+i)), makeMoveAndFollowHandler(i))
 	}
+	d.Register("workspace_prefix_send", makeSubPrefixHandler(func(o *app.OS) { o.SendPrefixActive = true }))
+	d.Register("workspace_prefix_merge", makeSubPrefixHandler(func(o *app.OS) { o.MergePrefixActive = true }))
+	d.Register("workspace_prefix_peek", handleWorkspacePrefixPeek)
 	d.Register("workspace_prefix_cancel", handlePrefixCancel)
 
 	// Debug prefix (leader, D, ...)
@@ -89,6 +122,7 @@ func (d *ActionDispatcher) registerPrefixHandlers() {
 	d.Register("debug_prefix_cache", handleDebugCache)
 	d.Register("debug_prefix_showkeys", handleDebugShowkeys)
 	d.Register("debug_prefix_animations", handleDebugAnimations)
+	d.Register("debug_prefix_report", handleDebugReport)
 	d.Register("debug_prefix_cancel", handlePrefixCancel)
 
 	// Tape prefix (leader, T, ...)
@@ -102,6 +136,7 @@ func (d *ActionDispatcher) registerPrefixHandlers() {
 	d.Register("terminal_next_window", handleTerminalNextWindow)
 	d.Register("terminal_prev_window", handleTerminalPrevWindow)
 	d.Register("terminal_exit_mode", handleTerminalExitMode)
+	d.Register("terminal_passthrough", handleTerminalPassthrough)
 }
 
 // refreshFocusedWindow invalidates the focused window's render cache. Every
@@ -152,25 +187,8 @@ func handlePrefixCloseWindow(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	return o, nil
 }
 
-// startRename puts the focused window into rename mode. Renaming is a
-// window-management activity, so terminal mode is left first; the caller in
-// window-management mode is already there.
-func startRename(o *app.OS) {
-	if config.WindowTitlePosition == "hidden" || len(o.Windows) == 0 || o.FocusedWindow < 0 {
-		return
-	}
-	focused := o.GetFocusedWindow()
-	if focused == nil {
-		return
-	}
-	o.Mode = app.WindowManagementMode
-	o.RenamingWindow = true
-	o.RenameBuffer = focused.CustomName
-	focused.InvalidateCache()
-}
-
 func handlePrefixRenameWindow(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
-	startRename(o)
+	o.StartRename()
 	return o, nil
 }
 
@@ -178,13 +196,29 @@ func handlePrefixRenameWindow(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 // is up, matching the standalone rename_window binding.
 func handleWindowPrefixRename(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	if o.ShowCacheStats {
-		app.GetGlobalStyleCache().ResetStats()
+		o.ResetCacheStats()
 		o.ShowNotification("Cache statistics reset", "info", 2*time.Second)
 		return o, nil
 	}
 	return handlePrefixRenameWindow(msg, o)
 }
 
+func handleWindowPrefixReload(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	if err := o.ReloadFocusedWindow(); err != nil {
+		o.ShowNotification("Reload failed: "+err.Error(), "warning", config.NotificationDuration)
+		return o, nil
+	}
+	o.ShowNotification("Window reloaded", "info", config.NotificationDuration)
+	return o, nil
+}
+
+func handleWindowPrefixUpdateRate(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	if rate, ok := o.CycleFocusedUpdateRate(); ok {
+		o.ShowNotification("Background updates: "+rate, "info", config.NotificationDuration)
+	}
+	return o, nil
+}
+
 func handlePrefixSettings(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	o.OpenSettings()
 	return o, nil
@@ -206,6 +240,106 @@ func handlePrefixPrevWindow(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	return o, nil
 }
 
+func handleWindowPrefixNextFloating(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	if o.CycleToNextFloatingWindow() {
+		refreshFocusedWindow(o)
+	} else {
+		o.ShowNotification("No other floating window", "info", config.NotificationDuration)
+	}
+	return o, nil
+}
+
+func handleWindowPrefixNextTiled(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	if o.CycleToNextTiledWindow() {
+		refreshFocusedWindow(o)
+	} else {
+		o.ShowNotification("No other tiled window", "info", config.NotificationDuration)
+	}
+	return o, nil
+}
+
+func handleWindowPrefixPromote(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	if o.PromoteToMaster() {
+		refreshFocusedWindow(o)
+	} else {
+		o.ShowNotification("Promote needs a tiled window in master-stack", "info", config.NotificationDuration)
+	}
+	return o, nil
+}
+
+func handleWindowPrefixCycleRatio(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	if ratio, ok := o.CycleMasterRatio(); ok {
+		o.ShowNotification(fmt.Sprintf("Master ratio: %.0f%%", ratio*100), "info", config.NotificationDuration)
+	} else {
+		o.ShowNotification("Master ratio needs master-stack tiling", "info", config.NotificationDuration)
+	}
+	return o, nil
+}
+
+func handleWindowPrefixCompare(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	if o.StartCompare() {
+		o.ShowNotification("Compare: press the numbers of two windows", "info", config.NotificationDuration)
+	} else {
+		o.ShowNotification("Compare needs two windows on screen", "info", config.NotificationDuration)
+	}
+	return o, nil
+}
+
+func handleWindowPrefixPager(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	openScrollbackInPager(o, false)
+	return o, nil
+}
+
+func handleWindowPrefixEditor(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	openScrollbackInPager(o, true)
+	return o, nil
+}
+
+func handleWindowPrefixInvert(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.ToggleInvert()
+	return o, nil
+}
+
+// openScrollbackInPager opens the focused window's scrollback in a new window,
+// reporting why when it cannot.
+func openScrollbackInPager(o *app.OS, editor bool) {
+	if err := o.OpenScrollbackInPager(editor); err != nil {
+		o.ShowNotification("Scrollback: "+err.Error(), "error", config.NotificationDuration)
+	}
+}
+
+func handlePrefixSyncScroll(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.ToggleSyncScroll()
+	return o, nil
+}
+
+func handlePrefixLinkScroll(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.LinkScrollWithLastWindow()
+	return o, nil
+}
+
+func handlePrefixUnlinkScroll(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.UnlinkFocusedScroll()
+	return o, nil
+}
+
+func handlePrefixJumpWindow(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.StartWindowJump()
+	return o, nil
+}
+
+func handlePrefixLastWindow(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	if o.FocusLastWindow() {
+		refreshFocusedWindow(o)
+	}
+	return o, nil
+}
+
+// handlePrefixCopyCwd copies the focused window's working directory.
+func handlePrefixCopyCwd(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	return o, o.CopyFocusedCwd()
+}
+
 // makePrefixSelectHandler focuses the num-th window of the current workspace.
 // 0 selects the tenth, matching the tmux-style numbering where the row of digit
 // keys wraps around.
@@ -232,6 +366,16 @@ func makePrefixSelectHandler(num int) ActionHandler {
 	}
 }
 
+func handlePrefixDisplayPanes(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.ShowPaneNumbers()
+	return o, nil
+}
+
+func handlePrefixWindowIndices(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.ToggleWindowIndices()
+	return o, nil
+}
+
 func handlePrefixToggleTiling(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	o.ToggleAutoTiling()
 	return o, nil
@@ -280,6 +424,15 @@ func handlePrefixEqualizeSplits(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd)
 	return o, nil
 }
 
+func handlePrefixRetile(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	if o.RetileNow() {
+		o.ShowNotification("Layout Retiled", "success", config.NotificationDuration)
+	} else {
+		o.ShowNotification("Tiling is off", "warning", config.NotificationDuration)
+	}
+	return o, nil
+}
+
 func handlePrefixSelection(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	if focused := o.GetFocusedWindow(); focused != nil {
 		focused.EnterCopyMode()
@@ -288,6 +441,59 @@ func handlePrefixSelection(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	return o, nil
 }
 
+// noOutputMessage explains why there is nothing to select when a shell does
+// not mark its prompts.
+const noOutputMessage = "No command output found (needs shell integration, OSC 133)"
+
+// handlePrefixSelectOutput enters copy mode with the last command's output
+// selected, ready for y.
+func handlePrefixSelectOutput(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	focused := o.GetFocusedWindow()
+	if focused == nil || focused.Terminal == nil {
+		return o, nil
+	}
+	focused.EnterCopyMode()
+	focused.RLockIO()
+	ok := selectLastOutput(focused.CopyMode, focused)
+	focused.RUnlockIO()
+	if !ok {
+		focused.ExitCopyMode()
+		o.ShowNotification(noOutputMessage, "warning", config.NotificationDuration)
+		return o, nil
+	}
+	focused.InvalidateCache()
+	o.ShowNotification("VISUAL LINE (last output): y to copy", "info", 2*config.NotificationDuration)
+	return o, nil
+}
+
+func handlePrefixFind(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	if focused := o.GetFocusedWindow(); focused != nil {
+		focused.EnterQuickFind()
+	}
+	return o, nil
+}
+
+func handlePrefixPeek(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	focused := o.GetFocusedWindow()
+	if focused == nil || (focused.CopyMode != nil && focused.CopyMode.Active) {
+		return o, nil
+	}
+	if !focused.PeekScrollback() {
+		o.ShowNotification("No scrollback to peek at", "info", config.NotificationDuration)
+	}
+	return o, nil
+}
+
+func handlePrefixThemeNext(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.CycleTheme(1)
+	return o, nil
+}
+
+func handlePrefixThemePrev(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.CycleTheme(-1)
+	return o, nil
+}
+
 func handlePrefixScrollback(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	OpenScrollbackBrowser(o)
 	return o, nil
@@ -332,8 +538,10 @@ func handlePrefixDetach(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 		return m, cmd
 	}
 	// Outside a daemon session there is nothing to detach from, so the closest
-	// useful thing is to step back out to window-management mode.
+	// useful thing is to step back out to window-management mode, saying why
+	// the client is still here.
 	leaveTerminalMode(o)
+	o.ShowNotification("Nothing to detach from: start with 'tuios new' for a session that keeps running", "info", config.NotificationDuration)
 	return o, nil
 }
 
@@ -416,6 +624,11 @@ func handleDebugShowkeys(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	return o, nil
 }
 
+func handleDebugReport(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.SaveBugReport()
+	return o, nil
+}
+
 func handleDebugAnimations(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	config.AnimationsEnabled = !config.AnimationsEnabled
 	toggleNotify(o, "Animations", config.AnimationsEnabled)
@@ -483,3 +696,17 @@ func handleTerminalExitMode(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	leaveTerminalMode(o)
 	return o, nil
 }
+
+// handleTerminalPassthrough toggles prefix passthrough: while it is on, the
+// leader key goes to the focused pane, so a tmux running inside it gets its
+// own prefix. Window management mode keeps the prefix either way.
+func handleTerminalPassthrough(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.PrefixPassthrough = !o.PrefixPassthrough
+	o.PrefixActive = false
+	if o.PrefixPassthrough {
+		o.ShowNotification(fmt.Sprintf("Passthrough on: %s goes to the pane", config.LeaderKey), "info", config.NotificationDuration)
+	} else {
+		o.ShowNotification(fmt.Sprintf("Passthrough off: %s is the prefix again", config.LeaderKey), "info", config.NotificationDuration)
+	}
+	return o, nil
+}
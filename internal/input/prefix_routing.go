@@ -6,7 +6,6 @@ import (
 	tea "charm.land/bubbletea/v2"
 	"github.com/Gaurav-Gosain/tuios/internal/app"
 	"github.com/Gaurav-Gosain/tuios/internal/config"
-	"github.com/Gaurav-Gosain/tuios/internal/vt"
 )
 
 // dispatchAction runs the handler for action, if there is one. The third result
@@ -203,9 +202,7 @@ func forwardKeyToFocusedWindow(msg tea.KeyPressMsg, o *app.OS) {
 
 	var rawInput []byte
 	if focused.Terminal != nil && focused.Terminal.KittyKeyboardFlags() != 0 {
-		if encoded := vt.EncodeKeyCSIu(vtKeyFromBubbletea(msg), focused.Terminal.KittyKeyboardFlags()); encoded != "" {
-			rawInput = []byte(encoded)
-		}
+		rawInput = kittyKeyBytes(msg, focused.Terminal.KittyKeyboardFlags())
 	}
 	if len(rawInput) == 0 {
 		appCursorKeys := false
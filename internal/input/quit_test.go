@@ -1,8 +1,10 @@
 package input
 
 import (
+	"strings"
 	"testing"
 
+	tea "charm.land/bubbletea/v2"
 	"github.com/Gaurav-Gosain/tuios/internal/app"
 	"github.com/Gaurav-Gosain/tuios/internal/config"
 )
@@ -11,8 +13,8 @@ import (
 // quit keybindings used to each carry their own copy of: put the dialog up when
 // a window is running something, quit outright when nothing is.
 func TestRequestQuitConfirmsOnlyWhenThereIsSomethingToLose(t *testing.T) {
-	prev := config.AlwaysConfirmQuit
-	t.Cleanup(func() { config.AlwaysConfirmQuit = prev })
+	prev, prevBusy := config.AlwaysConfirmQuit, config.ConfirmQuitIfBusy
+	t.Cleanup(func() { config.AlwaysConfirmQuit, config.ConfirmQuitIfBusy = prev, prevBusy })
 
 	t.Run("nothing running quits outright", func(t *testing.T) {
 		config.AlwaysConfirmQuit = false
@@ -42,6 +44,16 @@ func TestRequestQuitConfirmsOnlyWhenThereIsSomethingToLose(t *testing.T) {
 			t.Fatalf("dialog selection = %d, want 0 (Yes)", m.QuitConfirmSelection)
 		}
 	})
+
+	t.Run("confirm-always holds with the busy check off", func(t *testing.T) {
+		config.AlwaysConfirmQuit = true
+		config.ConfirmQuitIfBusy = false
+		o := app.NewOS(app.OSOptions{})
+
+		if m, _ := requestQuit(o); !m.ShowQuitConfirm {
+			t.Fatal("confirm_quit ignored when confirm_quit_if_busy is off")
+		}
+	})
 }
 
 // TestDetachOutsideADaemonSessionIsNotADetach covers the branch every caller of
@@ -60,3 +72,27 @@ func TestDetachOutsideADaemonSessionIsNotADetach(t *testing.T) {
 		t.Fatal("returned a quit command outside a daemon session")
 	}
 }
+
+// TestPrefixDetachOutsideADaemonSessionSaysWhy checks that leader d in a plain
+// local session, which cannot detach, says so instead of only switching mode.
+func TestPrefixDetachOutsideADaemonSessionSaysWhy(t *testing.T) {
+	o := app.NewOS(app.OSOptions{})
+	o.Mode = app.TerminalMode
+
+	_, cmd := handlePrefixDetach(tea.KeyPressMsg{}, o)
+	if cmd != nil {
+		t.Fatal("returned a command outside a daemon session")
+	}
+	if o.Mode != app.WindowManagementMode {
+		t.Errorf("mode = %v, want window management", o.Mode)
+	}
+	found := false
+	for _, n := range o.Notifications {
+		if strings.Contains(n.Message, "Nothing to detach from") {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("no notification explained why nothing was detached")
+	}
+}
@@ -0,0 +1,41 @@
+package input
+
+import (
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/app"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// repeatLastAction is the action that replays app.OS.LastAction, like vim's
+// "." for the window manager.
+const repeatLastAction = "repeat_last_action"
+
+// repeatablePrefixes name the actions repeat_last_action may replay: ones
+// that change the layout by a step, where pressing the key again means "do
+// that once more". Focus movement, mode switches and one-shot toggles are left
+// out, so moving around between repeats does not replace what gets repeated.
+var repeatablePrefixes = []string{
+	"snap_", "unsnap", "swap_", "resize_",
+	"split_", "smart_split", "rotate_split", "equalize_",
+	"scroll_move_", "scroll_cycle_width", "scroll_consume", "scroll_expel",
+	"prefix_split_", "prefix_rotate_split", "prefix_equalize_",
+}
+
+// isRepeatable reports whether action is recorded as the last action.
+func isRepeatable(action string) bool {
+	for _, p := range repeatablePrefixes {
+		if strings.HasPrefix(action, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// handleRepeatLastAction only runs when there is nothing to repeat: Dispatch
+// swaps repeat_last_action for the recorded action before looking it up.
+func handleRepeatLastAction(_ tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.ShowNotification("Nothing to repeat yet", "info", config.NotificationDuration)
+	return o, nil
+}
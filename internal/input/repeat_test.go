@@ -0,0 +1,45 @@
+package input
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// TestRepeatLastAction checks a resize step is recorded and replayed, that
+// moving focus in between does not replace it, and that repeating with
+// nothing recorded is harmless.
+func TestRepeatLastAction(t *testing.T) {
+	m := isoOS(t, 2)
+	m.TileAllWindows()
+	d := GetDispatcher()
+	var msg tea.KeyPressMsg
+
+	d.Dispatch(repeatLastAction, msg, m)
+	if m.LastAction != "" {
+		t.Fatalf("repeating with nothing recorded set LastAction to %q", m.LastAction)
+	}
+
+	first := m.Windows[0]
+	width := first.Width
+	d.Dispatch("resize_master_grow", msg, m)
+	if m.LastAction != "resize_master_grow" {
+		t.Fatalf("LastAction %q after a resize step, want resize_master_grow", m.LastAction)
+	}
+	step := first.Width - width
+	if step <= 0 {
+		t.Fatalf("resize step did not grow the first pane (width %d -> %d)", width, first.Width)
+	}
+
+	d.Dispatch("nav_right", msg, m)
+	d.Dispatch("nav_left", msg, m)
+	if m.LastAction != "resize_master_grow" {
+		t.Errorf("navigation replaced LastAction with %q", m.LastAction)
+	}
+
+	m.FocusedWindow = 0
+	d.Dispatch(repeatLastAction, msg, m)
+	if got, want := first.Width, width+2*step; got != want {
+		t.Errorf("first pane width %d after repeating, want %d", got, want)
+	}
+}
@@ -0,0 +1,59 @@
+package input
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/app"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestScrollPeek checks that leader u scrolls the focused window up a page
+// without copy mode, that repeating the chord goes further back, and that any
+// other key drops the window back to live output.
+func TestScrollPeek(t *testing.T) {
+	win := newCopyModeWindow(t, "scrollpeek-0001")
+	win.ExitCopyMode()
+	var out strings.Builder
+	for i := range 100 {
+		fmt.Fprintf(&out, "line %d\r\n", i)
+	}
+	win.WriteOutput([]byte(out.String()))
+
+	o := &app.OS{
+		Mode:            app.TerminalMode,
+		Windows:         []*terminal.Window{win},
+		FocusedWindow:   0,
+		KeybindRegistry: config.NewKeybindRegistry(config.DefaultConfig()),
+	}
+
+	handlePrefixPeek(key("u"), o)
+	page := win.ScrollbackOffset
+	if page == 0 || !win.ScrollbackMode {
+		t.Fatalf("peek did not scroll up (offset=%d, mode=%v)", page, win.ScrollbackMode)
+	}
+	if win.CopyMode != nil && win.CopyMode.Active {
+		t.Fatal("peek entered copy mode")
+	}
+
+	// The leader and the peek chord keep the peek so it can be repeated.
+	endScrollPeek(tea.KeyPressMsg{Code: 'b', Mod: tea.ModCtrl}, o)
+	o.PrefixActive = true
+	endScrollPeek(key("u"), o)
+	if !win.ScrollbackMode {
+		t.Fatal("the peek chord ended the peek")
+	}
+	handlePrefixPeek(key("u"), o)
+	if win.ScrollbackOffset <= page {
+		t.Errorf("second peek offset %d, want more than %d", win.ScrollbackOffset, page)
+	}
+
+	o.PrefixActive = false
+	endScrollPeek(key("x"), o)
+	if win.ScrollbackMode || win.ScrollbackOffset != 0 {
+		t.Errorf("a key did not return to live output (offset=%d, mode=%v)", win.ScrollbackOffset, win.ScrollbackMode)
+	}
+}
@@ -0,0 +1,114 @@
+package input
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/app"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestSyncScrollFollowsFocusedWindow checks that in review mode a wheel scroll
+// of the focused window moves the other visible window by the same amount,
+// and that turning the mode off returns it to live output.
+func TestSyncScrollFollowsFocusedWindow(t *testing.T) {
+	var out strings.Builder
+	for i := range 100 {
+		fmt.Fprintf(&out, "line %d\r\n", i)
+	}
+	focused := newCopyModeWindow(t, "syncscroll-0001")
+	follower := newCopyModeWindow(t, "syncscroll-0002")
+	for _, w := range []*terminal.Window{focused, follower} {
+		w.ExitCopyMode()
+		w.WriteOutput([]byte(out.String()))
+	}
+
+	o := &app.OS{
+		Mode:            app.TerminalMode,
+		SelectionMode:   true,
+		Windows:         []*terminal.Window{focused, follower},
+		FocusedWindow:   0,
+		KeybindRegistry: config.NewKeybindRegistry(config.DefaultConfig()),
+	}
+	o.ToggleSyncScroll()
+
+	HandleInput(tea.MouseWheelMsg{Button: tea.MouseWheelUp}, o)
+	if focused.ScrollbackOffset == 0 {
+		t.Fatal("the wheel did not scroll the focused window")
+	}
+	if follower.ScrollbackOffset != focused.ScrollbackOffset {
+		t.Errorf("follower offset = %d, want %d", follower.ScrollbackOffset, focused.ScrollbackOffset)
+	}
+
+	HandleInput(tea.MouseWheelMsg{Button: tea.MouseWheelDown}, o)
+	if follower.ScrollbackOffset != focused.ScrollbackOffset {
+		t.Errorf("after scrolling back down follower offset = %d, want %d", follower.ScrollbackOffset, focused.ScrollbackOffset)
+	}
+
+	HandleInput(tea.MouseWheelMsg{Button: tea.MouseWheelUp}, o)
+	o.ToggleSyncScroll()
+	if follower.ScrollbackOffset != 0 || follower.ScrollbackMode {
+		t.Errorf("turning sync scroll off left the follower at offset %d", follower.ScrollbackOffset)
+	}
+}
+
+// TestLinkedScroll checks two linked windows follow each other whichever has
+// focus, that a third window is left alone, and that unlinking returns the
+// partner to live output.
+func TestLinkedScroll(t *testing.T) {
+	var out strings.Builder
+	for i := range 100 {
+		fmt.Fprintf(&out, "line %d\r\n", i)
+	}
+	a := newCopyModeWindow(t, "linkscroll-0001")
+	b := newCopyModeWindow(t, "linkscroll-0002")
+	other := newCopyModeWindow(t, "linkscroll-0003")
+	for _, w := range []*terminal.Window{a, b, other} {
+		w.ExitCopyMode()
+		w.WriteOutput([]byte(out.String()))
+	}
+
+	o := &app.OS{
+		Mode:            app.TerminalMode,
+		SelectionMode:   true,
+		Windows:         []*terminal.Window{a, b, other},
+		FocusedWindow:   0,
+		KeybindRegistry: config.NewKeybindRegistry(config.DefaultConfig()),
+	}
+	if o.LinkScroll(a.ID, a.ID) {
+		t.Error("a window was linked with itself")
+	}
+	if !o.LinkScroll(a.ID, b.ID) {
+		t.Fatal("LinkScroll refused two existing windows")
+	}
+
+	HandleInput(tea.MouseWheelMsg{Button: tea.MouseWheelUp}, o)
+	if a.ScrollbackOffset == 0 {
+		t.Fatal("the wheel did not scroll the focused window")
+	}
+	if b.ScrollbackOffset != a.ScrollbackOffset {
+		t.Errorf("linked window offset = %d, want %d", b.ScrollbackOffset, a.ScrollbackOffset)
+	}
+	if other.ScrollbackOffset != 0 {
+		t.Errorf("unlinked window scrolled to %d", other.ScrollbackOffset)
+	}
+
+	o.FocusedWindow = 1
+	HandleInput(tea.MouseWheelMsg{Button: tea.MouseWheelUp}, o)
+	if a.ScrollbackOffset != b.ScrollbackOffset {
+		t.Errorf("scrolling the partner left the first window at %d, want %d", a.ScrollbackOffset, b.ScrollbackOffset)
+	}
+
+	if !o.UnlinkScroll(b.ID) {
+		t.Fatal("UnlinkScroll found no link")
+	}
+	if a.ScrollbackOffset != 0 || a.ScrollbackMode {
+		t.Errorf("unlinking left the partner at offset %d", a.ScrollbackOffset)
+	}
+	if o.ScrollLinks != nil {
+		t.Errorf("links left after unlinking the only pair: %v", o.ScrollLinks)
+	}
+}
@@ -39,3 +39,20 @@ func handleThemePickerInput(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
 	}
 	return o, nil
 }
+
+// handleThemeCycleInput handles keyboard input while cycling themes. Every step
+// previews the next theme; Enter keeps it, Esc restores the original. Other keys
+// are swallowed so they do not reach the shell mid-preview.
+func handleThemeCycleInput(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	switch msg.String() {
+	case "right", "l", "n", ">", "tab":
+		o.CycleTheme(1)
+	case "left", "h", "p", "<", "shift+tab":
+		o.CycleTheme(-1)
+	case "enter":
+		o.ConfirmThemeCycle()
+	case "esc", "q":
+		o.CancelThemeCycle()
+	}
+	return o, nil
+}
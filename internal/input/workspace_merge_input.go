@@ -0,0 +1,50 @@
+package input
+
+import (
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/app"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// handleMergePrefix handles the key after Ctrl+B, w, M: a digit picks the
+// workspace the current one is merged into and opens the confirmation. Any
+// other key cancels.
+func handleMergePrefix(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.MergePrefixActive = false
+	o.PrefixActive = false
+
+	key := msg.String()
+	if len(key) != 1 || key[0] < '1' || key[0] > '9' {
+		return o, nil
+	}
+	target := int(key[0] - '0')
+	switch {
+	case target > o.NumWorkspaces:
+		return o, nil
+	case target == o.CurrentWorkspace:
+		o.ShowNotification(fmt.Sprintf("Already on workspace %d", target), "info", config.NotificationDuration)
+		return o, nil
+	case o.GetWorkspaceWindowCount(o.CurrentWorkspace) == 0:
+		o.ShowNotification("Nothing to merge: workspace is empty", "info", config.NotificationDuration)
+		return o, nil
+	}
+	o.MergeConfirmTarget = target
+	return o, nil
+}
+
+// handleMergeConfirm handles input while the merge confirmation is shown.
+func handleMergeConfirm(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y", "enter":
+		src, dst := o.CurrentWorkspace, o.MergeConfirmTarget
+		o.MergeConfirmTarget = 0
+		n := o.MergeWorkspace(src, dst)
+		o.ShowNotification(fmt.Sprintf("Merged %d window(s) into workspace %d", n, dst), "success", config.NotificationDuration)
+	case "n", "N", "esc":
+		o.MergeConfirmTarget = 0
+	}
+	// Ignore all other keys while confirming
+	return o, nil
+}
@@ -0,0 +1,38 @@
+package input
+
+import (
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/app"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// handleWorkspacePrefixPeek handles Ctrl+B, w, p: with a peek open it closes
+// it, otherwise it waits for the digit of the workspace to peek at.
+func handleWorkspacePrefixPeek(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	if o.PeekedWorkspace != 0 {
+		o.ClosePeek()
+		return o, nil
+	}
+	return makeSubPrefixHandler(func(o *app.OS) { o.PeekPrefixActive = true })(msg, o)
+}
+
+// handlePeekPrefix handles the key after Ctrl+B, w, p: a digit shows that
+// workspace beside the current one. Any other key cancels.
+func handlePeekPrefix(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.PeekPrefixActive = false
+	o.PrefixActive = false
+
+	key := msg.String()
+	if len(key) != 1 || key[0] < '1' || key[0] > '9' {
+		return o, nil
+	}
+	target := int(key[0] - '0')
+	if target == o.CurrentWorkspace {
+		o.ShowNotification(fmt.Sprintf("Already on workspace %d", target), "info", config.NotificationDuration)
+		return o, nil
+	}
+	o.PeekWorkspace(target)
+	return o, nil
+}
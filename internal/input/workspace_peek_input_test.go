@@ -0,0 +1,44 @@
+package input
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/app"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestPeekPrefixTogglesThePeek checks Ctrl+B, w, p, digit opens a peek without
+// switching workspace, and Ctrl+B, w, p closes it again.
+func TestPeekPrefixTogglesThePeek(t *testing.T) {
+	o := &app.OS{
+		NumWorkspaces:    9,
+		CurrentWorkspace: 1,
+		FocusedWindow:    0,
+		WorkspaceFocus:   map[int]int{},
+		Windows: []*terminal.Window{
+			{ID: "peek-win-a", Workspace: 1},
+			{ID: "peek-win-b", Workspace: 2},
+		},
+	}
+
+	handleWorkspacePrefixPeek(tea.KeyPressMsg{Code: 'p', Text: "p"}, o)
+	if !o.PeekPrefixActive {
+		t.Fatal("Ctrl+B, w, p should wait for a workspace digit")
+	}
+	handlePeekPrefix(tea.KeyPressMsg{Code: '2', Text: "2"}, o)
+	if o.PeekPrefixActive || o.PrefixActive {
+		t.Error("peek prefix still active after picking a workspace")
+	}
+	if o.PeekedWorkspace != 2 {
+		t.Errorf("peeked workspace = %d, want 2", o.PeekedWorkspace)
+	}
+	if o.CurrentWorkspace != 1 {
+		t.Errorf("peeking switched to workspace %d", o.CurrentWorkspace)
+	}
+
+	handleWorkspacePrefixPeek(tea.KeyPressMsg{Code: 'p', Text: "p"}, o)
+	if o.PeekedWorkspace != 0 || o.PeekPrefixActive {
+		t.Error("Ctrl+B, w, p with a peek open should close it")
+	}
+}
@@ -0,0 +1,33 @@
+package input
+
+import (
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/app"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// handleSendPrefix handles the key after Ctrl+B, w, s: a digit moves the
+// focused window to that workspace without following it, so the view stays
+// on the current workspace. Any other key cancels.
+func handleSendPrefix(msg tea.KeyPressMsg, o *app.OS) (*app.OS, tea.Cmd) {
+	o.SendPrefixActive = false
+	o.PrefixActive = false
+
+	key := msg.String()
+	if len(key) != 1 || key[0] < '1' || key[0] > '9' {
+		return o, nil
+	}
+	target := int(key[0] - '0')
+	if target > o.NumWorkspaces || o.GetFocusedWindow() == nil {
+		return o, nil
+	}
+	if target == o.CurrentWorkspace {
+		o.ShowNotification(fmt.Sprintf("Already on workspace %d", target), "info", config.NotificationDuration)
+		return o, nil
+	}
+	o.MoveWindowToWorkspace(o.FocusedWindow, target)
+	o.ShowNotification(fmt.Sprintf("Sent window to workspace %d", target), "info", config.NotificationDuration)
+	return o, nil
+}
@@ -0,0 +1,43 @@
+package input
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/app"
+	"github.com/Gaurav-Gosain/tuios/internal/terminal"
+)
+
+// TestSendPrefixMovesWithoutFollowing checks that Ctrl+B, w, s, digit moves
+// the focused window away while the view stays on the current workspace.
+func TestSendPrefixMovesWithoutFollowing(t *testing.T) {
+	o := &app.OS{
+		NumWorkspaces:    9,
+		CurrentWorkspace: 1,
+		FocusedWindow:    0,
+		WorkspaceFocus:   map[int]int{},
+		SendPrefixActive: true,
+		PrefixActive:     true,
+		Windows: []*terminal.Window{
+			{ID: "send-win-a", Workspace: 1},
+			{ID: "send-win-b", Workspace: 1},
+		},
+	}
+
+	handleSendPrefix(tea.KeyPressMsg{Code: '3', Text: "3"}, o)
+	if o.SendPrefixActive || o.PrefixActive {
+		t.Error("send prefix still active after picking a workspace")
+	}
+	if o.Windows[0].Workspace != 3 {
+		t.Errorf("window on workspace %d, want 3", o.Windows[0].Workspace)
+	}
+	if o.CurrentWorkspace != 1 {
+		t.Errorf("view followed the window to workspace %d", o.CurrentWorkspace)
+	}
+
+	o.SendPrefixActive = true
+	handleSendPrefix(tea.KeyPressMsg{Code: 'x', Text: "x"}, o)
+	if o.SendPrefixActive || o.Windows[1].Workspace != 1 {
+		t.Error("a non-digit should cancel without moving anything")
+	}
+}
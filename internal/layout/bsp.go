@@ -40,7 +40,9 @@ func (s SplitType) String() string {
 type AutoScheme int
 
 const (
-	// SchemeLongestSide splits along the longest dimension of the target area
+	// SchemeLongestSide splits the target pane across its longer side, measured
+	// on the pane's own rect at insert time: wide panes split side by side, tall
+	// panes stack.
 	SchemeLongestSide AutoScheme = iota
 	// SchemeAlternate alternates between vertical and horizontal splits
 	SchemeAlternate
@@ -224,7 +226,8 @@ func (t *BSPTree) FindNode(windowID int) *TileNode {
 
 // InsertWindow adds a new window to the tree by splitting the focused window.
 // If direction is SplitNone, uses the auto scheme to determine split direction.
-// The new window is inserted as the right/bottom child.
+// The new window is inserted as the right/bottom child, so ratio is the share
+// the focused window keeps.
 func (t *BSPTree) InsertWindow(windowID int, focusedWindowID int, direction SplitType, ratio float64, bounds Rect) {
 	// Don't insert duplicates
 	if t.HasWindow(windowID) {
@@ -289,7 +292,9 @@ func (t *BSPTree) InsertWindow(windowID int, focusedWindowID int, direction Spli
 
 // InsertWindowWithPreselection adds a new window using preselection direction.
 // Preselection determines which side of the focused window to place the new window.
-func (t *BSPTree) InsertWindowWithPreselection(windowID int, focusedWindowID int, preselect PreselectionDir, bounds Rect) {
+// As with InsertWindow, ratio is the share the focused window keeps; a value
+// outside (0, 1) uses the tree's default.
+func (t *BSPTree) InsertWindowWithPreselection(windowID int, focusedWindowID int, preselect PreselectionDir, ratio float64, bounds Rect) {
 	var direction SplitType
 	var newWindowIsLeft bool
 
@@ -308,10 +313,14 @@ func (t *BSPTree) InsertWindowWithPreselection(windowID int, focusedWindowID int
 		newWindowIsLeft = false
 	default:
 		// No preselection, use normal insert
-		t.InsertWindow(windowID, focusedWindowID, SplitNone, t.DefaultRatio, bounds)
+		t.InsertWindow(windowID, focusedWindowID, SplitNone, ratio, bounds)
 		return
 	}
 
+	if ratio <= 0 || ratio >= 1 {
+		ratio = t.DefaultRatio
+	}
+
 	// Don't insert duplicates
 	if t.HasWindow(windowID) {
 		return
@@ -341,9 +350,9 @@ func (t *BSPTree) InsertWindowWithPreselection(windowID int, focusedWindowID int
 	oldLeaf := NewLeafNode(targetNode.WindowID)
 	var internalNode *TileNode
 	if newWindowIsLeft {
-		internalNode = NewInternalNode(direction, t.DefaultRatio, newLeaf, oldLeaf)
+		internalNode = NewInternalNode(direction, 1-ratio, newLeaf, oldLeaf)
 	} else {
-		internalNode = NewInternalNode(direction, t.DefaultRatio, oldLeaf, newLeaf)
+		internalNode = NewInternalNode(direction, ratio, oldLeaf, newLeaf)
 	}
 
 	// Replace target in tree
@@ -451,6 +460,16 @@ func (t *BSPTree) applyLayoutRecursive(node *TileNode, bounds Rect, result map[i
 	t.applyLayoutRecursive(node.Right, rightBounds, result)
 }
 
+// separatorGap is the number of cells left between two sibling panes: the one
+// cell shared borders draw their separator in, otherwise the configured tiling
+// gap.
+func separatorGap() int {
+	if config.SharedBorders {
+		return 1
+	}
+	return config.TilingGap
+}
+
 // childBounds divides an internal node's rectangle between its two children.
 // It is the single definition of the split model: every other part of the
 // layout that needs to know where a divider sits, or what rectangle a node was
@@ -473,18 +492,15 @@ func childBounds(node *TileNode, bounds Rect) (leftBounds, rightBounds Rect) {
 		return leftBounds, rightBounds
 	}
 
-	// Shared borders reserve one cell between the two children for the drawn
-	// separator, so the far child starts one past the divider line.
-	gap := 0
-	if config.SharedBorders {
-		gap = 1
-	}
+	// The children are kept apart by the separator gap (see separatorGap), so
+	// the far child starts that many cells past the divider line.
+	gap := separatorGap()
 
 	if node.SplitType == SplitVertical {
 		splitX := bounds.X + int(float64(bounds.W)*node.SplitRatio)
 		if gap > 0 {
-			// Keep the separator cell inside the node's own rectangle.
-			splitX = max(bounds.X+1, min(splitX, bounds.X+bounds.W-2))
+			// Keep the gap inside the node's own rectangle.
+			splitX = max(bounds.X+1, min(splitX, bounds.X+bounds.W-1-gap))
 		}
 		leftBounds = Rect{X: bounds.X, Y: bounds.Y, W: splitX - bounds.X, H: bounds.H}
 		rightBounds = Rect{X: splitX + gap, Y: bounds.Y, W: bounds.X + bounds.W - splitX - gap, H: bounds.H}
@@ -493,7 +509,7 @@ func childBounds(node *TileNode, bounds Rect) (leftBounds, rightBounds Rect) {
 
 	splitY := bounds.Y + int(float64(bounds.H)*node.SplitRatio)
 	if gap > 0 {
-		splitY = max(bounds.Y+1, min(splitY, bounds.Y+bounds.H-2))
+		splitY = max(bounds.Y+1, min(splitY, bounds.Y+bounds.H-1-gap))
 	}
 	leftBounds = Rect{X: bounds.X, Y: bounds.Y, W: bounds.W, H: splitY - bounds.Y}
 	rightBounds = Rect{X: bounds.X, Y: splitY + gap, W: bounds.W, H: bounds.Y + bounds.H - splitY - gap}
@@ -563,13 +579,10 @@ func (t *BSPTree) ResizeSplit(windowID int, e ResizeEdge, pos int, bounds Rect)
 		return false
 	}
 
-	gap := 0
-	if config.SharedBorders {
-		gap = 1
-	}
+	gap := separatorGap()
 
 	// The near child's far edge is the divider line itself; the far child's near
-	// edge sits one separator cell past it.
+	// edge sits the separator gap past it.
 	line := pos
 	if !e.far() {
 		line -= gap
@@ -629,10 +642,7 @@ func minExtent(node *TileNode, vertical bool) int {
 		return max(left, right)
 	}
 
-	gap := 0
-	if config.SharedBorders {
-		gap = 1
-	}
+	gap := separatorGap()
 	return left + right + gap
 }
 
@@ -684,15 +694,12 @@ func (t *BSPTree) syncRatiosRecursive(node *TileNode, bounds Rect, windows map[i
 		return
 	}
 
-	// Shared-border layouts reserve one cell for the separator, so the near edge
-	// of the right/bottom subtree sits one cell past the divider. Sync has to use
-	// the same model applyLayoutRecursive does; otherwise every ratio in the tree
+	// Layouts with a separator gap start the right/bottom subtree that many
+	// cells past the divider. Sync has to use the same model
+	// applyLayoutRecursive does; otherwise every ratio in the tree
 	// is re-derived one cell off on each sync, and a resize on one axis walks the
 	// dividers on the other axis. Nested bounds must account for it too.
-	gap := 0
-	if config.SharedBorders {
-		gap = 1
-	}
+	gap := separatorGap()
 
 	if node.SplitType == SplitStacked {
 		// Stacked nodes ignore SplitRatio; mirror applyLayoutRecursive's bounds so
@@ -816,8 +823,15 @@ func (t *BSPTree) findAnyWindowInSubtree(node *TileNode) int {
 func (t *BSPTree) determineAutoSplit(targetNode *TileNode, bounds Rect) SplitType {
 	switch t.AutoScheme {
 	case SchemeLongestSide:
-		// Split along the longest dimension
-		if bounds.W >= bounds.H {
+		// Measure the target pane itself, not the whole screen, so each new
+		// window halves whichever side of its pane is longer. Cells are about
+		// twice as tall as they are wide, so a pane counts as wide once its
+		// width is at least twice its height.
+		r, ok := t.nodeBounds(targetNode, bounds)
+		if !ok {
+			r = bounds
+		}
+		if r.W >= r.H*2 {
 			return SplitVertical
 		}
 		return SplitHorizontal
@@ -919,6 +933,31 @@ func (t *BSPTree) RotateSplit(windowID int) {
 	}
 }
 
+// FlipHorizontal mirrors the whole layout left to right: every side-by-side
+// split swaps its children and takes the complementary ratio, so each pane
+// keeps its size but moves to the other side. Stacked splits are left alone.
+func (t *BSPTree) FlipHorizontal() {
+	mirrorSplits(t.Root, SplitVertical)
+}
+
+// FlipVertical mirrors the whole layout top to bottom, as FlipHorizontal does
+// for the stacked (top/bottom) splits.
+func (t *BSPTree) FlipVertical() {
+	mirrorSplits(t.Root, SplitHorizontal)
+}
+
+func mirrorSplits(node *TileNode, split SplitType) {
+	if node == nil || node.IsLeaf() {
+		return
+	}
+	if node.SplitType == split {
+		node.Left, node.Right = node.Right, node.Left
+		node.SplitRatio = 1 - node.SplitRatio
+	}
+	mirrorSplits(node.Left, split)
+	mirrorSplits(node.Right, split)
+}
+
 // SwapWindows swaps the positions of two windows in the tree
 func (t *BSPTree) SwapWindows(windowID1, windowID2 int) {
 	node1 := t.WindowToNode[windowID1]
@@ -940,6 +979,19 @@ func (t *BSPTree) EqualizeRatios() {
 	equalizeRatiosRecursive(t.Root)
 }
 
+// EqualizeSiblings sets the split ratios to 0.5 within the subtree of the
+// given window's parent, that is the window, its sibling and anything split
+// off the sibling, leaving the rest of the tree alone. It returns false when
+// the window is not in the tree or has no parent split.
+func (t *BSPTree) EqualizeSiblings(windowID int) bool {
+	node := t.WindowToNode[windowID]
+	if node == nil || node.Parent == nil {
+		return false
+	}
+	equalizeRatiosRecursive(node.Parent)
+	return true
+}
+
 func equalizeRatiosRecursive(node *TileNode) {
 	if node == nil || node.IsLeaf() {
 		return
@@ -1015,11 +1067,24 @@ func (t *BSPTree) GetAllWindowIDs() []int {
 // GetNextSplitDirection returns the direction of the next auto-split ("V" or "H")
 // based on the current tree state and auto scheme. It mirrors determineAutoSplit
 // so the dock indicator agrees with the axis an auto-insert would actually pick.
-func (t *BSPTree) GetNextSplitDirection() string {
+// bounds is the tiling area, which the longest-side scheme measures against.
+func (t *BSPTree) GetNextSplitDirection(bounds Rect) string {
 	if t == nil {
 		return "V" // Default to vertical for empty tree
 	}
 
+	if t.AutoScheme == SchemeLongestSide {
+		// An auto-insert splits the last window in the tree, so measure its pane.
+		ids := t.GetAllWindowIDs()
+		if len(ids) == 0 {
+			return "V"
+		}
+		if t.determineAutoSplit(t.WindowToNode[ids[len(ids)-1]], bounds) == SplitVertical {
+			return "V"
+		}
+		return "H"
+	}
+
 	if t.AutoScheme == SchemeSpiral {
 		// Spiral alternates on the depth of the window being split. The next
 		// auto-insert splits the deepest (most recently split) leaf, so predict
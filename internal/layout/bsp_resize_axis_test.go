@@ -89,10 +89,7 @@ func deepNest() *BSPTree {
 }
 
 func sharedGap() int {
-	if config.SharedBorders {
-		return 1
-	}
-	return 0
+	return separatorGap()
 }
 
 // dragEdge moves one edge of the target pane and drags every pane that shares
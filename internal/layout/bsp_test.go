@@ -2,6 +2,8 @@ package layout
 
 import (
 	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
 )
 
 // TestNewBSPTree tests creating a new BSP tree
@@ -206,6 +208,54 @@ func TestBSPTree_InsertSecondWindow(t *testing.T) {
 	}
 }
 
+// TestBSPTree_LongestSideMeasuresTargetPane checks that the longest-side
+// scheme picks each split axis from the pane being split, not from the whole
+// screen: a wide screen splits side by side, the resulting tall half stacks,
+// and the resulting wide quarter splits side by side again.
+func TestBSPTree_LongestSideMeasuresTargetPane(t *testing.T) {
+	tree := NewBSPTree()
+	tree.AutoScheme = SchemeLongestSide
+	bounds := Rect{X: 0, Y: 0, W: 120, H: 40}
+
+	want := []struct {
+		split     SplitType
+		indicator string
+	}{
+		{SplitVertical, "V"},
+		{SplitHorizontal, "H"},
+		{SplitVertical, "V"},
+	}
+	tree.InsertWindow(1, 0, SplitNone, 0.5, bounds)
+	for i, w := range want {
+		id := i + 2
+		if got := tree.GetNextSplitDirection(bounds); got != w.indicator {
+			t.Errorf("window %d: predicted split %q, want %q", id, got, w.indicator)
+		}
+		tree.InsertWindow(id, id-1, SplitNone, 0.5, bounds)
+		if parent := tree.FindNode(id).Parent; parent.SplitType != w.split {
+			t.Errorf("window %d: split %v, want %v", id, parent.SplitType, w.split)
+		}
+	}
+}
+
+// TestBSPTree_PreselectionRatioIsKeptByFocusedWindow checks that the ratio
+// passed to a preselected insert is the focused window's share whichever side
+// the new window lands on.
+func TestBSPTree_PreselectionRatioIsKeptByFocusedWindow(t *testing.T) {
+	bounds := Rect{X: 0, Y: 0, W: 100, H: 100}
+	for _, preselect := range []PreselectionDir{PreselectionDown, PreselectionUp} {
+		tree := NewBSPTree()
+		tree.InsertWindow(1, 0, SplitNone, 0.5, bounds)
+		tree.InsertWindowWithPreselection(2, 1, preselect, 0.7, bounds)
+
+		rects := tree.ApplyLayout(bounds)
+		if rects[1].H <= rects[2].H*2 {
+			t.Errorf("preselect %d: focused window %d rows, new window %d rows; want the focused window to keep 70%%",
+				preselect, rects[1].H, rects[2].H)
+		}
+	}
+}
+
 // TestBSPTree_InsertDuplicate tests that duplicate windows are not inserted
 func TestBSPTree_InsertDuplicate(t *testing.T) {
 	tree := NewBSPTree()
@@ -329,6 +379,121 @@ func TestBSPTree_SwapWindows(t *testing.T) {
 	}
 }
 
+// TestBSPTree_EqualizeSiblings tests that only the focused window's parent
+// split is reset
+func TestBSPTree_EqualizeSiblings(t *testing.T) {
+	tree := NewBSPTree()
+	bounds := Rect{X: 0, Y: 0, W: 100, H: 100}
+
+	tree.InsertWindow(1, 0, SplitNone, 0.5, bounds)
+	tree.InsertWindow(2, 1, SplitVertical, 0.5, bounds)
+	tree.InsertWindow(3, 2, SplitHorizontal, 0.5, bounds)
+
+	inner := tree.FindNode(3).Parent
+	tree.Root.SplitRatio = 0.7
+	inner.SplitRatio = 0.3
+
+	if !tree.EqualizeSiblings(3) {
+		t.Fatal("EqualizeSiblings should succeed for a window with a parent split")
+	}
+	if inner.SplitRatio != 0.5 {
+		t.Errorf("Expected focused split ratio 0.5, got %f", inner.SplitRatio)
+	}
+	if tree.Root.SplitRatio != 0.7 {
+		t.Errorf("Root ratio should be untouched, got %f", tree.Root.SplitRatio)
+	}
+
+	single := NewBSPTree()
+	single.InsertWindow(1, 0, SplitNone, 0.5, bounds)
+	if single.EqualizeSiblings(1) {
+		t.Error("EqualizeSiblings should fail for a lone window")
+	}
+	if tree.EqualizeSiblings(99) {
+		t.Error("EqualizeSiblings should fail for an unknown window")
+	}
+}
+
+// TestBSPTree_Flip tests that flipping mirrors pane positions along one axis
+// and keeps every pane's size
+func TestBSPTree_Flip(t *testing.T) {
+	tree := NewBSPTree()
+	bounds := Rect{X: 0, Y: 0, W: 100, H: 100}
+
+	// 1 on the left at 70%, 2 above 3 on the right.
+	tree.InsertWindow(1, 0, SplitNone, 0.5, bounds)
+	tree.InsertWindow(2, 1, SplitVertical, 0.5, bounds)
+	tree.InsertWindow(3, 2, SplitHorizontal, 0.5, bounds)
+	tree.Root.SplitRatio = 0.7
+
+	tree.FlipHorizontal()
+	got := tree.ApplyLayout(bounds)
+	want := map[int]Rect{
+		1: {X: 30, Y: 0, W: 70, H: 100},
+		2: {X: 0, Y: 0, W: 30, H: 50},
+		3: {X: 0, Y: 50, W: 30, H: 50},
+	}
+	for id, r := range want {
+		if got[id] != r {
+			t.Errorf("after FlipHorizontal window %d = %+v, want %+v", id, got[id], r)
+		}
+	}
+
+	tree.FlipVertical()
+	got = tree.ApplyLayout(bounds)
+	if got[3].Y != 0 || got[2].Y != 50 {
+		t.Errorf("after FlipVertical 3 should be above 2, got 2=%+v 3=%+v", got[2], got[3])
+	}
+	if got[1] != want[1] {
+		t.Errorf("FlipVertical moved the full-height pane: %+v", got[1])
+	}
+	if tree.FindNode(2).Parent.Left != tree.FindNode(3) {
+		t.Error("FlipVertical should put 3 first in its split")
+	}
+}
+
+// TestBSPTree_TilingGap checks the gap separates siblings on both axes and
+// that dragging either side of a divider keeps it.
+func TestBSPTree_TilingGap(t *testing.T) {
+	prevGap, prevShared := config.TilingGap, config.SharedBorders
+	config.TilingGap, config.SharedBorders = 2, false
+	defer func() { config.TilingGap, config.SharedBorders = prevGap, prevShared }()
+
+	tree := NewBSPTree()
+	bounds := Rect{X: 0, Y: 0, W: 100, H: 100}
+	tree.InsertWindow(1, 0, SplitNone, 0.5, bounds)
+	tree.InsertWindow(2, 1, SplitVertical, 0.5, bounds)
+	tree.InsertWindow(3, 2, SplitHorizontal, 0.5, bounds)
+	tree.Root.SplitRatio = 0.5
+	tree.FindNode(2).Parent.SplitRatio = 0.5
+
+	got := tree.ApplyLayout(bounds)
+	want := map[int]Rect{
+		1: {X: 0, Y: 0, W: 50, H: 100},
+		2: {X: 52, Y: 0, W: 48, H: 50},
+		3: {X: 52, Y: 52, W: 48, H: 48},
+	}
+	for id, r := range want {
+		if got[id] != r {
+			t.Errorf("window %d = %+v, want %+v", id, got[id], r)
+		}
+	}
+
+	// Dragging the right edge of 1 or the left edge of 2 moves the same
+	// divider, with the gap kept after it.
+	if !tree.ResizeSplit(1, ResizeEdgeRight, 60, bounds) {
+		t.Fatal("ResizeSplit found no divider right of window 1")
+	}
+	got = tree.ApplyLayout(bounds)
+	if got[1].W != 60 || got[2].X != 62 {
+		t.Errorf("after dragging 1's right edge to 60: 1=%+v 2=%+v", got[1], got[2])
+	}
+	tree.ResizeSplit(2, ResizeEdgeLeft, 70, bounds)
+	got = tree.ApplyLayout(bounds)
+	if got[2].X != 70 || got[1].X+got[1].W != 68 {
+		t.Errorf("after dragging 2's left edge to 70: 1=%+v 2=%+v", got[1], got[2])
+	}
+}
+
 // TestSplitType_String tests string representation of split types
 func TestSplitType_String(t *testing.T) {
 	tests := []struct {
@@ -0,0 +1,116 @@
+package layout
+
+import "math"
+
+// Layout presets: fixed arrangements applied on demand, as opposed to the
+// auto schemes that decide where each new window goes.
+const (
+	PresetColumns = "columns" // every window side by side in a single row
+	PresetRows    = "rows"    // every window stacked in a single column
+	PresetGrid    = "grid"    // rows of near-equal cells, as close to square as the count allows
+)
+
+// Presets lists the preset names in the order they are offered to the user.
+var Presets = []string{PresetColumns, PresetRows, PresetGrid}
+
+// presetShape returns how many windows each row of a preset holds, top to
+// bottom, or nil for an unknown preset or no windows. A grid has ceil(sqrt(n))
+// columns and spreads any shortfall over its lower rows, so no two rows differ
+// by more than one cell: seven windows are 3+2+2, not 3+3+1.
+func presetShape(preset string, n int) []int {
+	if n <= 0 {
+		return nil
+	}
+	switch preset {
+	case PresetColumns:
+		return []int{n}
+	case PresetRows:
+		shape := make([]int, n)
+		for i := range shape {
+			shape[i] = 1
+		}
+		return shape
+	case PresetGrid:
+		cols := int(math.Ceil(math.Sqrt(float64(n))))
+		rows := (n + cols - 1) / cols
+		shape := make([]int, rows)
+		for i := range shape {
+			shape[i] = n / rows
+			if i < n%rows {
+				shape[i]++
+			}
+		}
+		return shape
+	default:
+		return nil
+	}
+}
+
+// CalculatePresetLayout returns the positions of n windows arranged by a
+// preset, in the same order the windows were given. It returns nil for an
+// unknown preset.
+func CalculatePresetLayout(preset string, n int, screenWidth int, usableHeight int, topMargin int) []TileLayout {
+	shape := presetShape(preset, n)
+	if shape == nil {
+		return nil
+	}
+
+	layouts := make([]TileLayout, 0, n)
+	rowHeight := usableHeight / len(shape)
+	for row, cols := range shape {
+		y := topMargin + row*rowHeight
+		h := rowHeight
+		if row == len(shape)-1 {
+			h = topMargin + usableHeight - y
+		}
+		cellWidth := screenWidth / cols
+		for col := range cols {
+			x := col * cellWidth
+			w := cellWidth
+			if col == cols-1 {
+				w = screenWidth - x
+			}
+			layouts = append(layouts, TileLayout{X: x, Y: y, Width: max(w, 1), Height: max(h, 1)})
+		}
+	}
+	return layouts
+}
+
+// ArrangePreset replaces the tree with one that lays windowIDs out by a
+// preset, in the order given, keeping the tree's auto scheme for windows added
+// later. Each row is a chain of vertical splits and the rows a chain of
+// horizontal ones, with ratios that give every cell in a chain the same share.
+// It reports false, leaving the tree alone, for an unknown preset or no
+// windows.
+func (t *BSPTree) ArrangePreset(preset string, windowIDs []int) bool {
+	shape := presetShape(preset, len(windowIDs))
+	if shape == nil {
+		return false
+	}
+
+	t.WindowToNode = make(map[int]*TileNode, len(windowIDs))
+	rows := make([]*TileNode, 0, len(shape))
+	next := 0
+	for _, cols := range shape {
+		cells := make([]*TileNode, cols)
+		for i := range cells {
+			leaf := NewLeafNode(windowIDs[next])
+			t.WindowToNode[windowIDs[next]] = leaf
+			cells[i] = leaf
+			next++
+		}
+		rows = append(rows, evenChain(SplitVertical, cells))
+	}
+	t.Root = evenChain(SplitHorizontal, rows)
+	return true
+}
+
+// evenChain joins nodes into a right-leaning chain of splits in which each
+// node gets an equal share: the first split gives its left child 1/len, the
+// next 1/(len-1) of what remains, and so on.
+func evenChain(split SplitType, nodes []*TileNode) *TileNode {
+	if len(nodes) == 1 {
+		return nodes[0]
+	}
+	return NewInternalNode(split, 1/float64(len(nodes)), nodes[0], evenChain(split, nodes[1:]))
+}
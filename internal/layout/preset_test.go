@@ -0,0 +1,96 @@
+package layout
+
+import (
+	"testing"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// TestCalculatePresetLayout checks the cell geometry of each preset,
+// including a grid whose last row is short and widens to fill the screen, and
+// one that spreads its shortfall over two rows instead of stranding a cell.
+func TestCalculatePresetLayout(t *testing.T) {
+	tests := []struct {
+		preset string
+		n      int
+		want   []TileLayout
+	}{
+		{PresetColumns, 3, []TileLayout{
+			{X: 0, Y: 1, Width: 40, Height: 60},
+			{X: 40, Y: 1, Width: 40, Height: 60},
+			{X: 80, Y: 1, Width: 40, Height: 60},
+		}},
+		{PresetRows, 3, []TileLayout{
+			{X: 0, Y: 1, Width: 120, Height: 20},
+			{X: 0, Y: 21, Width: 120, Height: 20},
+			{X: 0, Y: 41, Width: 120, Height: 20},
+		}},
+		{PresetGrid, 3, []TileLayout{
+			{X: 0, Y: 1, Width: 60, Height: 30},
+			{X: 60, Y: 1, Width: 60, Height: 30},
+			{X: 0, Y: 31, Width: 120, Height: 30},
+		}},
+		{PresetGrid, 7, []TileLayout{
+			{X: 0, Y: 1, Width: 40, Height: 20},
+			{X: 40, Y: 1, Width: 40, Height: 20},
+			{X: 80, Y: 1, Width: 40, Height: 20},
+			{X: 0, Y: 21, Width: 60, Height: 20},
+			{X: 60, Y: 21, Width: 60, Height: 20},
+			{X: 0, Y: 41, Width: 60, Height: 20},
+			{X: 60, Y: 41, Width: 60, Height: 20},
+		}},
+	}
+	for _, tt := range tests {
+		got := CalculatePresetLayout(tt.preset, tt.n, 120, 60, 1)
+		if len(got) != len(tt.want) {
+			t.Fatalf("%s: got %d cells, want %d", tt.preset, len(got), len(tt.want))
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s cell %d = %+v, want %+v", tt.preset, i, got[i], tt.want[i])
+			}
+		}
+	}
+
+	if got := CalculatePresetLayout("diagonal", 3, 120, 60, 0); got != nil {
+		t.Errorf("unknown preset laid out %d cells", len(got))
+	}
+	if got := CalculatePresetLayout(PresetGrid, 0, 120, 60, 0); got != nil {
+		t.Errorf("no windows laid out %d cells", len(got))
+	}
+}
+
+// TestArrangePresetMatchesCalculation checks that the tree a preset builds
+// lays windows out exactly where CalculatePresetLayout puts them, so tiled
+// and floating workspaces arrange the same way.
+func TestArrangePresetMatchesCalculation(t *testing.T) {
+	prev := config.SharedBorders
+	config.SharedBorders = false
+	defer func() { config.SharedBorders = prev }()
+
+	ids := []int{7, 3, 9, 4, 5}
+	bounds := Rect{X: 0, Y: 0, W: 120, H: 60}
+	for _, preset := range Presets {
+		tree := NewBSPTree()
+		tree.InsertWindow(1, 0, SplitNone, 0.5, bounds)
+		if !tree.ArrangePreset(preset, ids) {
+			t.Fatalf("%s: ArrangePreset failed", preset)
+		}
+		if tree.HasWindow(1) || tree.WindowCount() != len(ids) {
+			t.Fatalf("%s: tree holds %v, want %v", preset, tree.GetAllWindowIDs(), ids)
+		}
+
+		rects := tree.ApplyLayout(bounds)
+		for i, cell := range CalculatePresetLayout(preset, len(ids), bounds.W, bounds.H, bounds.Y) {
+			want := Rect{X: cell.X, Y: cell.Y, W: cell.Width, H: cell.Height}
+			if rects[ids[i]] != want {
+				t.Errorf("%s window %d at %+v, want %+v", preset, ids[i], rects[ids[i]], want)
+			}
+		}
+	}
+
+	tree := NewBSPTree()
+	if tree.ArrangePreset("diagonal", ids) || !tree.IsEmpty() {
+		t.Error("unknown preset changed the tree")
+	}
+}
@@ -11,20 +11,29 @@ type TileLayout struct {
 }
 
 // CalculateTilingLayout returns optimal positions for n windows
-// masterRatio controls the width ratio of the master (left) pane (0.3-0.7)
-func CalculateTilingLayout(n int, screenWidth int, usableHeight int, topMargin int, masterRatio float64) []TileLayout {
+// masterRatio controls the width ratio of the master (left) pane, within
+// [config.MasterRatioMin, config.MasterRatioMax]
+// gap is the number of blank cells kept between neighbouring tiles and, when
+// the screen has room for it, around the edges, the same as BSP tiling
+func CalculateTilingLayout(n int, screenWidth int, usableHeight int, topMargin int, masterRatio float64, gap int) []TileLayout {
 	if n == 0 {
 		return nil
 	}
 
+	// Lay the tiles out in the area inside the outer gap and shift them into
+	// place at the end, so the arithmetic below can keep starting at X=0.
+	left := 0
+	if gap > 0 && screenWidth > 2*gap && usableHeight > 2*gap {
+		left = gap
+		topMargin += gap
+		screenWidth -= 2 * gap
+		usableHeight -= 2 * gap
+	}
+
 	layouts := make([]TileLayout, 0, n)
 
-	// Clamp master ratio to reasonable bounds (30%-70%)
-	if masterRatio < 0.3 {
-		masterRatio = 0.3
-	} else if masterRatio > 0.7 {
-		masterRatio = 0.7
-	}
+	// Clamp master ratio to the configured bounds (master_ratio_min/max)
+	masterRatio = config.ClampMasterRatio(masterRatio)
 
 	// Status bar is an overlay, windows use full usable height starting at Y=0
 	switch n {
@@ -159,6 +168,19 @@ func CalculateTilingLayout(n int, screenWidth int, usableHeight int, topMargin i
 		}
 	}
 
+	// Pull every edge that faces a neighbour back by the gap. Edges on the
+	// area's border already have the outer gap beyond them.
+	if gap > 0 {
+		for i := range layouts {
+			if layouts[i].X+layouts[i].Width < screenWidth {
+				layouts[i].Width = max(layouts[i].Width-gap, 1)
+			}
+			if layouts[i].Y+layouts[i].Height < topMargin+usableHeight {
+				layouts[i].Height = max(layouts[i].Height-gap, 1)
+			}
+		}
+	}
+
 	// Ensure minimum window size, then keep the widened tile on-screen. Without
 	// the position clamp a tile that was grown to the minimum on a small terminal
 	// would overflow screenWidth/usableHeight and overlap its neighbours.
@@ -171,6 +193,7 @@ func CalculateTilingLayout(n int, screenWidth int, usableHeight int, topMargin i
 		}
 		layouts[i].X = max(0, min(layouts[i].X, screenWidth-layouts[i].Width))
 		layouts[i].Y = max(topMargin, min(layouts[i].Y, topMargin+usableHeight-layouts[i].Height))
+		layouts[i].X += left
 	}
 
 	return layouts
@@ -8,7 +8,7 @@ import (
 
 // TestCalculateTilingLayout_SingleWindow tests layout with one window
 func TestCalculateTilingLayout_SingleWindow(t *testing.T) {
-	layouts := CalculateTilingLayout(1, 200, 100, 0, 0.5)
+	layouts := CalculateTilingLayout(1, 200, 100, 0, 0.5, 0)
 
 	if len(layouts) != 1 {
 		t.Fatalf("Expected 1 layout, got %d", len(layouts))
@@ -41,7 +41,7 @@ func TestCalculateTilingLayout_TwoWindows(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			layouts := CalculateTilingLayout(2, 200, 100, 0, tt.masterRatio)
+			layouts := CalculateTilingLayout(2, 200, 100, 0, tt.masterRatio, 0)
 
 			if len(layouts) != 2 {
 				t.Fatalf("Expected 2 layouts, got %d", len(layouts))
@@ -79,9 +79,33 @@ func TestCalculateTilingLayout_TwoWindows(t *testing.T) {
 	}
 }
 
+// TestCalculateTilingLayout_ConfiguredRatioBounds tests that the master ratio
+// is clamped to master_ratio_min/max rather than a fixed 0.3-0.7
+func TestCalculateTilingLayout_ConfiguredRatioBounds(t *testing.T) {
+	prevMin, prevMax := config.MasterRatioMin, config.MasterRatioMax
+	config.MasterRatioMin, config.MasterRatioMax = 0.2, 0.8
+	defer func() { config.MasterRatioMin, config.MasterRatioMax = prevMin, prevMax }()
+
+	tests := []struct {
+		masterRatio float64
+		expectLeft  int
+	}{
+		{0.2, 40},
+		{0.8, 160},
+		{0.1, 40},  // Clamped to master_ratio_min
+		{0.9, 160}, // Clamped to master_ratio_max
+	}
+	for _, tt := range tests {
+		layouts := CalculateTilingLayout(2, 200, 100, 0, tt.masterRatio, 0)
+		if layouts[0].Width != tt.expectLeft {
+			t.Errorf("Ratio %.1f: expected master width %d, got %d", tt.masterRatio, tt.expectLeft, layouts[0].Width)
+		}
+	}
+}
+
 // TestCalculateTilingLayout_ThreeWindows tests layout with three windows
 func TestCalculateTilingLayout_ThreeWindows(t *testing.T) {
-	layouts := CalculateTilingLayout(3, 200, 100, 0, 0.5)
+	layouts := CalculateTilingLayout(3, 200, 100, 0, 0.5, 0)
 
 	if len(layouts) != 3 {
 		t.Fatalf("Expected 3 layouts, got %d", len(layouts))
@@ -109,7 +133,7 @@ func TestCalculateTilingLayout_ThreeWindows(t *testing.T) {
 
 // TestCalculateTilingLayout_FourWindows tests 2x2 grid layout
 func TestCalculateTilingLayout_FourWindows(t *testing.T) {
-	layouts := CalculateTilingLayout(4, 200, 100, 0, 0.5)
+	layouts := CalculateTilingLayout(4, 200, 100, 0, 0.5, 0)
 
 	if len(layouts) != 4 {
 		t.Fatalf("Expected 4 layouts, got %d", len(layouts))
@@ -148,7 +172,7 @@ func TestCalculateTilingLayout_ManyWindows(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			layouts := CalculateTilingLayout(tt.numWindows, 300, 200, 0, 0.5)
+			layouts := CalculateTilingLayout(tt.numWindows, 300, 200, 0, 0.5, 0)
 
 			if len(layouts) != tt.numWindows {
 				t.Fatalf("Expected %d layouts, got %d", tt.numWindows, len(layouts))
@@ -172,7 +196,7 @@ func TestCalculateTilingLayout_ManyWindows(t *testing.T) {
 // TestCalculateTilingLayout_MinimumSize tests that minimum sizes are enforced
 func TestCalculateTilingLayout_MinimumSize(t *testing.T) {
 	// Create a very small screen to test minimum size enforcement
-	layouts := CalculateTilingLayout(2, 50, 20, 0, 0.5)
+	layouts := CalculateTilingLayout(2, 50, 20, 0, 0.5, 0)
 
 	if len(layouts) != 2 {
 		t.Fatalf("Expected 2 layouts, got %d", len(layouts))
@@ -193,7 +217,7 @@ func TestCalculateTilingLayout_MinimumSize(t *testing.T) {
 // TestCalculateTilingLayout_WithTopMargin tests layout with top margin
 func TestCalculateTilingLayout_WithTopMargin(t *testing.T) {
 	topMargin := 2
-	layouts := CalculateTilingLayout(2, 200, 100, topMargin, 0.5)
+	layouts := CalculateTilingLayout(2, 200, 100, topMargin, 0.5, 0)
 
 	if len(layouts) != 2 {
 		t.Fatalf("Expected 2 layouts, got %d", len(layouts))
@@ -207,9 +231,39 @@ func TestCalculateTilingLayout_WithTopMargin(t *testing.T) {
 	}
 }
 
+// TestCalculateTilingLayout_Gap tests that tiles keep the gap from each other
+// and from the screen edges
+func TestCalculateTilingLayout_Gap(t *testing.T) {
+	const gap = 2
+	layouts := CalculateTilingLayout(3, 200, 100, 1, 0.5, gap)
+
+	want := []TileLayout{
+		{X: 2, Y: 3, Width: 96, Height: 96},
+		{X: 100, Y: 3, Width: 98, Height: 46},
+		{X: 100, Y: 51, Width: 98, Height: 48},
+	}
+	if len(layouts) != len(want) {
+		t.Fatalf("Expected %d layouts, got %d", len(want), len(layouts))
+	}
+	for i := range want {
+		if layouts[i] != want[i] {
+			t.Errorf("Window %d: expected %+v, got %+v", i, want[i], layouts[i])
+		}
+	}
+
+	// The master's right edge and the stack's left edge are gap cells apart,
+	// as are the two stacked tiles
+	if d := layouts[1].X - (layouts[0].X + layouts[0].Width); d != gap {
+		t.Errorf("Horizontal gap = %d, want %d", d, gap)
+	}
+	if d := layouts[2].Y - (layouts[1].Y + layouts[1].Height); d != gap {
+		t.Errorf("Vertical gap = %d, want %d", d, gap)
+	}
+}
+
 // TestCalculateTilingLayout_ZeroWindows tests edge case with no windows
 func TestCalculateTilingLayout_ZeroWindows(t *testing.T) {
-	layouts := CalculateTilingLayout(0, 200, 100, 0, 0.5)
+	layouts := CalculateTilingLayout(0, 200, 100, 0, 0.5, 0)
 
 	if layouts != nil {
 		t.Errorf("Expected nil for 0 windows, got %d layouts", len(layouts))
@@ -219,13 +273,13 @@ func TestCalculateTilingLayout_ZeroWindows(t *testing.T) {
 // BenchmarkCalculateTilingLayout benchmarks the tiling calculation
 func BenchmarkCalculateTilingLayout(b *testing.B) {
 	for b.Loop() {
-		_ = CalculateTilingLayout(10, 1920, 1080, 0, 0.5)
+		_ = CalculateTilingLayout(10, 1920, 1080, 0, 0.5, 0)
 	}
 }
 
 // BenchmarkCalculateTilingLayout_ManyWindows benchmarks with many windows
 func BenchmarkCalculateTilingLayout_ManyWindows(b *testing.B) {
 	for b.Loop() {
-		_ = CalculateTilingLayout(50, 1920, 1080, 0, 0.5)
+		_ = CalculateTilingLayout(50, 1920, 1080, 0, 0.5, 0)
 	}
 }
@@ -20,6 +20,8 @@ type CommandBlock struct {
 	ExitCode     int    // -1 if unknown
 	StartLine    int    // absolute line index
 	EndLine      int    // absolute line index (inclusive)
+	OutputStart  int    // absolute line index of the first output line
+	OutputEnd    int    // absolute line index of the last output line; < OutputStart if none
 	Method       string // "osc133" or "regex"  - how this block was parsed
 }
 
@@ -149,6 +151,7 @@ func parseWithMarkers(term *vt.Emulator, markers []vt.SemanticMarker) []CommandB
 		// Extract output (between C and D)
 		output := ""
 		styledOutput := ""
+		outputStart, outputEnd := 0, -1
 		if cMarker != nil {
 			outEnd := 0
 			if dMarker != nil {
@@ -189,6 +192,7 @@ func parseWithMarkers(term *vt.Emulator, markers []vt.SemanticMarker) []CommandB
 				}
 			}
 
+			outputStart, outputEnd = cMarker.AbsLine, outEnd
 			if outEnd >= cMarker.AbsLine {
 				output = extractLinesText(term, cMarker.AbsLine, outEnd)
 				styledOutput = extractLinesStyledText(term, cMarker.AbsLine, outEnd)
@@ -207,6 +211,8 @@ func parseWithMarkers(term *vt.Emulator, markers []vt.SemanticMarker) []CommandB
 			ExitCode:     exitCode,
 			StartLine:    m.AbsLine,
 			EndLine:      endLineForBlock(dMarker, cMarker, bMarker, term),
+			OutputStart:  outputStart,
+			OutputEnd:    outputEnd,
 			Method:       "osc133",
 		})
 	}
@@ -341,6 +347,8 @@ func parseWithRegex(term *vt.Emulator) []CommandBlock {
 			ExitCode:     -1,
 			StartLine:    p.line,
 			EndLine:      outputEnd,
+			OutputStart:  outputStart,
+			OutputEnd:    outputEnd,
 			Method:       "regex",
 		})
 	}
@@ -383,6 +391,26 @@ func extractAbsLineText(term *vt.Emulator, absLine int) string {
 	return strings.TrimRight(sb.String(), " ")
 }
 
+// PlainText returns the whole scrollback followed by the visible screen as
+// plain text, one line per terminal row, with trailing blank rows dropped.
+func PlainText(term *vt.Emulator) string {
+	if term == nil {
+		return ""
+	}
+	total := term.ScrollbackLen() + term.Height()
+	lines := make([]string, total)
+	for i := range lines {
+		lines[i] = extractAbsLineText(term, i)
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
 // extractAbsLineStyledText extracts ANSI-styled text from an absolute line index.
 func extractAbsLineStyledText(term *vt.Emulator, absLine int) string {
 	sbLen := term.ScrollbackLen()
@@ -8,6 +8,7 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/session"
 )
 
@@ -155,16 +156,11 @@ func isValidSessionNameChar(r rune) bool {
 }
 
 func (m *SessionPicker) generateSessionName() string {
-	existing := make(map[string]bool)
+	existing := make([]string, 0, len(m.sessions))
 	for _, s := range m.sessions {
-		existing[s.Name] = true
-	}
-	for i := 0; ; i++ {
-		name := fmt.Sprintf("session-%d", i)
-		if !existing[name] {
-			return name
-		}
+		existing = append(existing, s.Name)
 	}
+	return config.NewSessionName(existing)
 }
 
 // Result returns the user's selection after the picker has quit.
@@ -143,9 +143,11 @@ func teaHandler(sshSession ssh.Session) (tea.Model, []tea.ProgramOption) {
 
 	// Close the daemon client when the SSH session ends, otherwise the client
 	// read loop, its socket, and the daemon-side connState leak per connection.
+	// startup.shutdown_command runs first, as it does when a local client exits.
 	if o, ok := model.(*app.OS); ok {
 		go func() {
 			<-sshSession.Context().Done()
+			o.RunShutdownCommand()
 			o.Cleanup()
 		}()
 	}
@@ -155,7 +155,14 @@ func NewDaemon(cfg *DaemonConfig) *Daemon {
 // onSessionCreated installs a session's event and state sinks and publishes a
 // session-created event. It runs on the manager's create hook.
 func (d *Daemon) onSessionCreated(s *Session) {
-	name := s.Name
+	d.installSessionSinks(s)
+	d.events.publish(streamEvent{Type: EventSessionCreated, Session: s.Name()})
+}
+
+// installSessionSinks points a session's state and event sinks at the daemon.
+// Events carry the session name captured here, so a rename installs them again.
+func (d *Daemon) installSessionSinks(s *Session) {
+	name := s.Name()
 	// Every daemon-side mutation reaches the attached clients from here, so a
 	// change the daemon made itself shows up in a live TUI without the verb that
 	// made it knowing a client exists. Source is empty because the daemon, not a
@@ -177,7 +184,6 @@ func (d *Daemon) onSessionCreated(s *Session) {
 			Workspace: ev.Workspace,
 		})
 	})
-	d.events.publish(streamEvent{Type: EventSessionCreated, Session: name})
 }
 
 // onSessionDeleted publishes a session-closed event and tells every client
@@ -189,9 +195,9 @@ func (d *Daemon) onSessionCreated(s *Session) {
 // PTYs are closed and their windows are gone, but the socket stays open, so the
 // client sits in a dead session with no way to learn what happened.
 func (d *Daemon) onSessionDeleted(s *Session) {
-	d.events.publish(streamEvent{Type: EventSessionClosed, Session: s.Name})
+	d.events.publish(streamEvent{Type: EventSessionClosed, Session: s.Name()})
 	d.broadcastToSession(s.ID, MsgSessionEnded, &SessionEndedPayload{
-		SessionName: s.Name,
+		SessionName: s.Name(),
 		Reason:      "the session was terminated",
 	}, "")
 }
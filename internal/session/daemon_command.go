@@ -26,6 +26,11 @@ var daemonOwnedCommands = map[string]bool{
 	// because it has no viewport. It says so with WindowState.Unplaced instead of
 	// guessing, and the client that receives the push places it.
 	"NewWindow": true,
+	// Signals go to the processes behind a PTY, which only the daemon holds.
+	"SignalWindow": true,
+	// Reloading swaps the PTY behind a window for a fresh one. The client only
+	// has to rebind to the new PTYID the state push carries.
+	"ReloadWindow": true,
 }
 
 // handleExecuteCommand routes a tape command to the TUI client attached to the session.
@@ -43,7 +48,7 @@ func (d *Daemon) handleExecuteCommand(cs *connState, msg *Message) error {
 		LogBasic("Execute command: session not found")
 		return d.sendCommandResult(cs, payload.RequestID, false, "session not found")
 	}
-	LogBasic("Execute command: found session %s (ID=%s)", session.Name, session.ID)
+	LogBasic("Execute command: found session %s (ID=%s)", session.Name(), session.ID)
 
 	// Find the TUI client attached to this session. When one is present most
 	// commands are routed to it (unchanged behavior). With no client attached,
@@ -59,11 +59,15 @@ func (d *Daemon) handleAttach(cs *connState, msg *Message) error {
 
 	var session *Session
 	var err error
+	existing := true
 
 	if payload.SessionName == "" {
+		existing = d.manager.HasSessions()
 		session, err = d.manager.GetDefaultSession(cfg, payload.Width, payload.Height)
 	} else if payload.CreateNew {
-		session, _, err = d.manager.GetOrCreateSession(payload.SessionName, cfg, payload.Width, payload.Height)
+		var created bool
+		session, created, err = d.manager.GetOrCreateSession(payload.SessionName, cfg, payload.Width, payload.Height)
+		existing = !created
 	} else {
 		session = d.manager.GetSession(payload.SessionName)
 		if session == nil {
@@ -100,7 +104,7 @@ func (d *Daemon) handleAttach(cs *connState, msg *Message) error {
 
 	clientCount := d.getSessionClientCount(session.ID)
 	log.Printf("Client %s attached to session %s (TUI client, %d clients total, size=%dx%d)",
-		cs.clientID, session.Name, clientCount, payload.Width, payload.Height)
+		cs.clientID, session.Name(), clientCount, payload.Width, payload.Height)
 
 	// Calculate effective size including the new client's dimensions.
 	effectiveWidth, effectiveHeight := d.calculateEffectiveSize(session.ID)
@@ -147,12 +151,13 @@ func (d *Daemon) handleAttach(cs *connState, msg *Message) error {
 	}
 
 	return d.sendMessage(cs, MsgAttached, &AttachedPayload{
-		SessionName: session.Name,
+		SessionName: session.Name(),
 		SessionID:   session.ID,
 		Width:       effectiveWidth,
 		Height:      effectiveHeight,
 		WindowCount: len(state.Windows),
 		State:       state,
+		Existing:    existing,
 	})
 }
 
@@ -235,7 +240,7 @@ func (d *Daemon) handleNew(cs *connState, msg *Message) error {
 }
 
 func (d *Daemon) handleList(cs *connState) error {
-	sessions := d.manager.ListSessions()
+	sessions := d.listSessions()
 	return d.sendMessage(cs, MsgSessionList, &SessionListPayload{
 		Sessions: sessions,
 	})
@@ -390,7 +395,7 @@ func (d *Daemon) handleCreatePTY(cs *connState, msg *Message) error {
 		d.notifyPTYClosed(sessionID, ptyID)
 	}
 
-	debugLog("[DEBUG] Creating PTY %dx%d for session %s", width, height, session.Name)
+	debugLog("[DEBUG] Creating PTY %dx%d for session %s", width, height, session.Name())
 	pty, err := session.CreatePTY(payload.WindowID, width, height, onExit)
 	if err != nil {
 		debugLog("[DEBUG] handleCreatePTY: failed to create PTY: %v", err)
@@ -48,18 +48,7 @@ func (d *Daemon) executeDaemonCommand(sess *Session, commandType string, args []
 		if len(args) > 0 {
 			name = args[0]
 		}
-		win, err := sess.AddDaemonWindow("", onExit)
-		if err != nil {
-			return nil, err
-		}
-		displayName := win.Title
-		if name != "" {
-			if err := sess.RenameDaemonWindow(win.ID, name); err != nil {
-				return nil, err
-			}
-			displayName = name
-		}
-		return map[string]any{"window_id": win.ID, "name": displayName}, nil
+		return newDaemonWindow(sess, DaemonWindowSpec{}, name, onExit)
 
 	case "CloseWindow":
 		target := ""
@@ -77,6 +66,19 @@ func (d *Daemon) executeDaemonCommand(sess *Session, commandType string, args []
 		}
 		return nil, nil
 
+	case "ReloadWindow":
+		target := ""
+		if len(args) > 0 {
+			target = args[0]
+		} else {
+			id, err := focusedWindowID(sess.GetState())
+			if err != nil {
+				return nil, err
+			}
+			target = id
+		}
+		return nil, sess.ReloadDaemonWindow(target, onExit)
+
 	case "NextWindow":
 		return nil, sess.CycleDaemonFocus(1)
 
@@ -103,6 +105,26 @@ func (d *Daemon) executeDaemonCommand(sess *Session, commandType string, args []
 			return nil, fmt.Errorf("RenameWindow requires <new-name> or <target> <new-name>")
 		}
 
+	case "SignalWindow":
+		var target, name string
+		switch len(args) {
+		case 1:
+			name = args[0]
+		case 2:
+			target, name = args[0], args[1]
+		default:
+			return nil, fmt.Errorf("SignalWindow requires <signal> or <target> <signal>")
+		}
+		sig, err := ParseSignal(name)
+		if err != nil {
+			return nil, err
+		}
+		pty, err := d.resolvePTYForTarget(sess, target)
+		if err != nil {
+			return nil, err
+		}
+		return nil, pty.Signal(sig)
+
 	case "MinimizeWindow", "RestoreWindow":
 		minimize := commandType == "MinimizeWindow"
 		target := ""
@@ -430,3 +452,20 @@ func buildSessionInfoData(sess *Session, state *SessionState, hasClient bool) ma
 		"tui_attached":      hasClient,
 	}
 }
+
+// newDaemonWindow creates a window from spec, renames it to name when one is
+// given, and returns the window_id/name result the NewWindow command reports.
+func newDaemonWindow(sess *Session, spec DaemonWindowSpec, name string, onExit func(ptyID string)) (map[string]any, error) {
+	win, err := sess.AddDaemonWindowSpec(spec, onExit)
+	if err != nil {
+		return nil, err
+	}
+	displayName := win.Title
+	if name != "" {
+		if err := sess.RenameDaemonWindow(win.ID, name); err != nil {
+			return nil, err
+		}
+		displayName = name
+	}
+	return map[string]any{"window_id": win.ID, "name": displayName}, nil
+}
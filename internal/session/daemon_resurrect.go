@@ -37,8 +37,11 @@ func (d *Daemon) restoreAllSessions() {
 // restoreSession recreates a single session from a saved SessionState. It
 // respawns a fresh shell for every window (in the window's saved cwd, marked as
 // restored) and remaps each window to its new PTY, since the PTY IDs from the
-// previous daemon are dead. If the session is already live it is returned
-// unchanged.
+// previous daemon are dead. A window whose shell cannot be respawned keeps its
+// place in the layout with an empty PTYID, so clients show it as a placeholder
+// rather than subscribing to a dead PTY; its saved cwd is carried over and the
+// respawn is tried again on the next restore. If the session is already live it
+// is returned unchanged.
 func (d *Daemon) restoreSession(state *SessionState) (*Session, error) {
 	if state == nil || state.Name == "" {
 		return nil, fmt.Errorf("cannot restore session from empty state")
@@ -84,6 +87,7 @@ func (d *Daemon) restoreSession(state *SessionState) (*Session, error) {
 		pty, err := sess.RestorePTY(w.ID, ptyWidth, ptyHeight, w.Cwd, onExit)
 		if err != nil {
 			LogError("Failed to respawn shell for restored window %s: %v", shortID(w.ID), err)
+			w.PTYID = ""
 			continue
 		}
 		w.PTYID = pty.ID
@@ -88,6 +88,50 @@ func TestDaemonRestartRestoresWindows(t *testing.T) {
 	}
 }
 
+// TestDaemonRestoreKeepsUnspawnableWindow verifies a window whose shell cannot
+// be respawned stays in the restored layout as a placeholder: no PTY, but its
+// geometry, workspace and saved cwd are kept so the next restore can retry.
+func TestDaemonRestoreKeepsUnspawnableWindow(t *testing.T) {
+	tmpDir := t.TempDir()
+	defer useResurrectionDir(tmpDir)()
+	t.Setenv("SHELL", "/nonexistent/tuios-test-shell")
+
+	cwd := t.TempDir()
+	d := NewDaemon(&DaemonConfig{})
+	defer d.manager.Shutdown()
+
+	sess, err := d.restoreSession(&SessionState{
+		Name:   "broken",
+		Width:  80,
+		Height: 24,
+		Windows: []WindowState{
+			{ID: "win-1", X: 0, Y: 0, Width: 80, Height: 24, Workspace: 2, PTYID: "dead-pty", Cwd: cwd},
+		},
+		WindowToBSPID: map[string]int{"win-1": 1},
+	})
+	if err != nil {
+		t.Fatalf("restoreSession failed: %v", err)
+	}
+
+	state := sess.GetState()
+	if len(state.Windows) != 1 {
+		t.Fatalf("restored window count = %d, want 1", len(state.Windows))
+	}
+	w := state.Windows[0]
+	if w.PTYID != "" {
+		t.Errorf("unspawnable window kept PTYID %q, want none", w.PTYID)
+	}
+	if w.Workspace != 2 || w.Width != 80 || w.Height != 24 {
+		t.Errorf("placeholder lost its placement: %+v", w)
+	}
+	if state.WindowToBSPID["win-1"] != 1 {
+		t.Errorf("placeholder lost its BSP mapping: %v", state.WindowToBSPID)
+	}
+	if got := sess.ResurrectionState().Windows[0].Cwd; got != cwd {
+		t.Errorf("placeholder cwd = %q, want %q kept for the next restore", got, cwd)
+	}
+}
+
 // TestDaemonRestoreSkipsLiveSession verifies restoreSession does not clobber a
 // session that is already live.
 func TestDaemonRestoreSkipsLiveSession(t *testing.T) {
@@ -17,6 +17,17 @@ func (d *Daemon) getSessionClientCount(sessionID string) int {
 	return count
 }
 
+// listSessions returns the manager's sessions with their attached TUI clients
+// counted, which only the daemon knows.
+func (d *Daemon) listSessions() []SessionInfo {
+	infos := d.manager.ListSessions()
+	for i := range infos {
+		infos[i].Clients = d.getSessionClientCount(infos[i].ID)
+		infos[i].Attached = infos[i].Clients > 0
+	}
+	return infos
+}
+
 // calculateEffectiveSize returns the minimum dimensions across all clients in a session.
 // This is used for multi-client rendering where all clients need to see the same content.
 func (d *Daemon) calculateEffectiveSize(sessionID string) (width, height int) {
@@ -113,7 +124,7 @@ func (d *Daemon) recalculateAndBroadcastSize(sessionID string) {
 			ClientCount: d.getSessionClientCount(sessionID),
 		}
 		d.broadcastToSession(sessionID, MsgSessionResize, payload, "")
-		LogBasic("Session %s resized to %dx%d (min of %d clients)", session.Name, newWidth, newHeight, payload.ClientCount)
+		LogBasic("Session %s resized to %dx%d (min of %d clients)", session.Name(), newWidth, newHeight, payload.ClientCount)
 	}
 }
 
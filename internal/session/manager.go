@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
 )
 
 // Manager manages all persistent sessions for a user.
@@ -79,7 +81,7 @@ func (m *Manager) CreateSession(name string, cfg *SessionConfig, width, height i
 	}
 
 	// If no name was provided, one was auto-generated
-	name = session.Name
+	name = session.Name()
 
 	// Register the session
 	m.sessions[name] = session
@@ -155,6 +157,41 @@ func (m *Manager) DeleteSession(name string) error {
 	return nil
 }
 
+// RenameSession renames a session in place: its windows, PTYs and attached
+// clients are untouched. It fails when oldName does not exist or newName is
+// empty or already taken. The saved resurrection state moves with the session.
+func (m *Manager) RenameSession(oldName, newName string) error {
+	if newName == "" {
+		return fmt.Errorf("new session name is empty")
+	}
+
+	m.mu.Lock()
+	session, exists := m.sessions[oldName]
+	if !exists {
+		m.mu.Unlock()
+		return fmt.Errorf("session '%s' not found", oldName)
+	}
+	if oldName == newName {
+		m.mu.Unlock()
+		return nil
+	}
+	if _, taken := m.sessions[newName]; taken {
+		m.mu.Unlock()
+		return fmt.Errorf("session '%s' already exists", newName)
+	}
+	delete(m.sessions, oldName)
+	m.sessions[newName] = session
+	m.mu.Unlock()
+
+	session.rename(newName)
+
+	// Save under the new name before dropping the old file, so a crash in
+	// between leaves the session resurrectable under one of them.
+	_ = SaveSessionForResurrection(session.ResurrectionState())
+	RemoveResurrectionState(oldName)
+	return nil
+}
+
 // ListSessions returns information about all sessions.
 func (m *Manager) ListSessions() []SessionInfo {
 	m.mu.RLock()
@@ -219,16 +256,15 @@ func (m *Manager) HasSessions() bool {
 	return len(m.sessions) > 0
 }
 
-// GenerateSessionName generates a unique session name in session-N format.
+// GenerateSessionName picks a name for a session created without one, from
+// startup.session_name_format, avoiding every live session's name.
 func (m *Manager) GenerateSessionName() string {
 	m.mu.RLock()
-	defer m.mu.RUnlock()
-
-	// Find the lowest available number using "session-N" format
-	for i := 0; ; i++ {
-		name := fmt.Sprintf("session-%d", i)
-		if _, exists := m.sessions[name]; !exists {
-			return name
-		}
+	names := make([]string, 0, len(m.sessions))
+	for name := range m.sessions {
+		names = append(names, name)
 	}
+	m.mu.RUnlock()
+
+	return config.NewSessionName(names)
 }
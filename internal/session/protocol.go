@@ -129,6 +129,10 @@ type AttachedPayload struct {
 	Height      int           `json:"height"`          // Current session height
 	WindowCount int           `json:"window_count"`    // Number of windows in session
 	State       *SessionState `json:"state,omitempty"` // Session state for restore
+	// Existing reports that the session was there before this attach rather
+	// than created by it. A daemon that predates the field leaves it false,
+	// which reads as a new session: the behavior clients had before it.
+	Existing bool `json:"existing,omitempty"`
 }
 
 // NewPayload requests creation of a new session.
@@ -152,6 +156,7 @@ type SessionInfo struct {
 	LastActive  int64  `json:"last_active"`  // Unix timestamp of last activity
 	WindowCount int    `json:"window_count"` // Number of windows
 	Attached    bool   `json:"attached"`     // Whether a client is attached
+	Clients     int    `json:"clients"`      // Number of TUI clients attached
 	Width       int    `json:"width"`        // Session width
 	Height      int    `json:"height"`       // Session height
 }
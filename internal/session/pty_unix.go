@@ -3,6 +3,7 @@
 package session
 
 import (
+	"fmt"
 	"os/exec"
 	"syscall"
 	"unsafe"
@@ -10,6 +11,20 @@ import (
 	"golang.org/x/sys/unix"
 )
 
+// signalsByName are the signals SignalWindow can deliver, by short name.
+var signalsByName = map[string]syscall.Signal{
+	"HUP":  syscall.SIGHUP,
+	"INT":  syscall.SIGINT,
+	"QUIT": syscall.SIGQUIT,
+	"KILL": syscall.SIGKILL,
+	"TERM": syscall.SIGTERM,
+	"STOP": syscall.SIGSTOP,
+	"TSTP": syscall.SIGTSTP,
+	"CONT": syscall.SIGCONT,
+	"USR1": syscall.SIGUSR1,
+	"USR2": syscall.SIGUSR2,
+}
+
 // configurePTYCommand sets up the command for PTY usage on Unix systems.
 // This creates a new session and sets up the controlling terminal.
 func configurePTYCommand(cmd *exec.Cmd) {
@@ -46,3 +61,20 @@ func (p *PTY) SetPixelSize(cols, rows, xpixel, ypixel int) error {
 	}
 	return nil
 }
+
+// Signal sends sig to the PTY's foreground process group, which is the job a
+// key like Ctrl+C typed into the window would reach. When the foreground group
+// cannot be read it falls back to the shell's own group: the shell is started
+// with Setsid, so its PID is also its group ID.
+func (p *PTY) Signal(sig syscall.Signal) error {
+	if p.cmd == nil || p.cmd.Process == nil {
+		return fmt.Errorf("PTY %s has no process", p.ID)
+	}
+	pgid := p.cmd.Process.Pid
+	if p.pty != nil {
+		if fg, err := unix.IoctlGetInt(int(p.pty.Fd()), unix.TIOCGPGRP); err == nil && fg > 0 {
+			pgid = fg
+		}
+	}
+	return syscall.Kill(-pgid, sig)
+}
@@ -3,9 +3,19 @@
 package session
 
 import (
+	"fmt"
 	"os/exec"
+	"syscall"
 )
 
+// signalsByName are the signals SignalWindow can deliver, by short name.
+// Windows has no process groups or job-control signals; only termination can
+// be delivered, so the list is short.
+var signalsByName = map[string]syscall.Signal{
+	"KILL": syscall.SIGKILL,
+	"TERM": syscall.SIGTERM,
+}
+
 // configurePTYCommand sets up the command for PTY usage on Windows.
 // Windows ConPTY handles the terminal setup differently than Unix PTYs.
 func configurePTYCommand(cmd *exec.Cmd) {
@@ -17,3 +27,12 @@ func configurePTYCommand(cmd *exec.Cmd) {
 func (p *PTY) SetPixelSize(cols, rows, xpixel, ypixel int) error {
 	return nil
 }
+
+// Signal terminates the PTY's shell. Both accepted signals end the process,
+// since Windows cannot deliver a catchable signal to it.
+func (p *PTY) Signal(sig syscall.Signal) error {
+	if p.cmd == nil || p.cmd.Process == nil {
+		return fmt.Errorf("PTY %s has no process", p.ID)
+	}
+	return p.cmd.Process.Kill()
+}
@@ -40,6 +40,7 @@ type WindowState struct {
 	ID           string `json:"id"`
 	Title        string `json:"title"`
 	CustomName   string `json:"custom_name,omitempty"`
+	AutoNamed    bool   `json:"auto_named,omitempty"` // CustomName follows the foreground command rather than being set by the user
 	X            int    `json:"x"`
 	Y            int    `json:"y"`
 	Width        int    `json:"width"`
@@ -66,6 +67,10 @@ type WindowState struct {
 	// resurrection state written before this existed) reads as placed, which is
 	// exactly the pre-existing behavior of trusting the geometry as sent.
 	Unplaced bool `json:"unplaced,omitempty"`
+	// Command is the command line the window was started with, if any. The
+	// client placing an unplaced window applies the window rules that match it,
+	// and reloading the window types it into the fresh shell.
+	Command string `json:"command,omitempty"`
 }
 
 // SerializedBSPNode represents a BSP tree node for serialization
@@ -84,6 +89,12 @@ type SerializedBSPTree struct {
 	DefaultRatio float64            `json:"default_ratio"`
 }
 
+// Values of SessionState.LastMode.
+const (
+	LastModeTerminal         = "terminal"
+	LastModeWindowManagement = "window_management"
+)
+
 // SessionState represents the complete serializable state of a session.
 type SessionState struct {
 	Name             string         `json:"name"`
@@ -95,11 +106,16 @@ type SessionState struct {
 	AutoTiling       bool           `json:"auto_tiling"`
 	Width            int            `json:"width"`
 	Height           int            `json:"height"`
-	// Input mode (window-management vs terminal) is deliberately absent: it is
-	// per-viewer, not per-session. It used to live here, which meant one client
-	// entering terminal mode flipped the input mode of every other client
-	// attached to the same session. Clients own their own mode.
-	//
+	// LastMode is the input mode of the client that last pushed state:
+	// LastModeTerminal or LastModeWindowManagement. Input mode is per-viewer,
+	// not per-session, and is never applied from here. It used to be, which
+	// meant one client entering terminal mode flipped the mode of every other
+	// client attached to the same session; ApplyStateSync never reads this
+	// field, so clients keep their own modes. It is only a record, read by a
+	// client as it attaches, and only with startup.restore_mode_on_attach, to
+	// come back in the mode it detached in.
+	LastMode string `json:"last_mode,omitempty"`
+
 	// BSP tiling state
 
 	WorkspaceTrees  map[int]*SerializedBSPTree `json:"workspace_trees,omitempty"`  // BSP tree per workspace
@@ -191,6 +207,10 @@ type PTY struct {
 	exited   bool
 	exitedMu sync.RWMutex
 	exitCode int
+	// replaced is set (under exitedMu) when a reload swaps this PTY out from
+	// under its window. The shell is killed on purpose and the window lives on,
+	// so its exit is not reported to clients or raised as a window exit.
+	replaced bool
 
 	// Single-goroutine VT writer channel. Closed by readOutput on exit so
 	// vtWriter's range terminates.
@@ -208,9 +228,11 @@ type PTY struct {
 // Session represents a persistent TUIOS session.
 // The daemon manages PTYs and stores state; the client runs the TUI.
 type Session struct {
-	// Identity
-	ID   string
-	Name string
+	// Identity. The name changes on rename while other goroutines read it,
+	// so it is only reached through Name.
+	ID     string
+	name   string
+	nameMu sync.RWMutex
 
 	// PTYs managed by this session
 	ptys   map[string]*PTY
@@ -299,7 +321,7 @@ func NewSession(name string, cfg *SessionConfig, width, height int) (*Session, e
 
 	session := &Session{
 		ID:   id,
-		Name: name,
+		name: name,
 		ptys: make(map[string]*PTY),
 		state: &SessionState{
 			Name:             name,
@@ -418,9 +440,10 @@ func (s *Session) createPTY(windowID string, width, height int, cwd string, rest
 	// Create command
 	cmd := exec.Command(shell)
 	cmd.Env = s.buildEnv(windowID, restored)
-	// Start a restored shell in its saved working directory when it still
-	// exists; otherwise fall back to the shell's default (inherited) directory.
-	if restored && cwd != "" {
+	// Start the shell in the requested directory (a restored shell's saved one,
+	// or one a caller asked for) when it still exists; otherwise fall back to
+	// the shell's default (inherited) directory.
+	if cwd != "" {
 		if info, statErr := os.Stat(cwd); statErr == nil && info.IsDir() {
 			cmd.Dir = cwd
 		}
@@ -755,6 +778,25 @@ func (s *Session) emitLifecycleLocked(before lifecycleSnapshot) {
 	}
 }
 
+// rename sets the session's name in its state and pushes the change to attached
+// clients, whose dock and session switcher read it from the synced state.
+func (s *Session) rename(name string) {
+	_ = s.mutateState(func(state *SessionState) error {
+		s.nameMu.Lock()
+		s.name = name
+		s.nameMu.Unlock()
+		state.Name = name
+		return nil
+	})
+}
+
+// Name returns the session's current name.
+func (s *Session) Name() string {
+	s.nameMu.RLock()
+	defer s.nameMu.RUnlock()
+	return s.name
+}
+
 // Stop closes all PTYs and cleans up.
 func (s *Session) Stop() {
 	// Stop resurrection saving
@@ -810,12 +852,12 @@ func (s *Session) Info() SessionInfo {
 	s.sizeMu.RUnlock()
 
 	return SessionInfo{
-		Name:        s.Name,
+		Name:        s.Name(),
 		ID:          s.ID,
 		Created:     s.Created.Unix(),
 		LastActive:  s.LastActive.Unix(),
 		WindowCount: s.WindowCount(),
-		Attached:    false, // Will be set by manager
+		Attached:    false, // Set by Daemon.listSessions
 		Width:       width,
 		Height:      height,
 	}
@@ -851,7 +893,7 @@ func (s *Session) buildEnv(windowID string, restored bool) []string {
 	kitty, sixel := s.GraphicsCapabilities()
 	env = append(env, "TERM_PROGRAM="+guestenv.TermProgram(kitty, sixel))
 	env = append(env, "TERM_PROGRAM_VERSION=0.1.0")
-	env = append(env, "TUIOS_SESSION="+s.Name)
+	env = append(env, "TUIOS_SESSION="+s.Name())
 	if windowID != "" {
 		env = append(env, "TUIOS_WINDOW_ID="+windowID)
 	}
@@ -1345,9 +1387,13 @@ func (p *PTY) monitorExit() {
 	if p.cmd.ProcessState != nil {
 		p.exitCode = p.cmd.ProcessState.ExitCode()
 	}
+	replaced := p.replaced
 	p.exitedMu.Unlock()
 
 	debugLog("[DEBUG] PTY %s: process exited with code %d", p.ID[:8], p.exitCode)
+	if replaced {
+		return
+	}
 
 	// Notify callback (used by daemon to inform clients)
 	if p.onExit != nil {
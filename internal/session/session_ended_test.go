@@ -199,3 +199,28 @@ func TestSessionEndedLeavesTheConnectionUsable(t *testing.T) {
 		t.Fatalf("the connection was unusable after session-ended: %v", err)
 	}
 }
+
+// TestAttachReportsExistingSession checks AttachedPayload.Existing, which
+// startup.on_empty_session_attach relies on to tell an emptied session from one
+// the attach just created.
+func TestAttachReportsExistingSession(t *testing.T) {
+	startTestDaemon(t)
+
+	c := NewTUIClient()
+	if err := c.Connect("test", 80, 24); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+
+	if _, err := c.AttachSession("fresh", true, 80, 24); err != nil {
+		t.Fatalf("attach fresh: %v", err)
+	}
+	if c.AttachedExisting() {
+		t.Error("attach that created the session reported it as existing")
+	}
+
+	again := attachTestClient(t, "fresh")
+	if !again.AttachedExisting() {
+		t.Error("attach to a live session did not report it as existing")
+	}
+}
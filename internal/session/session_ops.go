@@ -104,6 +104,19 @@ func firstVisibleOnWorkspace(windows []WindowState, workspace int) string {
 	return ""
 }
 
+// DaemonWindowSpec describes a window for AddDaemonWindowSpec. The zero value
+// is the plain new window AddDaemonWindow creates.
+type DaemonWindowSpec struct {
+	Title     string // empty uses the default "Terminal <id>" title
+	Workspace int    // 0 means the current workspace
+	Dir       string // shell's starting directory when it exists; empty inherits the daemon's
+	Command   string // command line typed into the shell once it starts
+	// Detached leaves focus where it is instead of moving it to the new
+	// window. A window on another workspace never takes focus away from the
+	// current one either way.
+	Detached bool
+}
+
 // AddDaemonWindow spawns a fresh PTY and appends a canonical window for it to
 // the session state, focusing it on the current workspace. onExit (may be nil)
 // is invoked with the PTY ID when the shell process exits. It returns a copy of
@@ -111,6 +124,18 @@ func firstVisibleOnWorkspace(windows []WindowState, workspace int) string {
 // a new window; geometry is a nominal full-size box that a client re-tiles on
 // attach.
 func (s *Session) AddDaemonWindow(title string, onExit func(ptyID string)) (WindowState, error) {
+	return s.AddDaemonWindowSpec(DaemonWindowSpec{Title: title}, onExit)
+}
+
+// AddDaemonWindowSpec is AddDaemonWindow with control over where the window
+// goes and whether it takes focus. A window created on a workspace other than
+// the current one leaves the current workspace and its focus untouched, and
+// becomes that workspace's remembered focus only if it had none.
+func (s *Session) AddDaemonWindowSpec(spec DaemonWindowSpec, onExit func(ptyID string)) (WindowState, error) {
+	if bound := s.GetState().workspaceBound(); spec.Workspace < 0 || spec.Workspace > bound {
+		return WindowState{}, fmt.Errorf("workspace %d out of range (1-%d)", spec.Workspace, bound)
+	}
+
 	width, height := s.Size()
 	if width <= 0 {
 		width = 80
@@ -125,26 +150,38 @@ func (s *Session) AddDaemonWindow(title string, onExit func(ptyID string)) (Wind
 	ptyHeight := max(height-2, 1)
 
 	windowID := uuid.New().String()
+	title := spec.Title
 	if title == "" {
 		// The same default the renderer used when it still created windows
 		// itself, so a window looks the same however it was asked for.
 		title = "Terminal " + windowID[:8]
 	}
-	pty, err := s.CreatePTY(windowID, ptyWidth, ptyHeight, onExit)
+	pty, err := s.createPTY(windowID, ptyWidth, ptyHeight, spec.Dir, false, onExit)
 	if err != nil {
 		return WindowState{}, err
 	}
 
+	// The command is typed into the new shell rather than run in its place, so
+	// the window outlives it and stays usable, the same as a window the user
+	// opened and typed into.
+	if spec.Command != "" {
+		if _, err := pty.Write([]byte(spec.Command + "\n")); err != nil {
+			return WindowState{}, err
+		}
+	}
+
 	var win WindowState
 	_ = s.mutateState(func(state *SessionState) error {
 		if state.WorkspaceFocus == nil {
 			state.WorkspaceFocus = make(map[int]string)
 		}
-		workspace := state.CurrentWorkspace
-		if workspace < 1 {
-			workspace = 1
+		if state.CurrentWorkspace < 1 {
 			state.CurrentWorkspace = 1
 		}
+		workspace := spec.Workspace
+		if workspace == 0 {
+			workspace = state.CurrentWorkspace
+		}
 
 		win = WindowState{
 			ID:        windowID,
@@ -158,10 +195,17 @@ func (s *Session) AddDaemonWindow(title string, onExit func(ptyID string)) (Wind
 			// The daemon has no viewport, so this box is a placeholder that keeps
 			// the PTY a usable size until a client places the window properly.
 			Unplaced: true,
+			Command:  spec.Command,
 		}
 		state.Windows = append(state.Windows, win)
-		state.FocusedWindowID = windowID
-		state.WorkspaceFocus[workspace] = windowID
+
+		current := workspace == state.CurrentWorkspace
+		if current && (!spec.Detached || state.FocusedWindowID == "") {
+			state.FocusedWindowID = windowID
+			state.WorkspaceFocus[workspace] = windowID
+		} else if state.WorkspaceFocus[workspace] == "" {
+			state.WorkspaceFocus[workspace] = windowID
+		}
 		return nil
 	})
 	return win, nil
@@ -211,6 +255,71 @@ func (s *Session) CloseDaemonWindow(target string) (string, error) {
 	return closed.ID, nil
 }
 
+// ReloadDaemonWindow replaces the shell behind the window matching target with
+// a fresh one in the directory the old one was in, and types the window's
+// Command into it. The window keeps its ID and everything else in its state;
+// only its PTYID changes, which is how an attached client learns to rebind.
+// The old PTY is closed without reporting an exit, since the window has not
+// gone anywhere.
+func (s *Session) ReloadDaemonWindow(target string, onExit func(ptyID string)) error {
+	state := s.GetState()
+	idx, err := findWindowStateIndex(state.Windows, target)
+	if err != nil {
+		return err
+	}
+	win := state.Windows[idx]
+
+	cwd := win.Cwd
+	old := s.GetPTY(win.PTYID)
+	if old != nil {
+		if dir, ok := old.ProcessCwd(); ok {
+			cwd = dir
+		}
+	}
+
+	// WindowState dimensions are the outer window box (including the border);
+	// the shell gets the inner content size, matching AddDaemonWindowSpec.
+	ptyWidth := max(win.Width-2, 1)
+	ptyHeight := max(win.Height-2, 1)
+	if old != nil {
+		if w, h := old.Size(); w > 0 && h > 0 {
+			ptyWidth, ptyHeight = w, h
+		}
+	}
+
+	pty, err := s.createPTY(win.ID, ptyWidth, ptyHeight, cwd, false, onExit)
+	if err != nil {
+		return err
+	}
+	if win.Command != "" {
+		if _, err := pty.Write([]byte(win.Command + "\n")); err != nil {
+			_ = s.ClosePTY(pty.ID)
+			return err
+		}
+	}
+
+	err = s.mutateState(func(state *SessionState) error {
+		idx, err := findWindowStateIndex(state.Windows, win.ID)
+		if err != nil {
+			return err
+		}
+		state.Windows[idx].PTYID = pty.ID
+		return nil
+	})
+	if err != nil {
+		_ = s.ClosePTY(pty.ID)
+		return err
+	}
+
+	if old != nil {
+		old.exitedMu.Lock()
+		old.replaced = true
+		old.exitedMu.Unlock()
+		_ = s.ClosePTY(old.ID)
+	}
+	return nil
+}
+
 // FocusDaemonWindow makes the window matching target the focused window,
 // switching the current workspace to that window's workspace.
 func (s *Session) FocusDaemonWindow(target string) error {
@@ -277,6 +386,7 @@ func (s *Session) RenameDaemonWindow(target, name string) error {
 			return err
 		}
 		state.Windows[idx].CustomName = name
+		state.Windows[idx].AutoNamed = false
 		return nil
 	})
 }
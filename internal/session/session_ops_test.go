@@ -2,6 +2,7 @@ package session
 
 import (
 	"testing"
+	"time"
 )
 
 // newTestSession creates a session backed by a real shell for the state-op tests.
@@ -94,6 +95,44 @@ func TestCloseDaemonWindowByName(t *testing.T) {
 	}
 }
 
+func TestReloadDaemonWindow(t *testing.T) {
+	sess := newTestSession(t)
+
+	exited := make(chan string, 2)
+	onExit := func(ptyID string) { exited <- ptyID }
+	win, err := sess.AddDaemonWindowSpec(DaemonWindowSpec{Command: "true"}, onExit)
+	if err != nil {
+		t.Fatalf("AddDaemonWindowSpec failed: %v", err)
+	}
+
+	if err := sess.ReloadDaemonWindow(win.ID, onExit); err != nil {
+		t.Fatalf("ReloadDaemonWindow failed: %v", err)
+	}
+
+	state := sess.GetState()
+	if len(state.Windows) != 1 || state.Windows[0].ID != win.ID {
+		t.Fatalf("windows = %+v, want the same single window", state.Windows)
+	}
+	got := state.Windows[0]
+	if got.PTYID == "" || got.PTYID == win.PTYID {
+		t.Fatalf("PTYID = %q, want a fresh PTY (old %q)", got.PTYID, win.PTYID)
+	}
+	if got.Command != "true" {
+		t.Errorf("Command = %q, want it kept for the next reload", got.Command)
+	}
+	if sess.GetPTY(win.PTYID) != nil {
+		t.Error("old PTY still registered on the session")
+	}
+	if pty := sess.GetPTY(got.PTYID); pty == nil || pty.IsExited() {
+		t.Fatal("new PTY is not live")
+	}
+	select {
+	case id := <-exited:
+		t.Errorf("reload reported an exit for PTY %s", id)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
 func TestFocusAndCycleDaemonWindows(t *testing.T) {
 	sess := newTestSession(t)
 
@@ -203,3 +242,30 @@ func TestFindWindowStateIndexAmbiguity(t *testing.T) {
 		t.Error("expected no-match error")
 	}
 }
+
+// TestListSessionsCountsAttachedClients checks the session list reports each
+// session's attached TUI clients, which kill-server shows before stopping.
+func TestListSessionsCountsAttachedClients(t *testing.T) {
+	d := NewDaemon(&DaemonConfig{Version: "test", DisableAutoRestore: true})
+	defer d.manager.Shutdown()
+
+	watched, err := d.manager.CreateSession("watched", &SessionConfig{}, 80, 24)
+	if err != nil {
+		t.Fatalf("CreateSession: %v", err)
+	}
+	if _, err := d.manager.CreateSession("idle", &SessionConfig{}, 80, 24); err != nil {
+		t.Fatalf("CreateSession: %v", err)
+	}
+	newFakeTUI(t, d, watched.ID)
+
+	for _, info := range d.listSessions() {
+		wantClients := 0
+		if info.ID == watched.ID {
+			wantClients = 1
+		}
+		if info.Clients != wantClients || info.Attached != (wantClients > 0) {
+			t.Errorf("session %q: clients = %d, attached = %v; want %d, %v",
+				info.Name, info.Clients, info.Attached, wantClients, wantClients > 0)
+		}
+	}
+}
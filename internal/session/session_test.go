@@ -2,7 +2,13 @@ package session
 
 import (
 	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+
+	"github.com/adrg/xdg"
 )
 
 // TestProtocolMessages tests the protocol message encoding/decoding
@@ -153,8 +159,8 @@ func TestSessionManager(t *testing.T) {
 		t.Fatalf("CreateSession failed: %v", err)
 	}
 
-	if session.Name != "test-session" {
-		t.Errorf("Session name mismatch: got %s, want test-session", session.Name)
+	if session.Name() != "test-session" {
+		t.Errorf("Session name mismatch: got %s, want test-session", session.Name())
 	}
 
 	// Test getting session
@@ -193,6 +199,59 @@ func TestSessionManager(t *testing.T) {
 	}
 }
 
+// TestRenameSessionWhileListing renames a session while other goroutines list
+// sessions and read its name. Run with -race: the name is written by the
+// renaming goroutine and read by the listing ones.
+func TestRenameSessionWhileListing(t *testing.T) {
+	t.Cleanup(useResurrectionDir(t.TempDir()))
+	mgr := NewManager()
+	defer mgr.Shutdown()
+
+	session, err := mgr.CreateSession("rename-0", &SessionConfig{}, 80, 24)
+	if err != nil {
+		t.Fatalf("CreateSession failed: %v", err)
+	}
+
+	const renames = 50
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				for _, info := range mgr.ListSessions() {
+					if info.Name == "" {
+						t.Error("ListSessions reported an empty session name")
+					}
+				}
+				_ = session.Name()
+			}
+		}()
+	}
+
+	for i := 1; i <= renames; i++ {
+		if err := mgr.RenameSession(fmt.Sprintf("rename-%d", i-1), fmt.Sprintf("rename-%d", i)); err != nil {
+			t.Fatalf("RenameSession failed: %v", err)
+		}
+	}
+	close(done)
+	wg.Wait()
+
+	want := fmt.Sprintf("rename-%d", renames)
+	if got := session.Name(); got != want {
+		t.Errorf("Name() = %q, want %q", got, want)
+	}
+	if infos := mgr.ListSessions(); len(infos) != 1 || infos[0].Name != want {
+		t.Errorf("ListSessions = %+v, want one session named %q", infos, want)
+	}
+}
+
 // TestSessionNameGeneration tests automatic session name generation
 func TestSessionNameGeneration(t *testing.T) {
 	mgr := NewManager()
@@ -213,6 +272,32 @@ func TestSessionNameGeneration(t *testing.T) {
 	}
 }
 
+// TestSessionNameGenerationFollowsFormat checks a session the daemon names
+// itself (an unnamed create, or the default session) uses
+// startup.session_name_format like the CLI does.
+func TestSessionNameGenerationFollowsFormat(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	xdg.Reload()
+	t.Cleanup(xdg.Reload)
+	if err := os.MkdirAll(filepath.Join(dir, "tuios"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	cfg := []byte("[startup]\nsession_name_format = \"work-{n}\"\n")
+	if err := os.WriteFile(filepath.Join(dir, "tuios", "config.toml"), cfg, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	mgr := NewManager()
+	if name := mgr.GenerateSessionName(); name != "work-0" {
+		t.Errorf("First generated name: got %s, want work-0", name)
+	}
+	_, _ = mgr.CreateSession("work-0", nil, 80, 24)
+	if name := mgr.GenerateSessionName(); name != "work-1" {
+		t.Errorf("Second generated name: got %s, want work-1", name)
+	}
+}
+
 // TestGetOrCreateSession tests the get-or-create functionality
 func TestGetOrCreateSession(t *testing.T) {
 	mgr := NewManager()
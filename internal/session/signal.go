@@ -0,0 +1,50 @@
+package session
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// ParseSignal resolves a signal as the SignalWindow command and the
+// signal-window verb accept it: a short name ("INT"), the SIG-prefixed form
+// ("SIGINT"), either in any case, or the signal number. Only the signals in
+// signalsByName are accepted, so a typo cannot deliver something unexpected.
+func ParseSignal(name string) (syscall.Signal, error) {
+	trimmed := strings.ToUpper(strings.TrimSpace(name))
+	if n, err := strconv.Atoi(trimmed); err == nil {
+		for _, sig := range signalsByName {
+			if int(sig) == n {
+				return sig, nil
+			}
+		}
+		return 0, fmt.Errorf("unsupported signal %q (accepted: %s)", name, strings.Join(SignalNames(), ", "))
+	}
+	if sig, ok := signalsByName[strings.TrimPrefix(trimmed, "SIG")]; ok {
+		return sig, nil
+	}
+	return 0, fmt.Errorf("unknown signal %q (accepted: %s)", name, strings.Join(SignalNames(), ", "))
+}
+
+// SignalName returns the short name ParseSignal accepts for sig, or its number
+// when it has none.
+func SignalName(sig syscall.Signal) string {
+	for name, s := range signalsByName {
+		if s == sig {
+			return name
+		}
+	}
+	return strconv.Itoa(int(sig))
+}
+
+// SignalNames returns the accepted short signal names in sorted order.
+func SignalNames() []string {
+	names := make([]string, 0, len(signalsByName))
+	for name := range signalsByName {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
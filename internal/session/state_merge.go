@@ -20,8 +20,12 @@ import "maps"
 // retainDaemonExclusive carries over the parts of canonical state that no client
 // ever sets, so a sync that simply omits them does not wipe them. Options come
 // from the JSON verb protocol; Cwd is captured daemon-side from the live shell
-// process; ResurrectionVersion is stamped when state is written to disk.
+// process; ResurrectionVersion is stamped when state is written to disk. A
+// window's Command is set when the daemon creates it, and a client that never
+// learned it must not erase it. The session name only changes through
+// rename-session, so a client still holding the old name cannot put it back.
 func retainDaemonExclusive(incoming, canonical *SessionState) {
+	incoming.Name = canonical.Name
 	if incoming.Options == nil {
 		incoming.Options = canonical.Options
 	}
@@ -29,15 +33,20 @@ func retainDaemonExclusive(incoming, canonical *SessionState) {
 		incoming.ResurrectionVersion = canonical.ResurrectionVersion
 	}
 
-	cwds := make(map[string]string, len(canonical.Windows))
+	byID := make(map[string]*WindowState, len(canonical.Windows))
 	for i := range canonical.Windows {
-		if cwd := canonical.Windows[i].Cwd; cwd != "" {
-			cwds[canonical.Windows[i].ID] = cwd
-		}
+		byID[canonical.Windows[i].ID] = &canonical.Windows[i]
 	}
 	for i := range incoming.Windows {
+		cw := byID[incoming.Windows[i].ID]
+		if cw == nil {
+			continue
+		}
 		if incoming.Windows[i].Cwd == "" {
-			incoming.Windows[i].Cwd = cwds[incoming.Windows[i].ID]
+			incoming.Windows[i].Cwd = cw.Cwd
+		}
+		if incoming.Windows[i].Command == "" {
+			incoming.Windows[i].Command = cw.Command
 		}
 	}
 }
@@ -73,8 +82,11 @@ func reconcileStale(incoming, canonical *SessionState, hasLivePTY func(ptyID str
 			continue
 		}
 		win.CustomName = cw.CustomName
+		win.AutoNamed = cw.AutoNamed
 		win.Workspace = cw.Workspace
 		win.Minimized = cw.Minimized
+		// A reload swaps the PTY behind a window in place.
+		win.PTYID = cw.PTYID
 		seen[win.ID] = true
 		kept = append(kept, win)
 	}
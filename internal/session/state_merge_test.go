@@ -263,6 +263,7 @@ func TestClientSyncKeepsDaemonExclusiveFields(t *testing.T) {
 	// snapshot that carries neither options nor cwd.
 	seeded := sess.GetState()
 	seeded.Windows[0].Cwd = "/home/user/project"
+	seeded.Windows[0].Command = "npm run dev"
 	seeded.ResurrectionVersion = 2
 	sess.UpdateState(seeded)
 
@@ -271,6 +272,7 @@ func TestClientSyncKeepsDaemonExclusiveFields(t *testing.T) {
 	sync.ResurrectionVersion = 0
 	for i := range sync.Windows {
 		sync.Windows[i].Cwd = ""
+		sync.Windows[i].Command = ""
 	}
 	sess.UpdateState(sync)
 
@@ -284,6 +286,36 @@ func TestClientSyncKeepsDaemonExclusiveFields(t *testing.T) {
 	if w := windowByID(t, got, win.ID); w == nil || w.Cwd != "/home/user/project" {
 		t.Errorf("window = %+v, want the daemon-captured cwd to survive", w)
 	}
+	if w := windowByID(t, got, win.ID); w == nil || w.Command != "npm run dev" {
+		t.Errorf("window = %+v, want the spawn command to survive", w)
+	}
+}
+
+// TestStaleClientSyncKeepsReloadedPTY pushes a snapshot taken before a reload.
+// The window is the same one, but the PTY it names is the one the reload
+// killed, and taking it would leave the window pointing at nothing.
+func TestStaleClientSyncKeepsReloadedPTY(t *testing.T) {
+	sess, err := NewSession("reload", &SessionConfig{Shell: "/bin/sh"}, 80, 24)
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	defer sess.Stop()
+
+	win, err := sess.AddDaemonWindow("shell", nil)
+	if err != nil {
+		t.Fatalf("AddDaemonWindow: %v", err)
+	}
+	stale := clientSnapshot(sess)
+
+	if err := sess.ReloadDaemonWindow(win.ID, nil); err != nil {
+		t.Fatalf("ReloadDaemonWindow: %v", err)
+	}
+	fresh := windowByID(t, sess.GetState(), win.ID).PTYID
+
+	sess.UpdateState(stale)
+	if w := windowByID(t, sess.GetState(), win.ID); w == nil || w.PTYID != fresh {
+		t.Errorf("window = %+v, want PTYID %q from the reload", w, fresh)
+	}
 }
 
 // TestStaleClientSyncCannotResurrectAClosedWindow is the counterpart to
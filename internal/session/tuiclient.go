@@ -47,6 +47,8 @@ type TUIClient struct {
 
 	sessionID   string
 	sessionName string
+	// attachedExisting records AttachedPayload.Existing from the last attach.
+	attachedExisting bool
 
 	// Available session names from daemon
 	availableSessionNames []string
@@ -223,6 +225,7 @@ func (c *TUIClient) AttachSession(name string, createNew bool, width, height int
 		}
 		c.sessionID = payload.SessionID
 		c.sessionName = payload.SessionName
+		c.attachedExisting = payload.Existing
 		return payload.State, nil
 
 	case MsgError:
@@ -980,6 +983,12 @@ func (c *TUIClient) SessionName() string {
 	return c.sessionName
 }
 
+// AttachedExisting reports whether the last attach joined a session that
+// already existed, as opposed to one the attach created.
+func (c *TUIClient) AttachedExisting() bool {
+	return c.attachedExisting
+}
+
 // AvailableSessionNames returns the list of available sessions from the daemon.
 func (c *TUIClient) AvailableSessionNames() []string {
 	c.mu.Lock()
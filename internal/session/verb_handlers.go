@@ -3,6 +3,8 @@ package session
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -69,7 +71,7 @@ func mapResolveErr(err error, sess *Session) *verbError {
 			Detail:  "This command changes what is drawn on screen, so it only runs with a client attached. Attach to the session, then retry.",
 		}
 		if sess != nil {
-			hint.Command = "tuios attach " + sess.Name
+			hint.Command = "tuios attach " + sess.Name()
 		}
 		return hintedVerbError(ErrVerbNeedsClient, msg, hint)
 	}
@@ -127,7 +129,7 @@ type commonParams struct {
 func (d *Daemon) verbListSessions(_ *connState, _ json.RawMessage) (any, *verbError) {
 	return map[string]any{
 		"type":     "session_list",
-		"sessions": d.manager.ListSessions(),
+		"sessions": d.listSessions(),
 	}, nil
 }
 
@@ -166,8 +168,12 @@ func (d *Daemon) verbListWindows(_ *connState, params json.RawMessage) (any, *ve
 
 func (d *Daemon) verbNewWindow(_ *connState, params json.RawMessage) (any, *verbError) {
 	var p struct {
-		Session string `json:"session"`
-		Name    string `json:"name"`
+		Session   string `json:"session"`
+		Name      string `json:"name"`
+		Workspace int    `json:"workspace"`
+		Cwd       string `json:"cwd"`
+		Command   string `json:"command"`
+		Detached  bool   `json:"detached"`
 	}
 	if verr := decodeParams(params, &p); verr != nil {
 		return nil, verr
@@ -176,10 +182,13 @@ func (d *Daemon) verbNewWindow(_ *connState, params json.RawMessage) (any, *verb
 	if verr != nil {
 		return nil, verr
 	}
-
-	var args []string
-	if p.Name != "" {
-		args = []string{p.Name}
+	if bound := sess.GetState().workspaceBound(); p.Workspace < 0 || p.Workspace > bound {
+		return nil, invalidParam("workspace", fmt.Sprintf("workspace %d out of range (1-%d)", p.Workspace, bound))
+	}
+	if p.Cwd != "" {
+		if info, err := os.Stat(p.Cwd); err != nil || !info.IsDir() {
+			return nil, invalidParam("cwd", "not a directory: "+p.Cwd)
+		}
 	}
 
 	// Creating runs against daemon state whether or not a client is attached: the
@@ -187,10 +196,12 @@ func (d *Daemon) verbNewWindow(_ *connState, params json.RawMessage) (any, *verb
 	// window from the state push and places it, so there is no round trip to the
 	// client that can time out and no second creation path to keep in step.
 	onExit := func(ptyID string) { d.notifyPTYClosed(sess.ID, ptyID) }
-	data, err := d.executeDaemonCommand(sess, "NewWindow", args, onExit)
+	spec := DaemonWindowSpec{Workspace: p.Workspace, Dir: p.Cwd, Command: p.Command, Detached: p.Detached}
+	data, err := newDaemonWindow(sess, spec, p.Name, onExit)
 	if err != nil {
 		return nil, mapResolveErr(err, sess)
 	}
+
 	out := map[string]any{"type": "window_created"}
 	for k, v := range data {
 		out[k] = v
@@ -378,6 +389,36 @@ func (d *Daemon) verbResize(_ *connState, params json.RawMessage) (any, *verbErr
 	return map[string]any{"type": "resized", "width": p.Width, "height": p.Height}, nil
 }
 
+func (d *Daemon) verbSignalWindow(_ *connState, params json.RawMessage) (any, *verbError) {
+	var p struct {
+		Session string `json:"session"`
+		Window  string `json:"window"`
+		Signal  string `json:"signal"`
+	}
+	if verr := decodeParams(params, &p); verr != nil {
+		return nil, verr
+	}
+	sig, err := ParseSignal(p.Signal)
+	if err != nil {
+		return nil, hintedVerbError(ErrVerbInvalidParams, err.Error(), &VerbHint{Param: "signal", Accepted: SignalNames()})
+	}
+	sess, verr := d.resolveVerbSession(p.Session)
+	if verr != nil {
+		return nil, verr
+	}
+
+	// The processes belong to the daemon-owned PTY, so the signal is delivered
+	// here whether or not a client is attached.
+	pty, err := d.resolvePTYForTarget(sess, p.Window)
+	if err != nil {
+		return nil, mapResolveErr(err, sess)
+	}
+	if err := pty.Signal(sig); err != nil {
+		return nil, newVerbError(ErrVerbInternal, err.Error())
+	}
+	return map[string]any{"type": "ok", "signal": SignalName(sig)}, nil
+}
+
 func (d *Daemon) verbKillSession(_ *connState, params json.RawMessage) (any, *verbError) {
 	var p struct {
 		Session string `json:"session"`
@@ -402,6 +443,71 @@ func (d *Daemon) verbKillSession(_ *connState, params json.RawMessage) (any, *ve
 	return map[string]any{"type": "ok"}, nil
 }
 
+func (d *Daemon) verbRenameSession(_ *connState, params json.RawMessage) (any, *verbError) {
+	var p struct {
+		Session string `json:"session"`
+		Name    string `json:"name"`
+	}
+	if verr := decodeParams(params, &p); verr != nil {
+		return nil, verr
+	}
+	if p.Session == "" {
+		return nil, hintedVerbError(ErrVerbInvalidParams,
+			"session is required (rename-session never guesses which session to rename)",
+			&VerbHint{Param: "session", Command: "tuios ls", Available: d.sessionNames()})
+	}
+	if p.Name == "" {
+		return nil, invalidParam("name", "name is required")
+	}
+	sess := d.manager.GetSession(p.Session)
+	if sess == nil {
+		available := d.sessionNames()
+		return nil, hintedVerbError(ErrVerbSessionNotFound, "session '"+p.Session+"' not found", &VerbHint{
+			Param:      "session",
+			Command:    "tuios ls",
+			DidYouMean: closestMatch(p.Session, available),
+			Available:  available,
+		})
+	}
+	if err := d.manager.RenameSession(p.Session, p.Name); err != nil {
+		return nil, hintedVerbError(ErrVerbInvalidParams, err.Error(), &VerbHint{
+			Param:     "name",
+			Command:   "tuios ls",
+			Available: d.sessionNames(),
+		})
+	}
+	d.installSessionSinks(sess)
+	return map[string]any{"type": "ok", "session": p.Name}, nil
+}
+
+func (d *Daemon) verbSaveSession(_ *connState, params json.RawMessage) (any, *verbError) {
+	var p struct {
+		Session string `json:"session"`
+	}
+	if verr := decodeParams(params, &p); verr != nil {
+		return nil, verr
+	}
+	if p.Session == "" {
+		return nil, hintedVerbError(ErrVerbInvalidParams, "session is required",
+			&VerbHint{Param: "session", Command: "tuios ls", Available: d.sessionNames()})
+	}
+	sess := d.manager.GetSession(p.Session)
+	if sess == nil {
+		available := d.sessionNames()
+		return nil, hintedVerbError(ErrVerbSessionNotFound, "session '"+p.Session+"' not found", &VerbHint{
+			Param:      "session",
+			Command:    "tuios ls",
+			DidYouMean: closestMatch(p.Session, available),
+			Available:  available,
+		})
+	}
+	state := sess.ResurrectionState()
+	if err := SaveSessionForResurrection(state); err != nil {
+		return nil, newVerbError(ErrVerbInternal, "failed to save session: "+err.Error())
+	}
+	return map[string]any{"type": "ok", "session": p.Session, "windows": len(state.Windows)}, nil
+}
+
 func (d *Daemon) verbSetOption(_ *connState, params json.RawMessage) (any, *verbError) {
 	var p struct {
 		Session string `json:"session"`
@@ -456,7 +562,7 @@ func (d *Daemon) verbGetOption(_ *connState, params json.RawMessage) (any, *verb
 	value, ok := sess.GetOption(p.Key)
 	if !ok {
 		available := sess.OptionKeys()
-		return nil, hintedVerbError(ErrVerbOptionNotFound, "option "+p.Key+" is not set on session "+sess.Name, &VerbHint{
+		return nil, hintedVerbError(ErrVerbOptionNotFound, "option "+p.Key+" is not set on session "+sess.Name(), &VerbHint{
 			Param:      "key",
 			Verb:       "set-option",
 			Command:    "tuios set-config " + p.Key + " <value>",
@@ -161,3 +161,58 @@ func TestClientSyncClearsUnplaced(t *testing.T) {
 		t.Errorf("geometry = %d,%d %dx%d, want the client's 10,5 40x12", got.X, got.Y, got.Width, got.Height)
 	}
 }
+
+// TestNewWindowDetachedOnAnotherWorkspace covers scripting a running layout
+// from outside: a window created on another workspace, detached, lands there
+// without moving the user off their workspace or stealing focus.
+func TestNewWindowDetachedOnAnotherWorkspace(t *testing.T) {
+	d := NewDaemon(&DaemonConfig{Version: "test", DisableAutoRestore: true})
+	defer d.manager.Shutdown()
+
+	sess, err := d.manager.CreateSession("scripted", &SessionConfig{}, 80, 24)
+	if err != nil {
+		t.Fatalf("CreateSession failed: %v", err)
+	}
+	first, err := sess.AddDaemonWindow("", nil)
+	if err != nil {
+		t.Fatalf("AddDaemonWindow failed: %v", err)
+	}
+
+	dir := t.TempDir()
+	params, _ := json.Marshal(map[string]any{"session": "scripted", "workspace": 3, "cwd": dir, "detached": true})
+	out, verr := d.verbNewWindow(nil, params)
+	if verr != nil {
+		t.Fatalf("verbNewWindow: %v", verr)
+	}
+	windowID, _ := out.(map[string]any)["window_id"].(string)
+
+	state := sess.GetState()
+	if state.CurrentWorkspace != 1 {
+		t.Errorf("CurrentWorkspace = %d, want 1", state.CurrentWorkspace)
+	}
+	if state.FocusedWindowID != first.ID {
+		t.Errorf("FocusedWindowID = %q, want the existing window %q", state.FocusedWindowID, first.ID)
+	}
+	if state.WorkspaceFocus[3] != windowID {
+		t.Errorf("WorkspaceFocus[3] = %q, want the new window", state.WorkspaceFocus[3])
+	}
+	var created *WindowState
+	for i := range state.Windows {
+		if state.Windows[i].ID == windowID {
+			created = &state.Windows[i]
+		}
+	}
+	if created == nil {
+		t.Fatal("the new window is not in daemon state")
+	}
+	if created.Workspace != 3 {
+		t.Errorf("Workspace = %d, want 3", created.Workspace)
+	}
+
+	if _, verr := d.verbNewWindow(nil, json.RawMessage(`{"session":"scripted","workspace":99}`)); verr == nil || verr.Code != ErrVerbInvalidParams {
+		t.Errorf("workspace 99: got %v, want invalid_params", verr)
+	}
+	if _, verr := d.verbNewWindow(nil, json.RawMessage(`{"session":"scripted","cwd":"/no/such/dir"}`)); verr == nil || verr.Code != ErrVerbInvalidParams {
+		t.Errorf("missing cwd: got %v, want invalid_params", verr)
+	}
+}
@@ -192,9 +192,16 @@ func init() {
 			params: []verbParam{
 				sessionParam,
 				{Name: "name", Type: "string", Description: "Name for the new window. Omit to use the shell's title."},
+				{Name: "workspace", Type: "int", Description: "Workspace to create the window on. Omit for the current workspace."},
+				{Name: "cwd", Type: "string", Description: "Directory to start the shell in. Omit to inherit the daemon's."},
+				{Name: "command", Type: "string", Description: "Command line to type into the new shell once it starts."},
+				{Name: "detached", Type: "bool", Description: "Leave focus where it is instead of moving it to the new window.", Default: "false"},
 			},
-			examples: []string{`{"id":1,"verb":"new-window","params":{"session":"work","name":"build"}}`},
-			handler:  (*Daemon).verbNewWindow,
+			examples: []string{
+				`{"id":1,"verb":"new-window","params":{"session":"work","name":"build"}}`,
+				`{"id":2,"verb":"new-window","params":{"session":"work","workspace":3,"cwd":"/src/app","command":"make watch","detached":true}}`,
+			},
+			handler: (*Daemon).verbNewWindow,
 		},
 		"close-window": {
 			description: "Close a window.",
@@ -249,6 +256,16 @@ func init() {
 			examples: []string{`{"id":1,"verb":"resize","params":{"session":"work","width":120,"height":40}}`},
 			handler:  (*Daemon).verbResize,
 		},
+		"signal-window": {
+			description: "Send a signal to the foreground process group of a window's PTY.",
+			params: []verbParam{
+				sessionParam,
+				windowParam,
+				{Name: "signal", Type: "string", Required: true, Description: `Signal name ("INT" or "SIGINT") or number.`, Accepted: SignalNames()},
+			},
+			examples: []string{`{"id":1,"verb":"signal-window","params":{"session":"work","window":"build","signal":"INT"}}`},
+			handler:  (*Daemon).verbSignalWindow,
+		},
 		"kill-session": {
 			description: "Terminate a session and every window in it.",
 			params: []verbParam{
@@ -257,6 +274,23 @@ func init() {
 			examples: []string{`{"id":1,"verb":"kill-session","params":{"session":"work"}}`},
 			handler:  (*Daemon).verbKillSession,
 		},
+		"rename-session": {
+			description: "Rename a session, keeping its windows and attached clients.",
+			params: []verbParam{
+				{Name: "session", Type: "string", Required: true, Description: "Session to rename."},
+				{Name: "name", Type: "string", Required: true, Description: "New name; must not already be in use."},
+			},
+			examples: []string{`{"id":1,"verb":"rename-session","params":{"session":"work","name":"project"}}`},
+			handler:  (*Daemon).verbRenameSession,
+		},
+		"save-session": {
+			description: "Write a session's resurrection state to disk now instead of waiting for the periodic save.",
+			params: []verbParam{
+				{Name: "session", Type: "string", Required: true, Description: "Session to save."},
+			},
+			examples: []string{`{"id":1,"verb":"save-session","params":{"session":"work"}}`},
+			handler:  (*Daemon).verbSaveSession,
+		},
 		"set-option": {
 			description: "Set a session option, applied live when a client is attached.",
 			params: []verbParam{
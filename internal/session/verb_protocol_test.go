@@ -280,6 +280,56 @@ func TestVerbResize(t *testing.T) {
 	}
 }
 
+func TestVerbSignalWindow(t *testing.T) {
+	d, sp := startTestDaemon(t)
+	makeSessionWithWindow(t, d, "sig")
+
+	c := dialVerb(t, sp)
+	// SIGCONT is harmless to a running shell, so it exercises delivery without
+	// ending the window.
+	res := result(t, c.call(t, `{"verb":"signal-window","params":{"session":"sig","signal":"sigcont"}}`))
+	if res["type"] != "ok" || res["signal"] != "CONT" {
+		t.Errorf("signal-window result = %v", res)
+	}
+
+	code := errCode(t, c.call(t, `{"verb":"signal-window","params":{"session":"sig","signal":"BOGUS"}}`))
+	if code != ErrVerbInvalidParams {
+		t.Errorf("bad signal code = %q, want %q", code, ErrVerbInvalidParams)
+	}
+}
+
+func TestParseSignal(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{"INT", "INT", false},
+		{"sigterm", "TERM", false},
+		{" Kill ", "KILL", false},
+		{"9", "KILL", false},
+		{"SIGWINCH", "", true},
+		{"", "", true},
+		{"12345", "", true},
+	}
+	for _, tt := range tests {
+		sig, err := ParseSignal(tt.name)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseSignal(%q) = %v, want an error", tt.name, sig)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseSignal(%q) error: %v", tt.name, err)
+			continue
+		}
+		if got := SignalName(sig); got != tt.want {
+			t.Errorf("ParseSignal(%q) = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
 func TestVerbOptionsRoundTrip(t *testing.T) {
 	d, sp := startTestDaemon(t)
 	makeSessionWithWindow(t, d, "opt")
@@ -321,6 +371,61 @@ func TestVerbKillSession(t *testing.T) {
 	}
 }
 
+func TestVerbRenameSession(t *testing.T) {
+	d, sp := startTestDaemon(t)
+	makeSessionWithWindow(t, d, "old")
+	makeSessionWithWindow(t, d, "taken")
+
+	c := dialVerb(t, sp)
+	res := result(t, c.call(t, `{"verb":"rename-session","params":{"session":"old","name":"new"}}`))
+	if res["type"] != "ok" {
+		t.Errorf("rename type = %v", res["type"])
+	}
+	if d.manager.GetSession("old") != nil {
+		t.Error("session still listed under its old name")
+	}
+	sess := d.manager.GetSession("new")
+	if sess == nil {
+		t.Fatal("session not found under its new name")
+	}
+	if got := sess.GetState().Name; got != "new" {
+		t.Errorf("state name = %q, want %q", got, "new")
+	}
+
+	if code := errCode(t, c.call(t, `{"verb":"rename-session","params":{"session":"new","name":"taken"}}`)); code != ErrVerbInvalidParams {
+		t.Errorf("rename onto an existing name code = %q, want %q", code, ErrVerbInvalidParams)
+	}
+	if code := errCode(t, c.call(t, `{"verb":"rename-session","params":{"session":"old","name":"other"}}`)); code != ErrVerbSessionNotFound {
+		t.Errorf("rename missing code = %q, want %q", code, ErrVerbSessionNotFound)
+	}
+}
+
+func TestVerbSaveSession(t *testing.T) {
+	defer useResurrectionDir(t.TempDir())()
+	d, sp := startTestDaemon(t)
+	makeSessionWithWindow(t, d, "work")
+
+	c := dialVerb(t, sp)
+	res := result(t, c.call(t, `{"verb":"save-session","params":{"session":"work"}}`))
+	if res["type"] != "ok" {
+		t.Errorf("save type = %v", res["type"])
+	}
+	if res["windows"] != float64(1) {
+		t.Errorf("save windows = %v, want 1", res["windows"])
+	}
+	state, err := LoadResurrectionState("work")
+	if err != nil {
+		t.Fatalf("saved state not readable: %v", err)
+	}
+	if len(state.Windows) != 1 {
+		t.Errorf("saved window count = %d, want 1", len(state.Windows))
+	}
+
+	if code := errCode(t, c.call(t, `{"verb":"save-session","params":{"session":"nope"}}`)); code != ErrVerbSessionNotFound {
+		t.Errorf("save missing code = %q, want %q", code, ErrVerbSessionNotFound)
+	}
+}
+
 func TestVerbErrorCases(t *testing.T) {
 	_, sp := startTestDaemon(t)
 	c := dialVerb(t, sp)
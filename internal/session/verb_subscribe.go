@@ -261,7 +261,7 @@ func (d *Daemon) waitWindowExit(sessionName, window string, deadline <-chan time
 	}
 
 	sub := d.events.subscribe(eventFilter{
-		session: sess.Name,
+		session: sess.Name(),
 		ptyID:   pty.ID,
 		types:   map[string]bool{EventWindowExit: true, EventWindowClosed: true},
 	}, defaultEventQueue)
@@ -303,7 +303,7 @@ func (d *Daemon) waitWindowIdle(sessionName, window string, idleMs int, deadline
 	}
 
 	sub := d.events.subscribe(eventFilter{
-		session: sess.Name,
+		session: sess.Name(),
 		ptyID:   pty.ID,
 		types:   map[string]bool{EventOutput: true},
 	}, defaultEventQueue)
@@ -367,7 +367,7 @@ func (d *Daemon) waitWindowOutput(sessionName, window, pattern, source string, d
 	matches := func() bool { return re.MatchString(pty.CaptureContent(scrollback, false)) }
 
 	sub := d.events.subscribe(eventFilter{
-		session: sess.Name,
+		session: sess.Name(),
 		ptyID:   pty.ID,
 		types:   map[string]bool{EventOutput: true},
 	}, defaultEventQueue)
@@ -101,6 +101,8 @@ const (
 	CommandTypeWait CommandType = "Wait"
 	// CommandTypeWaitUntilRegex represents the WaitUntilRegex command.
 	CommandTypeWaitUntilRegex CommandType = "WaitUntilRegex"
+	// CommandTypeWaitFor represents the WaitFor command.
+	CommandTypeWaitFor CommandType = "WaitFor"
 
 	// CommandTypeSet represents the Set command.
 	CommandTypeSet CommandType = "Set"
@@ -187,7 +189,7 @@ func (ct CommandType) IsCommand() bool {
 		CommandTypeSwitchWS, CommandTypeMoveToWS, CommandTypeMoveAndFollowWS,
 		CommandTypeSplit, CommandTypeFocus, CommandTypeRotateSplit,
 		CommandTypeEqualizeSplits, CommandTypePreselect,
-		CommandTypeWait, CommandTypeWaitUntilRegex,
+		CommandTypeWait, CommandTypeWaitUntilRegex, CommandTypeWaitFor,
 		CommandTypeSet, CommandTypeOutput, CommandTypeSource,
 		CommandTypeEnableAnimations, CommandTypeDisableAnimations, CommandTypeToggleAnimations,
 		CommandTypeComment,
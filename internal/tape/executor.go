@@ -292,8 +292,8 @@ func (ce *CommandExecutor) Execute(cmd *Command) error {
 			return ce.executor.SendToWindow(ce.executor.GetFocusedWindowID(), keyBytes)
 		}
 
-	case CommandTypeWait, CommandTypeWaitUntilRegex:
-		// Wait (a Sleep alias) and WaitUntilRegex are handled by the interactive
+	case CommandTypeWait, CommandTypeWaitUntilRegex, CommandTypeWaitFor:
+		// Wait (a Sleep alias), WaitUntilRegex and WaitFor are handled by the interactive
 		// playback loop (internal/app/update.go), which needs to block across
 		// ticks while checking timers and screen contents. They are intentionally
 		// no-ops here so the remote/daemon exec path (which is fire-and-forget)
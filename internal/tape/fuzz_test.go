@@ -45,6 +45,7 @@ var tapeSeeds = []string{
 	"WaitUntilRegex //\n",
 	"WaitUntilRegex /(((((((((((/\n",
 	"WaitUntilRegex /" + strings.Repeat("a*", 512) + "/\n",
+	"WaitFor server contains \"ready\" timeout 5s\nWaitFor\nWaitFor x contains\n",
 	// Unterminated literals.
 	"Type \"unterminated",
 	"Type 'unterminated",
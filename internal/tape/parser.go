@@ -159,6 +159,8 @@ func (p *Parser) parseCommand() (Command, bool) {
 		return p.parseWaitCommand()
 	case TokenWaitUntilRegex:
 		return p.parseWaitUntilRegexCommand()
+	case TokenWaitFor:
+		return p.parseWaitForCommand()
 	case TokenSet:
 		return p.parseSetCommand()
 	case TokenOutput:
@@ -581,6 +583,67 @@ func (p *Parser) parseWaitUntilRegexCommand() (Command, bool) {
 	return cmd, true
 }
 
+// parseWaitForCommand parses WaitFor <window> contains "text" [timeout <duration>]
+// commands. WaitFor blocks playback until the named window's screen contains
+// the text, and fails playback if the timeout (default 5s) passes first.
+// Args are the window reference, the text and, if given, the timeout.
+func (p *Parser) parseWaitForCommand() (Command, bool) {
+	cmd := Command{
+		Type:   CommandTypeWaitFor,
+		Line:   p.curTok.Line,
+		Column: p.curTok.Column,
+	}
+
+	p.nextToken() // consume WaitFor
+
+	switch p.curTok.Type {
+	case TokenIdentifier, TokenNumber, TokenString:
+		cmd.Args = []string{p.curTok.Literal}
+		p.nextToken()
+	default:
+		p.addError("WaitFor expects a window ID or name")
+		p.skipToNextLine()
+		return cmd, false
+	}
+
+	if p.curTok.Type != TokenIdentifier || p.curTok.Literal != "contains" {
+		p.addError(fmt.Sprintf("WaitFor expects 'contains', got %q", p.curTok.Literal))
+		p.skipToNextLine()
+		return cmd, false
+	}
+	p.nextToken()
+
+	if p.curTok.Type != TokenString {
+		p.addError("WaitFor expects the text to wait for as a string")
+		p.skipToNextLine()
+		return cmd, false
+	}
+	cmd.Args = append(cmd.Args, p.curTok.Literal)
+	cmd.Raw = fmt.Sprintf("WaitFor %s contains %q", cmd.Args[0], cmd.Args[1])
+	p.nextToken()
+
+	if p.curTok.Type == TokenIdentifier && p.curTok.Literal == "timeout" {
+		p.nextToken()
+		if p.curTok.Type != TokenDuration {
+			p.addError(fmt.Sprintf("WaitFor timeout expects a duration, got %v", p.curTok.Type))
+			p.skipToNextLine()
+			return cmd, false
+		}
+		if _, err := ParseDuration(p.curTok.Literal); err != nil {
+			p.addError(fmt.Sprintf("invalid duration: %s", p.curTok.Literal))
+		}
+		cmd.Args = append(cmd.Args, p.curTok.Literal)
+		cmd.Raw += " timeout " + p.curTok.Literal
+		p.nextToken()
+	}
+
+	if p.curTok.Type != TokenNewline && p.curTok.Type != TokenEOF {
+		p.skipToNextLine()
+	}
+
+	return cmd, true
+}
+
 // parseSetCommand parses Set <key> <value> commands
 func (p *Parser) parseSetCommand() (Command, bool) {
 	cmd := Command{
@@ -180,6 +180,34 @@ func TestParserWaitUntilRegex(t *testing.T) {
 	}
 }
 
+func TestParserWaitFor(t *testing.T) {
+	commands, errors := ParseFile(`WaitFor server contains "listening" timeout 10s`)
+	if len(errors) != 0 {
+		t.Fatalf("Unexpected parse errors: %v", errors)
+	}
+	if len(commands) != 1 || commands[0].Type != CommandTypeWaitFor {
+		t.Fatalf("Unexpected parse result: %+v", commands)
+	}
+	if args := commands[0].Args; len(args) != 3 || args[0] != "server" || args[1] != "listening" || args[2] != "10s" {
+		t.Errorf("Unexpected args: %v", args)
+	}
+
+	// Default timeout, quoted window name.
+	commands, errors = ParseFile(`WaitFor "my shell" contains "$ "`)
+	if len(errors) != 0 {
+		t.Fatalf("Unexpected parse errors: %v", errors)
+	}
+	if len(commands) != 1 || len(commands[0].Args) != 2 || commands[0].Args[0] != "my shell" {
+		t.Errorf("Unexpected parse result: %+v", commands)
+	}
+
+	for _, bad := range []string{`WaitFor server "x"`, `WaitFor server contains`, `WaitFor server contains "x" timeout 5`} {
+		if _, errors := ParseFile(bad); len(errors) == 0 {
+			t.Errorf("Expected a parse error for %q", bad)
+		}
+	}
+}
+
 func TestParserKeyCombo(t *testing.T) {
 	tests := []struct {
 		name        string
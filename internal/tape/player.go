@@ -12,6 +12,9 @@ type Player struct {
 	paused       bool          // Whether playback is paused
 	finished     bool          // Whether all commands have been played
 	currentDelay time.Duration // Remaining delay before next command
+	speed        float64       // Playback speed multiplier (1 = as written)
+	runs         int           // Times to play the script, 0 = forever
+	run          int           // Current run, 1-based
 }
 
 // NewPlayer creates a new script player from a list of commands
@@ -21,9 +24,46 @@ func NewPlayer(commands []Command) *Player {
 		index:    0,
 		paused:   false,
 		finished: false,
+		speed:    1,
+		runs:     1,
+		run:      1,
 	}
 }
 
+// SetSpeed sets the playback speed multiplier: 2 plays twice as fast, 0.5 at
+// half speed. Non-positive values are ignored.
+func (p *Player) SetSpeed(speed float64) {
+	if speed > 0 {
+		p.speed = speed
+	}
+}
+
+// ScaleDelay scales a script delay by the playback speed.
+func (p *Player) ScaleDelay(d time.Duration) time.Duration {
+	if p.speed <= 0 || p.speed == 1 {
+		return d
+	}
+	return time.Duration(float64(d) / p.speed)
+}
+
+// SetRuns sets how many times the script is played before the player
+// finishes. 0 plays it forever; negative values are ignored.
+func (p *Player) SetRuns(runs int) {
+	if runs >= 0 {
+		p.runs = runs
+	}
+}
+
+// Run returns the current run, starting at 1.
+func (p *Player) Run() int {
+	return p.run
+}
+
+// Runs returns how many times the script is played, 0 meaning forever.
+func (p *Player) Runs() int {
+	return p.runs
+}
+
 // NextCommand returns the next command to execute without advancing the player state
 // Used for pre-planning
 func (p *Player) NextCommand() *Command {
@@ -39,10 +79,24 @@ func (p *Player) Advance() {
 		p.index++
 	}
 	if p.index >= len(p.commands) {
+		// Start the next run, if any, from the top.
+		if len(p.commands) > 0 && (p.runs == 0 || p.run < p.runs) {
+			p.NextRun()
+			return
+		}
 		p.finished = true
 	}
 }
 
+// NextRun starts the script again from the top as a new run. Unlike Reset it
+// counts up, so a caller watching Run can tell a run boundary went by.
+func (p *Player) NextRun() {
+	p.index = 0
+	p.finished = false
+	p.currentDelay = 0
+	p.run++
+}
+
 // IsFinished returns true if all commands have been executed
 func (p *Player) IsFinished() bool {
 	return p.finished
@@ -64,6 +118,7 @@ func (p *Player) Reset() {
 	p.paused = false
 	p.finished = false
 	p.currentDelay = 0
+	p.run = 1
 }
 
 // CurrentIndex returns the current command index
@@ -0,0 +1,72 @@
+package tape
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlayerRuns(t *testing.T) {
+	commands, _ := ParseFile("Enter\nTab\n")
+	p := NewPlayer(commands)
+	p.SetRuns(2)
+
+	played := 0
+	for !p.IsFinished() && played < 10 {
+		played++
+		p.Advance()
+	}
+	if played != 4 {
+		t.Errorf("played %d commands over 2 runs, want 4", played)
+	}
+	if p.Run() != 2 {
+		t.Errorf("Run() = %d, want 2", p.Run())
+	}
+
+	// 0 runs forever.
+	p.Reset()
+	p.SetRuns(0)
+	for range 20 {
+		p.Advance()
+	}
+	if p.IsFinished() || p.Run() != 11 {
+		t.Errorf("forever: finished=%v run=%d, want false and 11", p.IsFinished(), p.Run())
+	}
+}
+
+func TestPlayerScaleDelay(t *testing.T) {
+	p := NewPlayer(nil)
+	if got := p.ScaleDelay(time.Second); got != time.Second {
+		t.Errorf("default speed: %v, want 1s", got)
+	}
+	p.SetSpeed(2)
+	if got := p.ScaleDelay(time.Second); got != 500*time.Millisecond {
+		t.Errorf("speed 2: %v, want 500ms", got)
+	}
+	p.SetSpeed(0.5)
+	if got := p.ScaleDelay(time.Second); got != 2*time.Second {
+		t.Errorf("speed 0.5: %v, want 2s", got)
+	}
+	p.SetSpeed(0)
+	if got := p.ScaleDelay(time.Second); got != 2*time.Second {
+		t.Errorf("speed 0 should be ignored, got %v", got)
+	}
+}
+
+func TestPlayerNextRun(t *testing.T) {
+	commands, _ := ParseFile("Enter\n")
+	p := NewPlayer(commands)
+	p.Advance()
+	if !p.IsFinished() {
+		t.Fatal("single run not finished after its only command")
+	}
+
+	// Unlike Reset, NextRun counts the run, so a watcher can see the boundary.
+	p.NextRun()
+	if p.IsFinished() || p.CurrentIndex() != 0 || p.Run() != 2 {
+		t.Errorf("after NextRun: finished=%v index=%d run=%d, want false, 0, 2", p.IsFinished(), p.CurrentIndex(), p.Run())
+	}
+	p.Reset()
+	if p.Run() != 1 {
+		t.Errorf("Reset left run %d, want 1", p.Run())
+	}
+}
@@ -114,6 +114,8 @@ const (
 	TokenWait TokenType = "Wait"
 	// TokenWaitUntilRegex represents the WaitUntilRegex command token.
 	TokenWaitUntilRegex TokenType = "WaitUntilRegex"
+	// TokenWaitFor represents the WaitFor command token.
+	TokenWaitFor TokenType = "WaitFor"
 	// TokenSet represents the Set command token.
 	TokenSet TokenType = "Set"
 	// TokenOutput represents the Output command token.
@@ -171,7 +173,7 @@ func (tt TokenType) IsCommand() bool {
 		TokenSplit, TokenFocus, TokenRotateSplit, TokenEqualizeSplits,
 		TokenToggleZoom, TokenSmartSplit, TokenCommandPalette,
 		TokenSaveLayout, TokenLoadLayout,
-		TokenWait, TokenWaitUntilRegex,
+		TokenWait, TokenWaitUntilRegex, TokenWaitFor,
 		TokenSet, TokenOutput, TokenSource,
 		TokenEnableAnimations, TokenDisableAnimations, TokenToggleAnimations:
 		return true
@@ -263,6 +265,7 @@ var KeywordTokenMap = map[string]TokenType{
 	// Synchronization
 	"Wait":           TokenWait,
 	"WaitUntilRegex": TokenWaitUntilRegex,
+	"WaitFor":        TokenWaitFor,
 
 	// Settings
 	"Set":    TokenSet,
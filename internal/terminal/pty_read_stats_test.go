@@ -0,0 +1,21 @@
+package terminal
+
+import "testing"
+
+// TestPTYReadStats checks the counters behind the cache stats overlay: bytes
+// add up, only reads that fill the buffer count as full, and a reset zeroes
+// them all.
+func TestPTYReadStats(t *testing.T) {
+	w := &Window{}
+	w.countPTYRead(100, 4096)
+	w.countPTYRead(4096, 4096)
+
+	if reads, bytes, full := w.PTYReadStats(); reads != 2 || bytes != 4196 || full != 1 {
+		t.Errorf("PTYReadStats() = (%d, %d, %d), want (2, 4196, 1)", reads, bytes, full)
+	}
+
+	w.ResetPTYReadStats()
+	if reads, bytes, full := w.PTYReadStats(); reads != 0 || bytes != 0 || full != 0 {
+		t.Errorf("after reset PTYReadStats() = (%d, %d, %d), want zeros", reads, bytes, full)
+	}
+}
@@ -0,0 +1,70 @@
+package terminal
+
+import (
+	"time"
+
+	"github.com/Gaurav-Gosain/tuios/internal/config"
+)
+
+// throttleIndicatorHold is how long a window keeps reporting itself as
+// throttled after the bucket last ran dry, so the indicator does not flicker
+// between consecutive reads of a steady flood.
+const throttleIndicatorHold = time.Second
+
+// outputThrottle is a token bucket that limits how fast a window consumes PTY
+// output (config.MaxPtyBytesPerSec). The bucket holds at most one second of
+// output, so short bursts pass straight through and only a sustained flood is
+// slowed down. It is owned by a single reader goroutine and is not locked.
+type outputThrottle struct {
+	tokens float64
+	last   time.Time
+}
+
+// take charges n bytes against the bucket and returns how long the caller
+// should wait before consuming more output. It returns 0 while the limit is
+// off or the bucket still has room.
+func (t *outputThrottle) take(n, limit int, now time.Time) time.Duration {
+	if limit <= 0 {
+		t.last = time.Time{}
+		return 0
+	}
+	rate := float64(limit)
+	if t.last.IsZero() {
+		t.tokens = rate
+	} else {
+		t.tokens = min(t.tokens+now.Sub(t.last).Seconds()*rate, rate)
+	}
+	t.last = now
+	t.tokens -= float64(n)
+	if t.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-t.tokens / rate * float64(time.Second))
+}
+
+// throttleOutput charges n bytes of output against the window's bucket and,
+// when the window is over config.MaxPtyBytesPerSec, sleeps until it is back
+// under it. Not reading the PTY meanwhile pushes back on the program writing to
+// it, so a flood is slowed at the source instead of swamping the UI. It returns
+// false if done is closed while waiting.
+func (w *Window) throttleOutput(done <-chan struct{}, t *outputThrottle, n int) bool {
+	wait := t.take(n, config.MaxPtyBytesPerSec, time.Now())
+	if wait <= 0 {
+		return true
+	}
+	w.throttledUntil.Store(time.Now().Add(wait + throttleIndicatorHold).UnixNano())
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// IsThrottled reports whether the window's output has recently been held back
+// by config.MaxPtyBytesPerSec.
+func (w *Window) IsThrottled() bool {
+	return time.Now().UnixNano() < w.throttledUntil.Load()
+}
@@ -0,0 +1,42 @@
+package terminal
+
+import (
+	"testing"
+	"time"
+)
+
+// TestOutputThrottleTake checks the token bucket: a burst up to one second of
+// output passes, a flood past it is told to wait in proportion to the excess,
+// the bucket refills with time, and a limit of 0 never waits.
+func TestOutputThrottleTake(t *testing.T) {
+	var tb outputThrottle
+	now := time.Unix(1000, 0)
+	const limit = 1000
+
+	if wait := tb.take(1000, limit, now); wait != 0 {
+		t.Fatalf("first second of output waited %v, want 0", wait)
+	}
+	if wait := tb.take(500, limit, now); wait != 500*time.Millisecond {
+		t.Errorf("500 bytes over the limit waited %v, want 500ms", wait)
+	}
+
+	// After the wait the bucket is back at zero, and a further second refills it.
+	now = now.Add(500 * time.Millisecond)
+	if wait := tb.take(0, limit, now); wait != 0 {
+		t.Errorf("bucket still over the limit after waiting: %v", wait)
+	}
+	now = now.Add(time.Second)
+	if wait := tb.take(1000, limit, now); wait != 0 {
+		t.Errorf("refilled bucket waited %v, want 0", wait)
+	}
+
+	// A long idle period does not bank more than one second of output.
+	now = now.Add(time.Hour)
+	if wait := tb.take(2000, limit, now); wait != time.Second {
+		t.Errorf("burst after idling waited %v, want 1s", wait)
+	}
+
+	if wait := tb.take(1<<20, 0, now); wait != 0 {
+		t.Errorf("no limit waited %v, want 0", wait)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/exec"
 	"runtime/debug"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -77,6 +78,18 @@ func (w *Window) SetTiled(tiled bool) {
 	w.InvalidateCache()
 }
 
+// SetTitleBarHidden shows or hides the window's title bar row and re-syncs
+// the emulator/PTY size, like SetTiled: the terminal gains the row while it
+// is hidden. No-op when unchanged.
+func (w *Window) SetTitleBarHidden(hidden bool) {
+	if w.TitleBarHidden == hidden {
+		return
+	}
+	w.TitleBarHidden = hidden
+	w.Resize(w.Width, w.Height)
+	w.InvalidateCache()
+}
+
 // The following scalar/string fields are written by the VT callbacks on the
 // PTY/monitor goroutine and read on the Bubble Tea UI goroutine, so they are
 // stored atomically and accessed only through these methods.
@@ -110,6 +123,25 @@ func (w *Window) Title() string {
 // SetTitle records the current window title.
 func (w *Window) SetTitle(t string) { w.title.Store(&t) }
 
+// SpawnCommandLine returns the command line the window was started with,
+// SpawnCommand followed by SpawnArgs, or "" for a plain shell.
+func (w *Window) SpawnCommandLine() string {
+	if w.SpawnCommand == "" {
+		return ""
+	}
+	return strings.Join(append([]string{w.SpawnCommand}, w.SpawnArgs...), " ")
+}
+
+// Pid returns the process ID of the window's shell, or 0 when the shell does
+// not run in this process's tree (a daemon window's shell belongs to the
+// daemon).
+func (w *Window) Pid() int {
+	if w.Cmd == nil || w.Cmd.Process == nil {
+		return 0
+	}
+	return w.Cmd.Process.Pid
+}
+
 // IsAltScreen reports whether the application is using the alternate screen buffer.
 func (w *Window) IsAltScreen() bool { return w.isAltScreen.Load() }
 
@@ -171,6 +203,9 @@ var (
 type Window struct {
 	title              atomic.Pointer[string] // Written on PTY/monitor goroutine, read on UI goroutine
 	CustomName         string                 // User-defined window name
+	AutoNamed          bool                   // CustomName came from the foreground command (config.AutoRenameFromCommand), not the user
+	SpawnCommand       string                 // Command typed into the shell when the window was created, replayed on reload
+	SpawnArgs          []string               // Arguments to SpawnCommand
 	Width              int
 	Height             int
 	X                  int
@@ -191,6 +226,7 @@ type Window struct {
 	LastTerminalSeq    int
 	IsBeingManipulated bool               // True when being dragged or resized
 	UpdateCounter      int                // Counter for throttling background updates
+	UpdateDivisor      int                // Background redraw throttle for this window; 0 uses config.BackgroundUpdateDivisor
 	cancelFunc         context.CancelFunc // For graceful goroutine cleanup
 	// ioMu guards the emulator cell buffer and the Pty/Terminal handles. See
 	// the block comment above LockIO for the full contract; the short version:
@@ -220,8 +256,10 @@ type Window struct {
 	ioMu                   sync.RWMutex
 	Minimized              bool               // True when window is minimized to dock
 	Minimizing             bool               // True when window is being minimized (animation playing)
+	Closing                bool               // True when window is playing its close animation and is about to be removed
 	MinimizeHighlightUntil time.Time          // Highlight dock tab until this time
 	MinimizeOrder          int64              // Unix nano timestamp when minimized (for dock ordering)
+	LastFocusTime          time.Time          // When the window last lost or gained focus (scrollback budget trims the oldest first)
 	PreMinimizeX           int                // Store position before minimizing
 	PreMinimizeY           int                // Store position before minimizing
 	PreMinimizeWidth       int                // Store size before minimizing
@@ -232,6 +270,12 @@ type Window struct {
 	PreZoomY               int                // Store position before zooming
 	PreZoomWidth           int                // Store size before zooming
 	PreZoomHeight          int                // Store size before zooming
+	Maximized              bool               // True when the maximize button filled the screen with this window
+	PreMaximizeX           int                // Store position before maximizing
+	PreMaximizeY           int                // Store position before maximizing
+	PreMaximizeWidth       int                // Store size before maximizing
+	PreMaximizeHeight      int                // Store size before maximizing
+	MaximizeMinimized      []string           // IDs of windows workspace-fullscreen minimized, restored with this one
 	SelectionStart         struct{ X, Y int } // Selection start position
 	SelectionEnd           struct{ X, Y int } // Selection end position
 	IsSelecting            bool               // True when selecting text
@@ -247,16 +291,30 @@ type Window struct {
 	// Scrollback mode support
 	ScrollbackMode   bool // True when viewing scrollback history
 	ScrollbackOffset int  // Number of lines scrolled back (0 = at bottom, viewing live output)
+	// Frozen header support: top rows pinned in place while the rest scrolls
+	FrozenHeaderLines int      // Rows pinned at the top of the window (0 = none)
+	FrozenHeader      []string // Styled snapshot of those rows, taken when they were pinned
+	// Inverted swaps this window's colors when it is drawn. It is applied to the
+	// rendered output only; the emulator's cells are untouched.
+	Inverted bool
 	// Alternate screen buffer tracking for TUI detection.
 	// Written on PTY/monitor goroutine, read on UI goroutine.
 	isAltScreen atomic.Bool // True when application is using alternate screen buffer (nvim, vim, etc.)
 	// Floating pane support
 	IsFloating bool // True when window is floating (not in BSP tiling)
 	IsPinned   bool // True when floating pane persists across workspace switches
+	// TitleBarHidden drops the top border row (config.ShowTitleBars) and gives
+	// it to the terminal. Change it through SetTitleBarHidden.
+	TitleBarHidden bool
 	// Cursor style tracking for passthrough to parent terminal.
 	// Written by the VT callback on the PTY goroutine, read on the UI goroutine.
 	cursorStyle atomic.Int32 // Current cursor style (block, underline, bar)
 	cursorBlink atomic.Bool  // Whether cursor should blink
+	// PTY read counters for the cache stats overlay, written on the PTY
+	// reader goroutine and read on the UI goroutine.
+	ptyReads     atomic.Uint64 // Reads that returned data
+	ptyReadBytes atomic.Uint64 // Bytes those reads returned
+	ptyFullReads atomic.Uint64 // Reads that filled the whole buffer
 	// Cell dimensions in pixels (for TIOCGWINSZ pixel reporting to child processes)
 	CellPixelWidth  int
 	CellPixelHeight int
@@ -274,6 +332,7 @@ type Window struct {
 	NotifyFunc        func(title, body string) // Callback for guest desktop notifications (OSC 9/777/99)
 	BellFunc          func()                   // Callback for guest bell (BEL)
 	CwdFunc           func(cwd string)         // Callback for the shell's working directory changing (OSC 7)
+	reportedCwd       atomic.Pointer[string]   // Last OSC 7 payload, written on the PTY goroutine
 	outputChan        chan []byte              // Channel for serializing daemon PTY output writes
 	outputDone        chan struct{}            // Signal to stop output writer goroutine
 	suppressCallbacks atomic.Bool              // Suppress VT emulator callbacks during state restoration (prevents race conditions)
@@ -292,10 +351,19 @@ type Window struct {
 	// background goroutine, which otherwise races the renderer and Close().
 	coalesceSignal atomic.Bool
 
+	// throttledUntil is when the throttled indicator lapses, in Unix
+	// nanoseconds; the PTY reader pushes it forward each time it has to wait
+	// on config.MaxPtyBytesPerSec. ThrottleShown is the state the window was
+	// last drawn with, owned by the UI goroutine.
+	throttledUntil atomic.Int64
+	ThrottleShown  bool
+
 	// lastScrollbackLen is the most recent scrollback length ScrollbackLenSync
 	// managed to read. It answers that call when the I/O lock is busy, so the
 	// compositor never waits on a bursting pane just to size a scrollbar.
 	lastScrollbackLen atomic.Int64
+	// lastScrollbackBytes does the same for ScrollbackBytesSync.
+	lastScrollbackBytes atomic.Int64
 
 	// PTYDataChan is a shared channel (buffered 1) that PTY readers signal
 	// to trigger rendering. Non-blocking send coalesces rapid updates.
@@ -366,7 +434,12 @@ type CopyMode struct {
 	CaseSensitive   bool          // Case-sensitive search
 	SearchBackward  bool          // True for ? (backward), false for / (forward)
 	SearchCache     SearchCache   // Cached search results (exported for copymode package)
+	QuickFind       bool          // Opened by the find prefix: Esc leaves copy mode, Enter stays at the match
+	SearchHistory   []string      // Past search queries, oldest first; kept across copy-mode sessions
+	HistoryIndex    int           // How far back up/down has recalled in SearchHistory; 0 is the query being typed
+	HistoryDraft    string        // The query being typed when recall started, restored past the newest entry
 	PendingGCount   bool          // Waiting for second 'g' in 'gg'
+	PendingPrompt   string        // Prompt jump waiting for its second key ('[[' / ']]')
 	LastCommandTime time.Time     // For detecting 'gg' sequence
 
 	// Character search state (f/F/t/T commands)
@@ -375,22 +448,39 @@ type CopyMode struct {
 	LastCharSearchDir  int  // 1 for forward (f/t), -1 for backward (F/T)
 	LastCharSearchTill bool // true for till (t/T), false for find (f/F)
 
+	// Marks (m{a-z} sets one, `{a-z} jumps back to it). Y is an absolute line
+	// plus the emulator's ScrollbackTrimmed at the time, so a mark stays on its
+	// line as old scrollback is dropped. The ` entry is the position before the
+	// last jump, for ``.
+	Marks       map[rune]Position
+	PendingMark string // "m" or "`" while waiting for the mark letter
+
 	// Count prefix (e.g., 10j means move down 10 times)
 	PendingCount   int       // Accumulated count (0 means no count)
 	CountStartTime time.Time // When count entry started (for timeout)
+
+	// Held-key acceleration (config.CopyModeKeyAccel)
+	LastMoveKey  string    // Last movement key, to detect a held key
+	LastMoveTime time.Time // When LastMoveKey was pressed
+	MoveStreak   int       // Consecutive fast repeats of LastMoveKey
 }
 
 // NewWindow creates a new terminal window with the specified properties.
 // It spawns a shell process, sets up PTY communication, and initializes the virtual terminal.
 // Returns nil if window creation fails.
 func NewWindow(id, title string, x, y, width, height, z int, exitChan chan string, ptyDataChan chan struct{}) *Window {
+	return NewWindowInDir(id, title, "", x, y, width, height, z, exitChan, ptyDataChan)
+}
+
+// NewWindowInDir is NewWindow with the shell started in dir. An empty dir, or
+// one that no longer exists, falls back to the directory TUIOS runs in.
+func NewWindowInDir(id, title, dir string, x, y, width, height, z int, exitChan chan string, ptyDataChan chan struct{}) *Window {
 	if title == "" {
 		title = "Terminal " + id[:8]
 	}
 
-	// Create VT terminal with inner dimensions (accounting for borders)
-	terminalWidth := max(width-2, 1)
-	terminalHeight := max(height-2, 1)
+	// Create VT terminal with inner dimensions (accounting for borders and padding)
+	terminalWidth, terminalHeight := paddedSize(width-2, height-2)
 	// Create terminal with scrollback buffer support
 	terminal := vt.NewEmulator(terminalWidth, terminalHeight)
 	// Set scrollback buffer size from config (default: 10000, configurable via --scrollback-lines or config file)
@@ -473,6 +563,7 @@ func NewWindow(id, title string, x, y, width, height, z int, exitChan chan strin
 			}
 		},
 		WorkingDirectory: func(cwd string) {
+			window.reportedCwd.Store(&cwd)
 			if window.CwdFunc != nil {
 				window.CwdFunc(cwd)
 			}
@@ -485,6 +576,11 @@ func NewWindow(id, title string, x, y, width, height, z int, exitChan chan strin
 	// Set up environment
 	// #nosec G204 - shell is intentionally user-controlled for terminal functionality
 	cmd := exec.Command(shell)
+	if dir != "" {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			cmd.Dir = dir
+		}
+	}
 
 	// Get cached terminal environment (detected once on first window creation)
 	termType, colorTerm := getTerminalEnv()
@@ -595,9 +691,8 @@ func NewDaemonWindow(id, title string, x, y, width, height, z int, ptyID string,
 		title = "Terminal " + id[:8]
 	}
 
-	// Create VT terminal with inner dimensions (accounting for borders)
-	terminalWidth := max(width-2, 1)
-	terminalHeight := max(height-2, 1)
+	// Create VT terminal with inner dimensions (accounting for borders and padding)
+	terminalWidth, terminalHeight := paddedSize(width-2, height-2)
 	terminal := vt.NewEmulator(terminalWidth, terminalHeight)
 	terminal.SetScrollbackMaxLines(config.ScrollbackLines)
 	terminal.SetCellSize(10, 20)
@@ -684,6 +779,7 @@ func NewDaemonWindow(id, title string, x, y, width, height, z int, ptyID string,
 			}
 		},
 		WorkingDirectory: func(cwd string) {
+			window.reportedCwd.Store(&cwd)
 			if window.CwdFunc != nil {
 				window.CwdFunc(cwd)
 			}
@@ -48,3 +48,14 @@ func (w *Window) CWD() string {
 	w.cwd.value = cwd
 	return cwd
 }
+
+// ReportedCWD returns the raw payload of the last OSC 7 working-directory
+// report (usually a file://host/path URI), or the empty string if the shell
+// never sent one. Unlike CWD it works for daemon windows and on platforms
+// without /proc, but only for shells configured to emit OSC 7.
+func (w *Window) ReportedCWD() string {
+	if p := w.reportedCwd.Load(); p != nil {
+		return *p
+	}
+	return ""
+}
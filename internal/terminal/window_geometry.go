@@ -1,23 +1,62 @@
 package terminal
 
+import "github.com/Gaurav-Gosain/tuios/internal/config"
+
 // ContentWidth returns the usable content width (excluding borders if not tiled).
 func (w *Window) ContentWidth() int {
-	if w.Tiled {
-		return max(w.Width, 1)
-	}
-	return max(w.Width-2, 1)
+	width, _ := w.contentSize(w.Width, w.Height)
+	return width
 }
 
-// ContentHeight returns the usable content height (excluding borders if not tiled).
+// ContentHeight returns the usable content height (excluding borders if not
+// tiled, and only the bottom one when the title bar is hidden).
 func (w *Window) ContentHeight() int {
-	if w.Tiled {
-		return max(w.Height, 1)
+	_, height := w.contentSize(w.Width, w.Height)
+	return height
+}
+
+// contentSize returns the terminal size for a window of the given outer size:
+// its frame interior less the content padding on every side.
+func (w *Window) contentSize(width, height int) (int, int) {
+	return paddedSize(w.frameInterior(width, height))
+}
+
+// paddedSize returns the terminal size for a frame interior of the given size
+// once the content padding comes off every side.
+func paddedSize(width, height int) (int, int) {
+	pad := contentPadding(width, height)
+	return max(width-2*pad, 1), max(height-2*pad, 1)
+}
+
+// frameInterior returns the area inside the frame of a window of the given
+// outer size: tiled windows have no borders, a hidden title bar gives its row
+// back, and every other window loses a cell to each border.
+func (w *Window) frameInterior(width, height int) (int, int) {
+	switch {
+	case w.Tiled:
+		return width, height
+	case w.TitleBarHidden:
+		return width - 2, height - 1
 	}
-	return max(w.Height-2, 1)
+	return width - 2, height - 2
+}
+
+// contentPadding returns the blank cells kept on each side of a frame interior
+// of the given size: config.WindowContentPadding, cut down so a small window
+// keeps at least one row and column of terminal.
+func contentPadding(width, height int) int {
+	return max(min(config.WindowContentPadding, (width-1)/2, (height-1)/2), 0)
+}
+
+// ContentPadding returns the blank cells between the window's frame and its
+// terminal on each side.
+func (w *Window) ContentPadding() int {
+	return contentPadding(w.frameInterior(w.Width, w.Height))
 }
 
 // BorderOffset returns the number of cells used by each border edge.
 // Returns 0 for tiled windows (no individual borders), 1 otherwise.
+// The top edge differs when the title bar is hidden; see TopOffset.
 func (w *Window) BorderOffset() int {
 	if w.Tiled {
 		return 0
@@ -25,12 +64,32 @@ func (w *Window) BorderOffset() int {
 	return 1
 }
 
+// TopOffset returns the number of rows above the content: the title bar, or
+// none for tiled windows and windows whose title bar is hidden.
+func (w *Window) TopOffset() int {
+	if w.Tiled || w.TitleBarHidden {
+		return 0
+	}
+	return 1
+}
+
+// ContentOffsetX returns the column of the terminal's first cell relative to
+// the window's left edge: past the border and the content padding.
+func (w *Window) ContentOffsetX() int {
+	return w.BorderOffset() + w.ContentPadding()
+}
+
+// ContentOffsetY returns the row of the terminal's first line relative to the
+// window's top edge: past the title bar and the content padding.
+func (w *Window) ContentOffsetY() int {
+	return w.TopOffset() + w.ContentPadding()
+}
+
 // ScreenToTerminal converts screen coordinates (X, Y) to terminal-relative coordinates.
 // Returns the terminal X, Y and whether the coordinates are within the content area.
 func (w *Window) ScreenToTerminal(screenX, screenY int) (termX, termY int, ok bool) {
-	off := w.BorderOffset()
-	termX = screenX - w.X - off
-	termY = screenY - w.Y - off
+	termX = screenX - w.X - w.ContentOffsetX()
+	termY = screenY - w.Y - w.ContentOffsetY()
 	ok = termX >= 0 && termY >= 0 && termX < w.ContentWidth() && termY < w.ContentHeight()
 	return
 }
@@ -40,12 +99,7 @@ func (w *Window) Resize(width, height int) {
 		return
 	}
 
-	borderDeduct := 2
-	if w.Tiled {
-		borderDeduct = 0
-	}
-	termWidth := max(width-borderDeduct, 1)
-	termHeight := max(height-borderDeduct, 1)
+	termWidth, termHeight := w.contentSize(width, height)
 
 	// Check if size actually changed
 	sizeChanged := w.Width != width || w.Height != height
@@ -100,12 +154,7 @@ func (w *Window) ResizeVisual(width, height int) {
 	// This prevents the "stuck" height and dimension mismatch issues during drag.
 	// PTY resize is still deferred until mouse release (via pending resizes).
 	if w.Terminal != nil {
-		borderDeduct := 2
-		if w.Tiled {
-			borderDeduct = 0
-		}
-		termWidth := max(width-borderDeduct, 1)
-		termHeight := max(height-borderDeduct, 1)
+		termWidth, termHeight := w.contentSize(width, height)
 		// ioMu serializes the buffer reallocation with the render reader and
 		// PTY writers; Terminal has no lock of its own.
 		w.ioMu.Lock()
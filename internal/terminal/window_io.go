@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Gaurav-Gosain/tuios/internal/config"
 	"github.com/Gaurav-Gosain/tuios/internal/pool"
 	"github.com/Gaurav-Gosain/tuios/internal/vt"
 )
@@ -52,6 +53,11 @@ func (w *Window) outputWriter() {
 
 	batch := make([]byte, 0, maxBatch)
 
+	// Daemon output is pushed at this window rather than read by it, so the
+	// throttle cannot push back on the program. Waiting here lets outputChan
+	// fill, and WriteOutputAsync drops what no longer fits.
+	var throttle outputThrottle
+
 	for {
 		select {
 		case <-w.outputDone:
@@ -122,6 +128,10 @@ func (w *Window) outputWriter() {
 			// goroutine checks coalesceSignal at a capped rate and
 			// signals then. This prevents partial-frame renders.
 		}
+
+		if !w.throttleOutput(w.outputDone, &throttle, len(batch)) {
+			return
+		}
 	}
 }
 
@@ -217,6 +227,29 @@ func (w *Window) WriteOutput(data []byte) {
 	w.MarkContentDirty()
 }
 
+// countPTYRead records one read of n bytes into a buffer of size bytes.
+func (w *Window) countPTYRead(n, size int) {
+	w.ptyReads.Add(1)
+	w.ptyReadBytes.Add(uint64(n))
+	if n == size {
+		w.ptyFullReads.Add(1)
+	}
+}
+
+// PTYReadStats reports how many reads the window's PTY reader has made, the
+// bytes they returned, and how many filled the whole read buffer. Daemon
+// windows are read by the daemon, so theirs stay at zero.
+func (w *Window) PTYReadStats() (reads, bytes, full uint64) {
+	return w.ptyReads.Load(), w.ptyReadBytes.Load(), w.ptyFullReads.Load()
+}
+
+// ResetPTYReadStats zeroes the counters PTYReadStats reports.
+func (w *Window) ResetPTYReadStats() {
+	w.ptyReads.Store(0)
+	w.ptyReadBytes.Store(0)
+	w.ptyFullReads.Store(0)
+}
+
 // WriteOutputAsync writes output data to the terminal emulator without blocking.
 // Used in daemon mode to process PTY output received from the daemon.
 // Data is queued to a channel and written in order by the outputWriter goroutine.
@@ -288,10 +321,16 @@ func (w *Window) handleIOOperations() {
 			}
 		}()
 
-		// Get buffer from pool for better memory management
-		bufPtr := pool.GetByteSlice()
-		buf := *bufPtr
-		defer pool.PutByteSlice(bufPtr)
+		// The default size comes from the pool; a tuned size is the
+		// window's own for as long as its reader runs.
+		var buf []byte
+		if config.PtyReadBufferSize == config.ByteSliceBufferSize {
+			bufPtr := pool.GetByteSlice()
+			buf = *bufPtr
+			defer pool.PutByteSlice(bufPtr)
+		} else {
+			buf = make([]byte, max(config.PtyReadBufferSize, config.MinPtyReadBufferSize))
+		}
 
 		// Snapshot the PTY once under the lock. Close() nils w.Pty under
 		// ioMu; re-reading the interface value each iteration without the
@@ -305,6 +344,7 @@ func (w *Window) handleIOOperations() {
 		if pty == nil {
 			return
 		}
+		var throttle outputThrottle
 		for {
 			select {
 			case <-ctx.Done():
@@ -322,6 +362,7 @@ func (w *Window) handleIOOperations() {
 				}
 				if n > 0 {
 					w.HasNewOutput.Store(true)
+					w.countPTYRead(n, len(buf))
 
 					// Signal bubbletea that PTY data arrived (non-blocking, coalesces rapid updates)
 					if w.PTYDataChan != nil {
@@ -355,6 +396,10 @@ func (w *Window) handleIOOperations() {
 						_, _ = w.Terminal.Write(buf[:n])
 					}
 					w.ioMu.Unlock()
+
+					if !w.throttleOutput(ctx.Done(), &throttle, n) {
+						return
+					}
 				}
 			}
 		}
@@ -39,6 +39,39 @@ func (w *Window) ScrollbackLen() int {
 	return w.Terminal.ScrollbackLen()
 }
 
+// ScrollbackBytesSync returns the approximate memory held by the scrollback
+// buffer. Like ScrollbackLenSync it never waits on the I/O lock, answering
+// with the last observed value while a burst holds it.
+func (w *Window) ScrollbackBytesSync() int {
+	if !w.ioMu.TryRLock() {
+		return int(w.lastScrollbackBytes.Load())
+	}
+	defer w.ioMu.RUnlock()
+	if w.Terminal == nil {
+		return 0
+	}
+	n := w.Terminal.ScrollbackBytes()
+	w.lastScrollbackBytes.Store(int64(n))
+	return n
+}
+
+// TrimScrollbackBytes drops the oldest scrollback lines until at least n bytes
+// are freed, and returns the bytes freed. It skips the window, freeing
+// nothing, when the I/O lock is busy; the caller retries on its next pass.
+func (w *Window) TrimScrollbackBytes(n int) int {
+	if !w.ioMu.TryLock() {
+		return 0
+	}
+	defer w.ioMu.Unlock()
+	if w.Terminal == nil {
+		return 0
+	}
+	freed := w.Terminal.TrimScrollbackBytes(n)
+	w.lastScrollbackBytes.Store(int64(w.Terminal.ScrollbackBytes()))
+	w.lastScrollbackLen.Store(int64(w.Terminal.ScrollbackLen()))
+	return freed
+}
+
 // ScrollbackLine returns a line from the scrollback buffer at the given index.
 // Index 0 is the oldest line. Returns nil if index is out of bounds.
 func (w *Window) ScrollbackLine(index int) uv.Line {
@@ -102,6 +135,22 @@ func (w *Window) ScrollDown(lines int) {
 	}
 }
 
+// PeekScrollback scrolls the view up a page without entering copy mode, for a
+// quick look at output that scrolled off; calling it again goes another page
+// back. The peek is transient: the caller ends it with ExitScrollbackMode on
+// the next input. It reports false when there is no scrollback to show.
+func (w *Window) PeekScrollback() bool {
+	if !w.ScrollbackMode {
+		w.EnterScrollbackMode()
+	}
+	w.ScrollUp(max(w.ContentHeight(), 1))
+	if w.ScrollbackOffset == 0 {
+		w.ExitScrollbackMode()
+		return false
+	}
+	return true
+}
+
 // EnterCopyMode enters vim-style copy/scrollback mode.
 // This replaces both ScrollbackMode and SelectionMode with a unified vim interface.
 func (w *Window) EnterCopyMode() {
@@ -118,7 +167,10 @@ func (w *Window) EnterCopyMode() {
 	w.CopyMode.SearchMatches = nil
 	w.CopyMode.CurrentMatch = 0
 	w.CopyMode.CaseSensitive = false
+	w.CopyMode.QuickFind = false
+	w.CopyMode.HistoryIndex = 0
 	w.CopyMode.PendingGCount = false
+	w.CopyMode.PendingMark = ""
 
 	// Sync with window scrollback
 	w.ScrollbackOffset = 0
@@ -126,16 +178,33 @@ func (w *Window) EnterCopyMode() {
 	w.InvalidateCache()
 }
 
+// EnterQuickFind opens an incremental search over the screen and scrollback
+// without the rest of copy mode. Matches are highlighted as the query is
+// typed; Enter keeps copy mode open at the current match, Esc returns to the
+// live terminal as if nothing happened.
+func (w *Window) EnterQuickFind() {
+	w.EnterCopyMode()
+	w.CopyMode.State = CopyModeSearch
+	w.CopyMode.QuickFind = true
+	// Search forward from the top of the visible screen so the first match
+	// on screen wins, and only then wrap into scrollback.
+	w.CopyMode.CursorY = 0
+}
+
 // ExitCopyMode exits copy mode and returns to normal terminal mode.
 func (w *Window) ExitCopyMode() {
 	if w.CopyMode != nil {
 		w.CopyMode.Active = false
 		w.CopyMode.State = CopyModeNormal
 		w.CopyMode.ScrollOffset = 0
+		w.CopyMode.QuickFind = false
 		// Clear search state
 		w.CopyMode.SearchQuery = ""
 		w.CopyMode.SearchMatches = nil
 		w.CopyMode.SearchCache.Valid = false
+		// Marks only last while copy mode stays open
+		w.CopyMode.Marks = nil
+		w.CopyMode.PendingMark = ""
 	}
 
 	// CRITICAL: Return to live content (bottom of scrollback)
@@ -3,10 +3,12 @@
 package terminal
 
 import (
+	"errors"
 	"os"
 	"syscall"
 	"unsafe"
 
+	"github.com/shirou/gopsutil/v4/process"
 	"golang.org/x/sys/unix"
 )
 
@@ -41,31 +43,74 @@ func getPgid(pid int) (int, error) {
 // Returns true if there's a foreground process different from the shell itself.
 // Returns false if only the shell is running or if unable to determine.
 func (w *Window) HasForegroundProcess() bool {
-	if w.Pty == nil || w.ShellPgid <= 0 {
+	if w.ShellPgid <= 0 {
 		return false
 	}
 
-	// Get the PTY file descriptor
-	ptyFd := w.Pty.Fd()
+	fgpgrp, ok := w.foregroundPgid()
+	if !ok {
+		// If we can't determine, assume no foreground process
+		return false
+	}
+
+	// If foreground process group is different from shell's process group,
+	// there's an active foreground process running
+	return fgpgrp != w.ShellPgid
+}
+
+// foregroundPgid returns the foreground process group of the window's PTY.
+func (w *Window) foregroundPgid() (int, bool) {
+	if w.Pty == nil {
+		return 0, false
+	}
 
 	// Get the foreground process group of the PTY
 	// Using tcgetpgrp syscall via ioctl
 	var fgpgrp int
 	_, _, errno := syscall.Syscall(
 		syscall.SYS_IOCTL,
-		ptyFd,
+		w.Pty.Fd(),
 		uintptr(unix.TIOCGPGRP),
 		uintptr(unsafe.Pointer(&fgpgrp)),
 	)
+	if errno != 0 || fgpgrp <= 0 {
+		return 0, false
+	}
+	return fgpgrp, true
+}
 
-	if errno != 0 {
-		// If we can't determine, assume no foreground process
-		return false
+// ForegroundCommand returns the name of the job in the foreground of the
+// window's PTY, the shell itself when nothing else runs. It is the name of the
+// process group leader, and empty for daemon windows, whose PTY belongs to the
+// daemon.
+func (w *Window) ForegroundCommand() string {
+	pgid, ok := w.foregroundPgid()
+	if !ok {
+		return ""
+	}
+	proc, err := process.NewProcess(int32(pgid)) // #nosec G115 - pids fit in int32
+	if err != nil {
+		return ""
+	}
+	name, err := proc.Name()
+	if err != nil {
+		return ""
 	}
+	return name
+}
 
-	// If foreground process group is different from shell's process group,
-	// there's an active foreground process running
-	return fgpgrp != w.ShellPgid
+// Signal sends sig to the foreground process group of the window's PTY, the
+// job a Ctrl+C typed into the window would reach, falling back to the shell's
+// group when the foreground group cannot be read.
+func (w *Window) Signal(sig syscall.Signal) error {
+	pgid, ok := w.foregroundPgid()
+	if !ok {
+		pgid = w.ShellPgid
+	}
+	if pgid <= 0 {
+		return errors.New("window has no local process")
+	}
+	return syscall.Kill(-pgid, sig)
 }
 
 // SetPtyPixelSize sets the pixel dimensions on the PTY using TIOCSWINSZ.
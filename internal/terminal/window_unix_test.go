@@ -5,6 +5,7 @@ package terminal
 import (
 	"syscall"
 	"testing"
+	"time"
 	"unsafe"
 
 	"golang.org/x/sys/unix"
@@ -102,3 +103,30 @@ func TestSetCellPixelDimensions(t *testing.T) {
 		t.Errorf("Expected Ypixel=%d, got %d", expectedYpixel, ws.Ypixel)
 	}
 }
+
+func TestForegroundCommand(t *testing.T) {
+	exitChan := make(chan string, 1)
+	window := NewWindow("test-id-fg123456", "Test", 0, 0, 80, 24, 0, exitChan, nil)
+	if window == nil {
+		t.Skip("Failed to create window with PTY")
+	}
+	defer window.Close()
+
+	if window.Pty == nil {
+		t.Skip("No PTY available")
+	}
+
+	if _, err := window.Pty.Write([]byte("exec sleep 30\n")); err != nil {
+		t.Fatalf("write to PTY: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	var got string
+	for time.Now().Before(deadline) {
+		if got = window.ForegroundCommand(); got == "sleep" {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Errorf("ForegroundCommand() = %q, want %q", got, "sleep")
+}
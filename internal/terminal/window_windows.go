@@ -2,6 +2,11 @@
 
 package terminal
 
+import (
+	"errors"
+	"syscall"
+)
+
 // TriggerRedraw ensures terminal applications properly respond to resize.
 // On Windows, the PTY resize itself should trigger the necessary updates.
 // Windows ConPTY doesn't use SIGWINCH - it handles resize notifications automatically.
@@ -27,7 +32,22 @@ func (w *Window) HasForegroundProcess() bool {
 	return false
 }
 
+// ForegroundCommand is a stub for Windows - ConPTY does not expose the
+// foreground process, so windows are never auto-renamed.
+func (w *Window) ForegroundCommand() string {
+	return ""
+}
+
 // SetPtyPixelSize is a stub for Windows - ConPTY doesn't support pixel dimensions.
 func (w *Window) SetPtyPixelSize(cols, rows, xpixel, ypixel int) error {
 	return nil
 }
+
+// Signal terminates the window's shell. Windows has no process groups or
+// job-control signals, so every signal ends the process.
+func (w *Window) Signal(_ syscall.Signal) error {
+	if w.Cmd == nil || w.Cmd.Process == nil {
+		return errors.New("window has no local process")
+	}
+	return w.Cmd.Process.Kill()
+}
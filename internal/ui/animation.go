@@ -18,6 +18,11 @@ const (
 	AnimationRestore
 	// AnimationSnap represents a window snap animation.
 	AnimationSnap
+	// AnimationOpen represents a new window growing into place.
+	AnimationOpen
+	// AnimationClose represents a closing window shrinking toward the dock.
+	// The window is removed by the caller once it completes.
+	AnimationClose
 )
 
 // Animation represents an animated transition for a window.
@@ -142,6 +147,58 @@ func NewSnapAnimation(w *terminal.Window, targetX, targetY, targetWidth, targetH
 	}
 }
 
+// NewOpenAnimation creates an animation that grows a new window from a small
+// box centered on originX, originY to its current bounds. The window starts at
+// the small box straight away so it is never drawn at full size first.
+func NewOpenAnimation(w *terminal.Window, originX, originY int, duration time.Duration) *Animation {
+	if duration == 0 {
+		return nil
+	}
+
+	anim := &Animation{
+		Window:      w,
+		Type:        AnimationOpen,
+		StartTime:   time.Now(),
+		Duration:    duration,
+		StartX:      max(originX-2, 0),
+		StartY:      max(originY-1, 0),
+		StartWidth:  5,
+		StartHeight: 3,
+		EndX:        w.X,
+		EndY:        w.Y,
+		EndWidth:    w.Width,
+		EndHeight:   w.Height,
+	}
+	w.X, w.Y = anim.StartX, anim.StartY
+	w.Width, w.Height = anim.StartWidth, anim.StartHeight
+	w.MarkPositionDirty()
+	return anim
+}
+
+// NewCloseAnimation creates an animation that shrinks a closing window toward
+// the dock at dockX, dockY. It leaves the window in place when it completes;
+// removing it is up to the caller.
+func NewCloseAnimation(w *terminal.Window, dockX, dockY int, duration time.Duration) *Animation {
+	if duration == 0 {
+		return nil
+	}
+
+	return &Animation{
+		Window:      w,
+		Type:        AnimationClose,
+		StartTime:   time.Now(),
+		Duration:    duration,
+		StartX:      w.X,
+		StartY:      w.Y,
+		StartWidth:  w.Width,
+		StartHeight: w.Height,
+		EndX:        dockX,
+		EndY:        dockY,
+		EndWidth:    5,
+		EndHeight:   3,
+	}
+}
+
 // Update updates the animation progress and applies changes to the window.
 // Returns true if the animation is complete, false otherwise.
 func (a *Animation) Update() bool {
@@ -192,7 +249,7 @@ func (a *Animation) Update() bool {
 			a.Window.Y = a.Window.PreMinimizeY
 			a.Window.Width = a.Window.PreMinimizeWidth
 			a.Window.Height = a.Window.PreMinimizeHeight
-		case AnimationRestore, AnimationSnap:
+		case AnimationRestore, AnimationSnap, AnimationOpen:
 			// Resize at completion for clean, one-time resize
 			a.Window.Resize(a.EndWidth, a.EndHeight)
 			// Ensure final position is exact
@@ -349,6 +349,59 @@ func TestUpdate_MinimizeCompletion(t *testing.T) {
 	}
 }
 
+func TestNewOpenAnimation_GrowsFromOrigin(t *testing.T) {
+	w := createTestWindow(100, 50, 80, 24)
+	defer func() { _ = w.Terminal.Close() }()
+
+	if anim := NewOpenAnimation(w, 140, 62, 0); anim != nil {
+		t.Fatal("NewOpenAnimation should return nil for zero duration")
+	}
+	if w.X != 100 || w.Width != 80 {
+		t.Fatal("zero-duration open animation moved the window")
+	}
+
+	anim := NewOpenAnimation(w, 140, 62, 50*time.Millisecond)
+	if anim == nil {
+		t.Fatal("NewOpenAnimation returned nil for non-zero duration")
+	}
+	// The window starts as a small box centered on the origin.
+	if w.X != 138 || w.Y != 61 || w.Width != 5 || w.Height != 3 {
+		t.Errorf("window starts at (%d,%d %dx%d), want (138,61 5x3)", w.X, w.Y, w.Width, w.Height)
+	}
+
+	anim.StartTime = time.Now().Add(-100 * time.Millisecond)
+	if !anim.Update() {
+		t.Fatal("animation should be complete after its duration")
+	}
+	if w.X != 100 || w.Y != 50 || w.Width != 80 || w.Height != 24 {
+		t.Errorf("window ends at (%d,%d %dx%d), want (100,50 80x24)", w.X, w.Y, w.Width, w.Height)
+	}
+}
+
+func TestNewCloseAnimation_ShrinksToDock(t *testing.T) {
+	w := createTestWindow(100, 50, 80, 24)
+	defer func() { _ = w.Terminal.Close() }()
+
+	if anim := NewCloseAnimation(w, 10, 300, 0); anim != nil {
+		t.Fatal("NewCloseAnimation should return nil for zero duration")
+	}
+
+	anim := NewCloseAnimation(w, 10, 300, 50*time.Millisecond)
+	if anim == nil {
+		t.Fatal("NewCloseAnimation returned nil for non-zero duration")
+	}
+	anim.StartTime = time.Now().Add(-100 * time.Millisecond)
+	if !anim.Update() {
+		t.Fatal("animation should be complete after its duration")
+	}
+	if w.X != 10 || w.Y != 300 || w.Width != 5 || w.Height != 3 {
+		t.Errorf("window ends at (%d,%d %dx%d), want the dock box (10,300 5x3)", w.X, w.Y, w.Width, w.Height)
+	}
+	if w.Minimized {
+		t.Error("a close animation must not minimize the window")
+	}
+}
+
 func TestUpdate_RestoreCompletion(t *testing.T) {
 	w := createTestWindow(10, 300, 5, 3)
 	defer func() { _ = w.Terminal.Close() }()
@@ -586,10 +639,12 @@ func TestAnimationType_Constants(t *testing.T) {
 		AnimationMinimize: "AnimationMinimize",
 		AnimationRestore:  "AnimationRestore",
 		AnimationSnap:     "AnimationSnap",
+		AnimationOpen:     "AnimationOpen",
+		AnimationClose:    "AnimationClose",
 	}
 
-	if len(types) != 3 {
-		t.Error("Expected 3 distinct animation types")
+	if len(types) != 5 {
+		t.Error("Expected 5 distinct animation types")
 	}
 
 	// Verify they start from 0 (iota)
@@ -123,6 +123,9 @@ type Emulator struct {
 
 	// semanticMarkers tracks OSC 133 shell integration markers
 	semanticMarkers *SemanticMarkerList
+	// scrollbackTrimmed counts the lines dropped off the top of the main
+	// screen's scrollback since the emulator was created
+	scrollbackTrimmed int
 }
 
 // NewEmulator creates a new virtual terminal emulator.
@@ -182,6 +185,7 @@ func NewEmulator(w, h int) *Emulator {
 	if sb := t.scrs[0].Scrollback(); sb != nil {
 		sb.SetOnTrim(func(n int) {
 			t.semanticMarkers.AdjustForScrollbackTrim(n)
+			t.scrollbackTrimmed += n
 		})
 	}
 
@@ -261,6 +265,13 @@ func (e *Emulator) ScrollbackLen() int {
 	return e.scrs[0].ScrollbackLen()
 }
 
+// ScrollbackTrimmed returns how many lines have been dropped off the top of the
+// scrollback since the emulator was created. An absolute line index taken
+// earlier refers to the line that many positions further up now.
+func (e *Emulator) ScrollbackTrimmed() int {
+	return e.scrollbackTrimmed
+}
+
 // SemanticMarkers returns the list of OSC 133 semantic zone markers.
 func (e *Emulator) SemanticMarkers() *SemanticMarkerList {
 	return e.semanticMarkers
@@ -334,6 +345,18 @@ func (e *Emulator) ScrollbackLine(index int) uv.Line {
 	return e.scrs[0].ScrollbackLine(index)
 }
 
+// ScrollbackBytes returns the approximate memory held by the main screen's
+// scrollback buffer.
+func (e *Emulator) ScrollbackBytes() int {
+	return e.scrs[0].ScrollbackBytes()
+}
+
+// TrimScrollbackBytes drops the oldest scrollback lines until at least n bytes
+// are freed, and returns the bytes freed.
+func (e *Emulator) TrimScrollbackBytes(n int) int {
+	return e.scrs[0].TrimScrollbackBytes(n)
+}
+
 // SetScrollbackMaxLines sets the maximum number of lines for the scrollback buffer.
 func (e *Emulator) SetScrollbackMaxLines(maxLines int) {
 	e.scrs[0].SetScrollbackMaxLines(maxLines)
@@ -384,6 +384,23 @@ func (s *Screen) ScrollbackLine(index int) uv.Line {
 	return s.scrollback.Line(index)
 }
 
+// ScrollbackBytes returns the approximate memory held by the scrollback buffer.
+func (s *Screen) ScrollbackBytes() int {
+	if s.scrollback == nil {
+		return 0
+	}
+	return s.scrollback.Bytes()
+}
+
+// TrimScrollbackBytes drops the oldest scrollback lines until at least n bytes
+// are freed, and returns the bytes freed.
+func (s *Screen) TrimScrollbackBytes(n int) int {
+	if s.scrollback == nil {
+		return 0
+	}
+	return s.scrollback.TrimBytes(n)
+}
+
 // SetScrollbackMaxLines sets the maximum number of lines for the scrollback buffer.
 func (s *Screen) SetScrollbackMaxLines(maxLines int) {
 	if s.scrollback != nil {
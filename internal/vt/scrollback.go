@@ -1,6 +1,8 @@
 package vt
 
 import (
+	"unsafe"
+
 	uv "github.com/charmbracelet/ultraviolet"
 )
 
@@ -8,6 +10,11 @@ import (
 // scrollback buffer.
 const DefaultScrollbackSize = 10000
 
+// cellBytes is the in-memory size of one stored cell. Bytes multiplies it by
+// the stored cell count, which ignores strings and styles shared between cells
+// but tracks real growth closely enough to budget against.
+var cellBytes = int(unsafe.Sizeof(uv.Cell{}))
+
 // Scrollback represents a scrollback buffer that stores lines that have
 // scrolled off the top of the visible screen.
 // Uses a ring buffer for O(1) insertions instead of O(n) slice reallocations.
@@ -29,6 +36,9 @@ type Scrollback struct {
 	// softWrapped indicates which lines are soft-wrapped (not hard breaks)
 	// A soft-wrapped line can be reflowed to a different width
 	softWrapped []bool
+	// cells is the total number of cells across the stored lines, kept
+	// current on every push, overwrite and trim so Bytes is O(1)
+	cells int
 	// onTrim is called when oldest lines are overwritten by the ring buffer.
 	// The argument is the number of lines trimmed (always 1 per overwrite).
 	onTrim func(int)
@@ -75,7 +85,11 @@ func (sb *Scrollback) PushLineWithWrap(line uv.Line, isSoftWrapped bool) {
 	lineCopy := make(uv.Line, len(line))
 	copy(lineCopy, line)
 
-	// Insert at tail position
+	// Insert at tail position, releasing the line it overwrites when full
+	if sb.full {
+		sb.cells -= len(sb.lines[sb.tail])
+	}
+	sb.cells += len(lineCopy)
 	sb.lines[sb.tail] = lineCopy
 	sb.softWrapped[sb.tail] = isSoftWrapped
 
@@ -149,6 +163,7 @@ func (sb *Scrollback) Clear() {
 	sb.head = 0
 	sb.tail = 0
 	sb.full = false
+	sb.cells = 0
 	// Nil out the lines to help GC, but keep the slice
 	for i := range sb.lines {
 		sb.lines[i] = nil
@@ -238,6 +253,10 @@ func (sb *Scrollback) SetMaxLines(maxLines int) {
 		newSoftWrapped[i] = sb.softWrapped[physicalIndex]
 	}
 
+	sb.cells = 0
+	for _, line := range newLines[:newLen] {
+		sb.cells += len(line)
+	}
 	sb.lines = newLines
 	sb.softWrapped = newSoftWrapped
 	sb.maxLines = maxLines
@@ -253,6 +272,32 @@ func (sb *Scrollback) SetMaxLines(maxLines int) {
 	}
 }
 
+// Bytes returns the approximate memory held by the stored lines.
+func (sb *Scrollback) Bytes() int {
+	return sb.cells * cellBytes
+}
+
+// TrimBytes drops the oldest lines until at least n bytes are freed or the
+// buffer is empty, and returns the bytes freed. Unlike SetMaxLines it leaves
+// the capacity alone, so the buffer grows back as new output arrives.
+func (sb *Scrollback) TrimBytes(n int) int {
+	freed, count := 0, 0
+	for freed < n && sb.Len() > 0 {
+		line := sb.lines[sb.head]
+		freed += len(line) * cellBytes
+		sb.cells -= len(line)
+		sb.lines[sb.head] = nil
+		sb.softWrapped[sb.head] = false
+		sb.head = (sb.head + 1) % sb.maxLines
+		sb.full = false
+		count++
+	}
+	if count > 0 && sb.onTrim != nil {
+		sb.onTrim(count)
+	}
+	return freed
+}
+
 // extractLine extracts a complete line from the buffer at the given Y coordinate.
 // This is a helper function to copy cells from a buffer line.
 func extractLine(buf *uv.Buffer, y, width int) uv.Line {
@@ -205,3 +205,44 @@ func TestSetMaxLinesDownsizeCallsOnTrim(t *testing.T) {
 		t.Errorf("onTrim total = %d, want 2 (oldest lines dropped on downsize)", trimmed)
 	}
 }
+
+func TestScrollbackTrimBytes(t *testing.T) {
+	sb := NewScrollback(4)
+	var trimmed int
+	sb.SetOnTrim(func(n int) { trimmed += n })
+
+	for i := range 6 {
+		sb.PushLine(make(uv.Line, i+1))
+	}
+	// Lines of 3, 4, 5 and 6 cells survive the ring overwrite.
+	if got, want := sb.Bytes(), 18*cellBytes; got != want {
+		t.Fatalf("Bytes() = %d, want %d", got, want)
+	}
+
+	trimmed = 0
+	freed := sb.TrimBytes(5 * cellBytes)
+	if freed != 7*cellBytes {
+		t.Errorf("TrimBytes freed %d, want %d (the two oldest lines)", freed, 7*cellBytes)
+	}
+	if sb.Len() != 2 || len(sb.Line(0)) != 5 {
+		t.Errorf("expected the 5- and 6-cell lines to remain, got %d lines", sb.Len())
+	}
+	if trimmed != 2 {
+		t.Errorf("onTrim reported %d lines, want 2", trimmed)
+	}
+	if sb.Bytes() != 11*cellBytes {
+		t.Errorf("Bytes() after trim = %d, want %d", sb.Bytes(), 11*cellBytes)
+	}
+
+	// The capacity is unchanged, so the buffer fills back up.
+	for range 4 {
+		sb.PushLine(make(uv.Line, 1))
+	}
+	if sb.Len() != 4 || sb.Bytes() != 4*cellBytes {
+		t.Errorf("after refill: Len=%d Bytes=%d, want 4 and %d", sb.Len(), sb.Bytes(), 4*cellBytes)
+	}
+
+	if freed := sb.TrimBytes(1 << 30); freed != 4*cellBytes || sb.Len() != 0 {
+		t.Errorf("trimming everything freed %d, left %d lines", freed, sb.Len())
+	}
+}